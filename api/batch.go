@@ -0,0 +1,191 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/metrics"
+	"github.com/pkg/errors"
+)
+
+// MaxBatchSize is the maximum number of requests accepted by batch call endpoint
+const MaxBatchSize = 1000
+
+type batchAnswer struct {
+	Error   string   `json:"error,omitempty"`
+	Results []answer `json:"results,omitempty"`
+	TraceID string   `json:"traceID,omitempty"`
+}
+
+// batchSeeds checks every distinct seed of the batch once. Items of the batch are usually signed with the same seed,
+// but seed manager forgets seed after the first check. Seed still allows one call: an item, that repeats member,
+// method and params of another item with the same seed, is rejected.
+type batchSeeds struct {
+	check func([]byte) error
+
+	lock    sync.Mutex
+	checked map[string]error
+	used    map[string]struct{}
+}
+
+func newBatchSeeds(check func([]byte) error) *batchSeeds {
+	return &batchSeeds{
+		check:   check,
+		checked: make(map[string]error),
+		used:    make(map[string]struct{}),
+	}
+}
+
+// checkSeed returns seed checker of the batch item. Checker returns result of the first check of seed and rejects
+// the item, if the same request was already accepted with this seed.
+func (s *batchSeeds) checkSeed(params Request) func([]byte) error {
+	return func(seed []byte) error {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+
+		// Signatures are not compared, because the same request may be signed again with another valid signature.
+		call := string(seed) + ":" + params.Reference + ":" + string(fingerprint(params))
+		if _, ok := s.used[call]; ok {
+			return errors.New("[ checkSeed ] Request is repeated with the same seed")
+		}
+
+		err, ok := s.checked[string(seed)]
+		if !ok {
+			err = s.check(seed)
+			s.checked[string(seed)] = err
+		}
+		if err == nil {
+			s.used[call] = struct{}{}
+		}
+		return err
+	}
+}
+
+// processBatchItem executes single request of the batch, errors are reported in answer
+func (ar *Runner) processBatchItem(ctx context.Context, seeds *batchSeeds, params Request) answer {
+	resp := answer{}
+
+	startTime := time.Now()
+	defer func() {
+		success := "success"
+		if resp.Error != "" {
+			success = "fail"
+		}
+		metrics.APIContractExecutionTime.WithLabelValues(params.Method, success).Observe(time.Since(startTime).Seconds())
+	}()
+
+	if params.LogLevel != nil {
+		logLevelNumber, err := insolar.ParseLevel(*params.LogLevel)
+		if err != nil {
+			resp.Error = errors.Wrap(err, "[ processBatchItem ] Can't parse logLevel").Error()
+			return resp
+		}
+		ctx = inslogger.WithLoggerLevel(ctx, logLevelNumber)
+	}
+
+	result, err := ar.checkSeedAndCall(ctx, "batchCallHandler", params, seeds.checkSeed(params), ar.makeCall)
+	if err != nil {
+		resp.Error = err.Error()
+		return resp
+	}
+
+	resp.Result = result
+	return resp
+}
+
+// batchCallHandler accepts array of independently signed requests, executes them concurrently
+// and returns results in the order of requests
+func (ar *Runner) batchCallHandler() func(http.ResponseWriter, *http.Request) {
+	return func(response http.ResponseWriter, req *http.Request) {
+		traceID := utils.RandTraceID()
+		ctx, insLog := inslogger.WithTraceField(context.Background(), traceID)
+
+		ctx, span := instracer.StartSpan(ctx, "batchCallHandler")
+		defer span.End()
+
+		resp := batchAnswer{TraceID: traceID}
+
+		insLog.Infof("[ batchCallHandler ] Incoming request: %s", req.RequestURI)
+
+		defer func() {
+			res, err := json.MarshalIndent(resp, "", "    ")
+			if err != nil {
+				res = []byte(`{"error": "can't marshal answer to json'"}`)
+			}
+			response.Header().Add("Content-Type", "application/json")
+			_, err = response.Write(res)
+			if err != nil {
+				insLog.Errorf("Can't write response\n")
+			}
+		}()
+
+		// Address limit is charged for every item of the batch, the first one is charged before reading the body.
+		host := remoteHost(req)
+		if !ar.rateLimits.allow(limitAddress, "batchCallHandler", host) {
+			resp.Error = rateLimitMessage(limitAddress)
+			return
+		}
+
+		var batch []Request
+		_, err := UnmarshalRequest(req, &batch)
+		if err != nil {
+			resp.Error = err.Error()
+			insLog.Error(errors.Wrap(err, "[ batchCallHandler ] Can't unmarshal request"))
+			return
+		}
+		if len(batch) == 0 {
+			resp.Error = "[ batchCallHandler ] Empty batch"
+			return
+		}
+		if len(batch) > MaxBatchSize {
+			resp.Error = fmt.Sprintf("[ batchCallHandler ] Batch is too big: %d, max %d", len(batch), MaxBatchSize)
+			return
+		}
+
+		seeds := newBatchSeeds(ar.checkSeed)
+		results := make([]answer, len(batch))
+		var wg sync.WaitGroup
+		wg.Add(len(batch))
+		for i := range batch {
+			if i > 0 && !ar.rateLimits.allow(limitAddress, "batchCallHandler", host) {
+				results[i] = answer{Error: rateLimitMessage(limitAddress)}
+				wg.Done()
+				continue
+			}
+			go func(i int) {
+				defer wg.Done()
+				results[i] = ar.processBatchItem(ctx, seeds, batch[i])
+				if results[i].Error != "" {
+					insLog.Errorf("[ batchCallHandler ] Request %d failed: %s", i, results[i].Error)
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		resp.Results = results
+	}
+}
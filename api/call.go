@@ -26,6 +26,8 @@ import (
 	"github.com/insolar/insolar/api/seedmanager"
 	"github.com/insolar/insolar/application/extractor"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/insolar/utils"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -36,12 +38,15 @@ import (
 
 // Request is a representation of request struct to api
 type Request struct {
-	Reference string  `json:"reference"`
-	Method    string  `json:"method"`
-	Params    []byte  `json:"params"`
-	Seed      []byte  `json:"seed"`
-	Signature []byte  `json:"signature"`
-	LogLevel  *string `json:"logLevel,omitempty"`
+	Reference  string   `json:"reference"`
+	Method     string   `json:"method"`
+	Params     []byte   `json:"params"`
+	Seed       []byte   `json:"seed"`
+	Signature  []byte   `json:"signature"`
+	Signatures [][]byte `json:"signatures,omitempty"`
+	LogLevel   *string  `json:"logLevel,omitempty"`
+	// IdempotencyKey is an optional client key. Repeated requests with the same key get the result of the first one.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
 }
 
 type answer struct {
@@ -73,28 +78,45 @@ func (ar *Runner) checkSeed(paramsSeed []byte) error {
 		return errors.New("[ checkSeed ] Bad seed param")
 	}
 
-	if !ar.SeedManager.Exists(*seed) {
+	ok, err := ar.SeedManager.Exists(*seed)
+	if err != nil {
+		return errors.Wrap(err, "[ checkSeed ] Can't check seed")
+	}
+	if !ok {
 		return errors.New("[ checkSeed ] Incorrect seed")
 	}
 
 	return nil
 }
 
+// callArgs returns member reference and arguments of member's Call method for the request
+func (ar *Runner) callArgs(params Request) (*insolar.Reference, []interface{}, error) {
+	reference, err := insolar.NewReferenceFromBase58(params.Reference)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to parse params.Reference")
+	}
+
+	signature := params.Signature
+	if len(params.Signatures) > 0 {
+		signature, err = insolar.MarshalArgs(params.Signatures)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to serialize params.Signatures")
+		}
+	}
+
+	return reference, []interface{}{*ar.CertificateManager.GetCertificate().GetRootDomainReference(), params.Method, params.Params, params.Seed, signature}, nil
+}
+
 func (ar *Runner) makeCall(ctx context.Context, params Request) (interface{}, error) {
 	ctx, span := instracer.StartSpan(ctx, "SendRequest "+params.Method)
 	defer span.End()
 
-	reference, err := insolar.NewReferenceFromBase58(params.Reference)
+	reference, args, err := ar.callArgs(params)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ makeCall ] failed to parse params.Reference")
+		return nil, errors.Wrap(err, "[ makeCall ]")
 	}
 
-	res, err := ar.ContractRequester.SendRequest(
-		ctx,
-		reference,
-		"Call",
-		[]interface{}{*ar.CertificateManager.GetCertificate().GetRootDomainReference(), params.Method, params.Params, params.Seed, params.Signature},
-	)
+	res, err := ar.ContractRequester.SendRequest(ctx, reference, "Call", args)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "[ makeCall ] Can't send request")
@@ -113,17 +135,88 @@ func (ar *Runner) makeCall(ctx context.Context, params Request) (interface{}, er
 	return result, nil
 }
 
+type callResult struct {
+	result interface{}
+	err    error
+}
+
+// callWithTimeout runs call and gives up after configured api timeout
+func (ar *Runner) callWithTimeout(
+	ctx context.Context, params Request, call func(context.Context, Request) (interface{}, error),
+) (interface{}, error) {
+	ch := make(chan callResult, 1)
+	go func() {
+		result, err := call(ctx, params)
+		ch <- callResult{result: result, err: err}
+	}()
+
+	select {
+	case res := <-ch:
+		return res.result, res.err
+	case <-time.After(time.Duration(ar.cfg.Timeout) * time.Second):
+		return nil, errors.New("Messagebus timeout exceeded")
+	}
+}
+
 func processError(err error, extraMsg string, resp *answer, insLog insolar.Logger) {
 	resp.Error = err.Error()
 	insLog.Error(errors.Wrapf(err, "[ CallHandler ] %s", extraMsg))
 }
 
 func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
+	return ar.requestHandler("callHandler", ar.makeCall)
+}
+
+// submitHandler registers request and replies with request reference without waiting for the result
+func (ar *Runner) submitHandler() func(http.ResponseWriter, *http.Request) {
+	return ar.requestHandler("submitHandler", ar.makeSubmit)
+}
+
+// makeSubmit sends request in no wait mode, result can be fetched later by returned request reference
+func (ar *Runner) makeSubmit(ctx context.Context, params Request) (interface{}, error) {
+	ctx, span := instracer.StartSpan(ctx, "SubmitRequest "+params.Method)
+	defer span.End()
+
+	reference, args, err := ar.callArgs(params)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeSubmit ]")
+	}
+
+	arguments, err := insolar.MarshalArgs(args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeSubmit ] Can't marshal arguments")
+	}
+
+	msg := &message.CallMethod{
+		Request: record.Request{
+			Object:     reference,
+			Method:     "Call",
+			Arguments:  arguments,
+			ReturnMode: record.ReturnNoWait,
+		},
+	}
+
+	res, err := ar.ContractRequester.Call(ctx, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ makeSubmit ] Can't send request")
+	}
+
+	registered, ok := res.(*reply.RegisterRequest)
+	if !ok {
+		return nil, errors.New("[ makeSubmit ] Unexpected reply")
+	}
+
+	return registered.Request.String(), nil
+}
+
+func (ar *Runner) requestHandler(
+	name string, call func(context.Context, Request) (interface{}, error),
+) func(http.ResponseWriter, *http.Request) {
 	return func(response http.ResponseWriter, req *http.Request) {
 		traceID := utils.RandTraceID()
 		ctx, insLog := inslogger.WithTraceField(context.Background(), traceID)
 
-		ctx, span := instracer.StartSpan(ctx, "callHandler")
+		ctx, span := instracer.StartSpan(ctx, name)
 		defer span.End()
 
 		params := Request{}
@@ -140,7 +233,7 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 
 		resp.TraceID = traceID
 
-		insLog.Infof("[ callHandler ] Incoming request: %s", req.RequestURI)
+		insLog.Infof("[ %s ] Incoming request: %s", name, req.RequestURI)
 
 		defer func() {
 			res, err := json.MarshalIndent(resp, "", "    ")
@@ -154,6 +247,11 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 			}
 		}()
 
+		if !ar.rateLimits.allow(limitAddress, name, remoteHost(req)) {
+			resp.Error = rateLimitMessage(limitAddress)
+			return
+		}
+
 		_, err := UnmarshalRequest(req, &params)
 		if err != nil {
 			processError(err, "Can't unmarshal request", &resp, insLog)
@@ -169,33 +267,12 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 			ctx = inslogger.WithLoggerLevel(ctx, logLevelNumber)
 		}
 
-		err = ar.checkSeed(params.Seed)
+		result, err := ar.checkSeedAndCall(ctx, name, params, ar.checkSeed, call)
 		if err != nil {
-			processError(err, "Can't checkSeed", &resp, insLog)
+			processError(err, "Can't makeCall", &resp, insLog)
 			return
 		}
 
-		var result interface{}
-		ch := make(chan interface{}, 1)
-		go func() {
-			result, err = ar.makeCall(ctx, params)
-			ch <- nil
-		}()
-		select {
-
-		case <-ch:
-			if err != nil {
-				processError(err, "Can't makeCall", &resp, insLog)
-				return
-			}
-			resp.Result = result
-
-		case <-time.After(time.Duration(ar.cfg.Timeout) * time.Second):
-			resp.Error = "Messagebus timeout exceeded"
-			return
-
-		}
-
 		resp.Result = result
 	}
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -31,18 +32,23 @@ import (
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
 const CallUrl = "http://localhost:19192/api/call"
+const BatchCallUrl = "http://localhost:19192/api/call/batch"
+const SubmitCallUrl = "http://localhost:19192/api/call/submit"
 
 type TimeoutSuite struct {
 	suite.Suite
-	ctx   context.Context
-	api   *Runner
-	user  *requester.UserConfigJSON
-	delay bool
+	ctx     context.Context
+	api     *Runner
+	user    *requester.UserConfigJSON
+	delay   bool
+	request insolar.Reference
+	calls   uint64
 }
 
 type APIresp struct {
@@ -50,6 +56,11 @@ type APIresp struct {
 	Error  string
 }
 
+type APIBatchResp struct {
+	Results []APIresp
+	Error   string
+}
+
 func (suite *TimeoutSuite) TestRunner_callHandler() {
 	seed, err := suite.api.SeedGenerator.Next()
 	suite.NoError(err)
@@ -93,6 +104,185 @@ func (suite *TimeoutSuite) TestRunner_callHandlerTimeout() {
 	suite.Equal("", result.Result)
 }
 
+func (suite *TimeoutSuite) TestRunner_batchCallHandler() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	resp, err := requester.SendBatchWithSeed(
+		suite.ctx,
+		BatchCallUrl,
+		suite.user,
+		[]*requester.RequestConfigJSON{{Method: "first"}, {Method: "second"}},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result APIBatchResp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Equal("", result.Error)
+	suite.Require().Len(result.Results, 2)
+	for _, r := range result.Results {
+		suite.Equal("", r.Error)
+		suite.Equal("OK", r.Result)
+	}
+}
+
+func (suite *TimeoutSuite) TestRunner_batchCallHandlerRepeatedItem() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	resp, err := requester.SendBatchWithSeed(
+		suite.ctx,
+		BatchCallUrl,
+		suite.user,
+		[]*requester.RequestConfigJSON{{Method: "first"}, {Method: "first"}},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result APIBatchResp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Require().Len(result.Results, 2)
+	var ok, repeated int
+	for _, r := range result.Results {
+		switch r.Error {
+		case "":
+			ok++
+		case "[ checkSeed ] Request is repeated with the same seed":
+			repeated++
+		}
+	}
+	suite.Equal(1, ok)
+	suite.Equal(1, repeated)
+}
+
+func (suite *TimeoutSuite) TestRunner_batchCallHandlerBadSeed() {
+	resp, err := requester.SendBatchWithSeed(
+		suite.ctx,
+		BatchCallUrl,
+		suite.user,
+		[]*requester.RequestConfigJSON{{Method: "first"}},
+		[]byte("bad seed"),
+	)
+	suite.NoError(err)
+
+	var result APIBatchResp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Equal("", result.Error)
+	suite.Require().Len(result.Results, 1)
+	suite.Equal("[ checkSeed ] Bad seed param", result.Results[0].Error)
+	suite.Equal("", result.Results[0].Result)
+}
+
+func (suite *TimeoutSuite) TestRunner_batchCallHandlerBadSignature() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	ks := platformpolicy.NewKeyProcessor()
+	sKey, err := ks.GeneratePrivateKey()
+	suite.NoError(err)
+	sKeyString, err := ks.ExportPrivateKeyPEM(sKey)
+	suite.NoError(err)
+	stranger, err := requester.CreateUserConfig(suite.user.Caller, string(sKeyString))
+	suite.NoError(err)
+
+	resp, err := requester.SendBatchWithSeed(
+		suite.ctx,
+		BatchCallUrl,
+		stranger,
+		[]*requester.RequestConfigJSON{{Method: "first"}},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result APIBatchResp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Require().Len(result.Results, 1)
+	suite.Equal("[ verifySignature ] Incorrect signature", result.Results[0].Error)
+
+	// Seed is not used by request with bad signature.
+	ok, err := suite.api.SeedManager.Exists(*seed)
+	suite.NoError(err)
+	suite.True(ok)
+}
+
+func (suite *TimeoutSuite) TestRunner_submitHandler() {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	resp, err := requester.SendWithSeed(
+		suite.ctx,
+		SubmitCallUrl,
+		suite.user,
+		&requester.RequestConfigJSON{},
+		seed[:],
+	)
+	suite.NoError(err)
+
+	var result APIresp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Equal("", result.Error)
+	suite.Equal(suite.request.String(), result.Result)
+}
+
+func (suite *TimeoutSuite) sendWithNewSeed(reqCfg *requester.RequestConfigJSON) APIresp {
+	seed, err := suite.api.SeedGenerator.Next()
+	suite.NoError(err)
+	suite.api.SeedManager.Add(*seed)
+
+	resp, err := requester.SendWithSeed(suite.ctx, CallUrl, suite.user, reqCfg, seed[:])
+	suite.NoError(err)
+
+	var result APIresp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	return result
+}
+
+func (suite *TimeoutSuite) TestRunner_callHandlerIdempotencyKey() {
+	reqCfg := &requester.RequestConfigJSON{Method: "idempotent", IdempotencyKey: testutils.RandomString()}
+	calls := atomic.LoadUint64(&suite.calls)
+
+	result := suite.sendWithNewSeed(reqCfg)
+	suite.Equal("", result.Error)
+	suite.Equal("OK", result.Result)
+
+	// Repeated request gets the original result without execution.
+	result = suite.sendWithNewSeed(reqCfg)
+	suite.Equal("", result.Error)
+	suite.Equal("OK", result.Result)
+	suite.Equal(calls+1, atomic.LoadUint64(&suite.calls))
+
+	result = suite.sendWithNewSeed(&requester.RequestConfigJSON{Method: "other", IdempotencyKey: reqCfg.IdempotencyKey})
+	suite.Contains(result.Error, "Idempotency key is already used for another request")
+	suite.Equal(calls+1, atomic.LoadUint64(&suite.calls))
+}
+
+func (suite *TimeoutSuite) TestRunner_callHandlerIdempotencyKeyBadSeed() {
+	reqCfg := &requester.RequestConfigJSON{Method: "idempotent", IdempotencyKey: testutils.RandomString()}
+
+	resp, err := requester.SendWithSeed(suite.ctx, CallUrl, suite.user, reqCfg, []byte("bad seed"))
+	suite.NoError(err)
+	var result APIresp
+	err = json.Unmarshal(resp, &result)
+	suite.NoError(err)
+	suite.Equal("[ checkSeed ] Bad seed param", result.Error)
+
+	// Key is released, because request with bad seed is not executed.
+	result = suite.sendWithNewSeed(reqCfg)
+	suite.Equal("", result.Error)
+	suite.Equal("OK", result.Result)
+}
+
 func TestTimeoutSuite(t *testing.T) {
 	timeoutSuite := new(TimeoutSuite)
 	timeoutSuite.ctx, _ = inslogger.WithTraceField(context.Background(), "APItests")
@@ -113,6 +303,7 @@ func TestTimeoutSuite(t *testing.T) {
 	cfg := configuration.NewAPIRunner()
 	cfg.Address = "localhost:19192"
 	cfg.Timeout = 1
+	cfg.Subscribe = ""
 	timeoutSuite.api, err = NewRunner(&cfg)
 	require.NoError(t, err)
 
@@ -127,30 +318,45 @@ func TestTimeoutSuite(t *testing.T) {
 		return cert
 	}
 
-	cr := testutils.NewContractRequesterMock(t)
-	cr.SendRequestFunc = func(p context.Context, p1 *insolar.Reference, method string, p3 []interface{}) (insolar.Reply, error) {
+	queries := testutils.NewQueryExecutorMock(t)
+	queries.QueryFunc = func(
+		_ context.Context, _ insolar.Reference, method string, _ insolar.Arguments,
+	) (*insolar.QueryResult, error) {
+		var contractErr *foundation.Error
+		var data []byte
 		switch method {
 		case "GetPublicKey":
-			var result = string(pKeyString)
-			var contractErr *foundation.Error
-			data, _ := insolar.MarshalArgs(result, contractErr)
-			return &reply.CallMethod{
-				Result: data,
-			}, nil
+			data, _ = insolar.MarshalArgs(string(pKeyString), contractErr)
+		case "GetPublicKeys":
+			var result []string
+			data, _ = insolar.MarshalArgs(result, contractErr)
 		default:
-			if timeoutSuite.delay {
-				time.Sleep(time.Second * 21)
-			}
-			var result = "OK"
-			var contractErr *foundation.Error
-			data, _ := insolar.MarshalArgs(result, contractErr)
-			return &reply.CallMethod{
-				Result: data,
-			}, nil
+			return nil, errors.Errorf("unexpected query %s", method)
 		}
+		return &insolar.QueryResult{Result: data}, nil
+	}
+
+	cr := testutils.NewContractRequesterMock(t)
+	cr.SendRequestFunc = func(p context.Context, p1 *insolar.Reference, method string, p3 []interface{}) (insolar.Reply, error) {
+		atomic.AddUint64(&timeoutSuite.calls, 1)
+		if timeoutSuite.delay {
+			time.Sleep(time.Second * 21)
+		}
+		var result = "OK"
+		var contractErr *foundation.Error
+		data, _ := insolar.MarshalArgs(result, contractErr)
+		return &reply.CallMethod{
+			Result: data,
+		}, nil
+	}
+
+	timeoutSuite.request = testutils.RandomRef()
+	cr.CallFunc = func(p context.Context, p1 insolar.Message) (insolar.Reply, error) {
+		return &reply.RegisterRequest{Request: timeoutSuite.request}, nil
 	}
 
 	timeoutSuite.api.ContractRequester = cr
+	timeoutSuite.api.Queries = queries
 	timeoutSuite.api.CertificateManager = cm
 	timeoutSuite.api.Start(timeoutSuite.ctx)
 
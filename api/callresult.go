@@ -0,0 +1,210 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+const (
+	// CallStatusPending is a status of request, that has no result yet.
+	CallStatusPending = "pending"
+	// CallStatusDone is a status of request, that has registered result.
+	CallStatusDone = "done"
+)
+
+// CallArgs is arguments that Call service accepts. Results are available to the member, that submitted request, only.
+// Member signs serialized Reference with seed the same way as it signs calls, method is a name of service method.
+type CallArgs struct {
+	Reference string
+	Caller    string
+	Seed      []byte
+	Signature []byte
+}
+
+// CallStatusReply is reply for Call service Status requests.
+type CallStatusReply struct {
+	Status  string
+	TraceID string
+}
+
+// CallResultReply is reply for Call service Result requests.
+type CallResultReply struct {
+	Result  interface{}
+	Error   string
+	TraceID string
+}
+
+// CallService is a service that provides API for fetching results of submitted requests.
+type CallService struct {
+	runner *Runner
+}
+
+// NewCallService creates new Call service instance.
+func NewCallService(runner *Runner) *CallService {
+	return &CallService{runner: runner}
+}
+
+// fetchResult checks signature of caller and returns result of request, if the request was sent to the caller.
+func (s *CallService) fetchResult(ctx context.Context, method string, args *CallArgs) (*record.Result, error) {
+	request, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse reference")
+	}
+
+	params, err := insolar.MarshalArgs(args.Reference)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal signed params")
+	}
+	caller, err := s.runner.verifySignature(ctx, Request{
+		Reference: args.Caller,
+		Method:    method,
+		Params:    params,
+		Seed:      args.Seed,
+		Signature: args.Signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = s.runner.checkSeed(args.Seed)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.runner.ArtifactManager.GetResult(ctx, *request)
+	if err == insolar.ErrNoResult {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result")
+	}
+	if res.Object != *caller.Record() {
+		return nil, errors.New("request is not sent by caller")
+	}
+
+	return res, nil
+}
+
+// Status returns status of request submitted to call submit endpoint.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "call.Status",
+//     "params": {
+//       "Reference": str, // request reference returned by submit
+//       "Caller": str, // reference of member, that submitted request
+//       "Seed": str, // base64 encoded seed
+//       "Signature": str // base64 encoded signature of serialized Reference with method name and seed
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {
+//       "Status": str, // "pending" or "done"
+//       "TraceID": str
+//     },
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *CallService) Status(r *http.Request, args *CallArgs, reply *CallStatusReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ CallService.Status ] Incoming request: %s", r.RequestURI)
+
+	res, err := s.fetchResult(ctx, "call.Status", args)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ CallService.Status ]"))
+		return errors.Wrap(err, "[ CallService.Status ]")
+	}
+
+	reply.Status = CallStatusPending
+	if res != nil {
+		reply.Status = CallStatusDone
+	}
+	reply.TraceID = traceID
+
+	return nil
+}
+
+// Result returns result of request submitted to call submit endpoint. Error is returned if request is still pending.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "call.Result",
+//     "params": {
+//       "Reference": str, // request reference returned by submit
+//       "Caller": str, // reference of member, that submitted request
+//       "Seed": str, // base64 encoded seed
+//       "Signature": str // base64 encoded signature of serialized Reference with method name and seed
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {
+//       "Result": any, // result of called method
+//       "Error": str, // error of called method
+//       "TraceID": str
+//     },
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *CallService) Result(r *http.Request, args *CallArgs, reply *CallResultReply) error {
+	traceID := utils.RandTraceID()
+	ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+	inslog.Infof("[ CallService.Result ] Incoming request: %s", r.RequestURI)
+
+	res, err := s.fetchResult(ctx, "call.Result", args)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ CallService.Result ]"))
+		return errors.Wrap(err, "[ CallService.Result ]")
+	}
+	if res == nil {
+		return errors.New("[ CallService.Result ] request is pending")
+	}
+
+	result, contractErr, err := extractor.CallResponse(res.Payload)
+	if err != nil {
+		return errors.Wrap(err, "[ CallService.Result ] Can't extract response")
+	}
+
+	reply.Result = result
+	if contractErr != nil {
+		reply.Error = contractErr.S
+	}
+	reply.TraceID = traceID
+
+	return nil
+}
@@ -160,6 +160,8 @@ type CallMethodArgs struct {
 	ObjectRefString string
 	Method          string
 	MethodArgs      []interface{}
+	// PrototypeRefString is optional, object is migrated to the prototype if it's a successor of object's prototype
+	PrototypeRefString string
 }
 
 // CallMethodReply is reply that Contract.CallMethod returns
@@ -194,6 +196,13 @@ func (s *ContractService) CallMethod(r *http.Request, args *CallMethodArgs, re *
 		},
 	}
 
+	if len(args.PrototypeRefString) != 0 {
+		msg.Prototype, err = insolar.NewReferenceFromBase58(args.PrototypeRefString)
+		if err != nil {
+			return errors.Wrap(err, "can't get protoRef")
+		}
+	}
+
 	callMethodReply, err := s.runner.ContractRequester.Call(ctx, msg)
 	if err != nil {
 		return errors.Wrap(err, "CallMethod failed with error")
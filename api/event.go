@@ -0,0 +1,152 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+const (
+	defaultEventsLimit = 20
+	maxEventsLimit     = 100
+)
+
+// EventFilterArgs is arguments that Event service accepts for Filter method.
+type EventFilterArgs struct {
+	Reference string
+	Name      string
+	FromPulse uint32
+	Limit     int
+}
+
+// EventFilterReply is reply for Event service Filter requests.
+type EventFilterReply struct {
+	Events    []Event
+	NextPulse uint32
+}
+
+// Event is an event emitted by a contract.
+type Event struct {
+	Contract    string
+	Name        string
+	Request     string
+	Result      string
+	PulseNumber uint32
+	Data        []byte
+}
+
+func newEvent(contract insolar.Reference, e artifacts.Event) Event {
+	return Event{
+		Contract:    contract.String(),
+		Name:        e.Name,
+		Request:     e.Request.String(),
+		Result:      e.Result.String(),
+		PulseNumber: uint32(e.Result.Pulse()),
+		Data:        e.Data,
+	}
+}
+
+// EventService is a service that provides API for reading events emitted by contracts.
+type EventService struct {
+	runner *Runner
+}
+
+// NewEventService creates new Event service instance.
+func NewEventService(runner *Runner) *EventService {
+	return &EventService{runner: runner}
+}
+
+// Filter returns events with provided name emitted by a contract starting from provided pulse. Events of one pulse
+// are never split between replies, so more than Limit events can be returned. Returned NextPulse should be passed as
+// FromPulse to the next call, it's zero when there are no more events. Events become available after their pulse is
+// replicated to heavy.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "event.Filter",
+//     "params": {
+//       "Reference": str, // contract reference
+//       "Name": str, // event name
+//       "FromPulse": int, // pulse to start from, optional
+//       "Limit": int // max count of events in reply
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {
+//       "Events": [{
+//         "Contract": str,
+//         "Name": str,
+//         "Request": str, // request, that emitted the event
+//         "Result": str, // result record, that holds the event
+//         "PulseNumber": int,
+//         "Data": str // base64 encoded serialized payload
+//       }],
+//       "NextPulse": int // cursor for the next request
+//     },
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *EventService) Filter(r *http.Request, args *EventFilterArgs, reply *EventFilterReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ EventService.Filter ] Incoming request: %s", r.RequestURI)
+
+	contract, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ EventService.Filter ] failed to parse reference")
+	}
+	if args.Name == "" {
+		return errors.New("[ EventService.Filter ] event name is required")
+	}
+
+	limit := args.Limit
+	if limit <= 0 {
+		limit = defaultEventsLimit
+	}
+	if limit > maxEventsLimit {
+		limit = maxEventsLimit
+	}
+
+	events, next, err := s.runner.ArtifactManager.GetEvents(
+		ctx, *contract, args.Name, insolar.PulseNumber(args.FromPulse), limit,
+	)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ EventService.Filter ] failed to fetch events"))
+		return errors.Wrap(err, "[ EventService.Filter ] failed to fetch events")
+	}
+
+	reply.Events = make([]Event, 0, len(events))
+	for _, e := range events {
+		reply.Events = append(reply.Events, newEvent(*contract, e))
+	}
+	reply.NextPulse = uint32(next)
+
+	return nil
+}
@@ -0,0 +1,174 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+const (
+	defaultExportSize = 10
+	maxExportSize     = 100
+)
+
+// ExporterArgs is arguments that Exporter service accepts.
+type ExporterArgs struct {
+	PulseNumber uint32
+	Size        int
+}
+
+// ExporterReply is reply for Exporter service requests.
+type ExporterReply struct {
+	Pulses          []ExportedPulse
+	NextPulseNumber uint32
+}
+
+// ExportedPulse holds data stored on heavy for a pulse.
+type ExportedPulse struct {
+	PulseNumber    uint32
+	PulseTimestamp int64
+	Entropy        []byte
+	Records        []ExportedRecord
+	Blobs          []ExportedRecord
+	Indexes        []ExportedRecord
+	Drops          []ExportedDrop
+}
+
+// ExportedRecord is a serialized record, blob or index bucket with its id.
+type ExportedRecord struct {
+	ID   string
+	Data []byte
+}
+
+// ExportedDrop is a jet drop of a pulse.
+type ExportedDrop struct {
+	JetID    string
+	Hash     []byte
+	PrevHash []byte
+	Size     uint64
+}
+
+// ExporterService is a service that provides API for exporting finalized pulses.
+type ExporterService struct {
+	runner *Runner
+}
+
+// NewExporterService creates new Exporter service instance.
+func NewExporterService(runner *Runner) *ExporterService {
+	return &ExporterService{runner: runner}
+}
+
+// Export returns data of finalized pulses starting from provided pulse number.
+// Zero pulse number means the beginning of the chain. Returned NextPulseNumber should be passed to the next call.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "exporter.Export",
+//     "params": {
+//       "PulseNumber": int, // pulse number to start from
+//       "Size": int // max count of pulses in reply
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {
+//       "Pulses": [{
+//         "PulseNumber": int,
+//         "PulseTimestamp": int,
+//         "Entropy": str, // base64 encoded entropy
+//         "Records": [{"ID": str, "Data": str}], // base64 encoded record.Material
+//         "Blobs": [{"ID": str, "Data": str}], // base64 encoded blob value
+//         "Indexes": [{"ID": str, "Data": str}], // base64 encoded object.IndexBucket
+//         "Drops": [{"JetID": str, "Hash": str, "PrevHash": str, "Size": int}]
+//       }],
+//       "NextPulseNumber": int // cursor for the next request
+//     },
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *ExporterService) Export(r *http.Request, args *ExporterArgs, reply *ExporterReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ExporterService.Export ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Exporter == nil {
+		return errors.New("[ ExporterService.Export ] exporter is not available on this node")
+	}
+
+	size := args.Size
+	if size == 0 {
+		size = defaultExportSize
+	}
+	if size > maxExportSize {
+		size = maxExportSize
+	}
+
+	page, err := s.runner.Exporter.Export(ctx, insolar.PulseNumber(args.PulseNumber), size)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ ExporterService.Export ] failed to export pulses"))
+		return errors.Wrap(err, "[ ExporterService.Export ] failed to export pulses")
+	}
+
+	reply.NextPulseNumber = uint32(page.NextPulseNumber)
+	reply.Pulses = make([]ExportedPulse, 0, len(page.Pulses))
+	for _, data := range page.Pulses {
+		pulse := ExportedPulse{
+			PulseNumber:    uint32(data.Pulse.PulseNumber),
+			PulseTimestamp: data.Pulse.PulseTimestamp,
+			Entropy:        data.Pulse.Entropy[:],
+		}
+		for _, rec := range data.Records {
+			buf, err := rec.Record.Marshal()
+			if err != nil {
+				return errors.Wrap(err, "[ ExporterService.Export ] failed to serialize record")
+			}
+			pulse.Records = append(pulse.Records, ExportedRecord{ID: rec.ID.String(), Data: buf})
+		}
+		for _, b := range data.Blobs {
+			pulse.Blobs = append(pulse.Blobs, ExportedRecord{ID: b.ID.String(), Data: b.Blob.Value})
+		}
+		for _, buck := range data.Indexes {
+			buf, err := buck.Marshal()
+			if err != nil {
+				return errors.Wrap(err, "[ ExporterService.Export ] failed to serialize index")
+			}
+			pulse.Indexes = append(pulse.Indexes, ExportedRecord{ID: buck.ObjID.String(), Data: buf})
+		}
+		for _, dr := range data.Drops {
+			pulse.Drops = append(pulse.Drops, ExportedDrop{
+				JetID:    dr.JetID.DebugString(),
+				Hash:     dr.Hash,
+				PrevHash: dr.PrevHash,
+				Size:     dr.Size,
+			})
+		}
+		reply.Pulses = append(reply.Pulses, pulse)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/api/seedmanager"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// idempotencyEntry is stored for idempotency key of request.
+type idempotencyEntry struct {
+	// Fingerprint protects from reusing the key for another request.
+	Fingerprint []byte
+	Done        bool
+	Result      interface{}
+	Error       string
+}
+
+// fingerprint identifies request regardless of seed and signature, so client may resign repeated request.
+func fingerprint(params Request) []byte {
+	h := sha256.New()
+	h.Write([]byte(params.Method))
+	h.Write(params.Params)
+	return h.Sum(nil)
+}
+
+// checkSeedAndCall verifies signature, takes a token of member limit of endpoint, checks seed with checkSeed and runs
+// call with timeout. Requests with idempotency key are executed once. Repeated requests with the same key of the same
+// member must be signed too, but their seeds are not checked, and they get the result of the first one.
+func (ar *Runner) checkSeedAndCall(
+	ctx context.Context,
+	endpoint string,
+	params Request,
+	checkSeed func([]byte) error,
+	call func(context.Context, Request) (interface{}, error),
+) (interface{}, error) {
+	// Signature is checked before idempotency key is used, so nobody can reserve keys of the member or get results of
+	// its requests.
+	member, err := ar.verifySignature(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	// Member limit is taken after verification, so nobody can spend the limit of another member.
+	if !ar.rateLimits.allow(limitMember, endpoint, member.String()) {
+		return nil, errors.New(rateLimitMessage(limitMember))
+	}
+
+	if len(params.IdempotencyKey) == 0 {
+		err = checkSeed(params.Seed)
+		if err != nil {
+			return nil, err
+		}
+		return ar.callWithTimeout(ctx, params, call)
+	}
+
+	// Keys are scoped by verified member, so different members can't get results of each other.
+	key := member.String() + ":" + params.IdempotencyKey
+	entry := idempotencyEntry{Fingerprint: fingerprint(params)}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ checkSeedAndCall ] Can't marshal idempotency entry")
+	}
+
+	reserved, err := ar.idempotencyKeys.SetKeyIfAbsent(key, data, ar.cfg.Storage.IdempotencyTTL)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ checkSeedAndCall ] Can't reserve idempotency key")
+	}
+	if !reserved {
+		return ar.repeatedResult(key, entry.Fingerprint)
+	}
+
+	err = checkSeed(params.Seed)
+	if err != nil {
+		// Request was not executed, so the key can be used again.
+		if delErr := ar.idempotencyKeys.DeleteKey(key); delErr != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(delErr, "[ checkSeedAndCall ] Can't release idempotency key"))
+		}
+		return nil, err
+	}
+
+	return ar.callWithTimeout(ctx, params, func(ctx context.Context, params Request) (interface{}, error) {
+		// Result is stored even if client stopped waiting for it because of timeout.
+		result, err := call(ctx, params)
+		entry.Done = true
+		entry.Result = result
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		ar.storeIdempotencyEntry(ctx, key, entry)
+		return result, err
+	})
+}
+
+func (ar *Runner) storeIdempotencyEntry(ctx context.Context, key string, entry idempotencyEntry) {
+	data, err := json.Marshal(entry)
+	if err == nil {
+		err = ar.idempotencyKeys.SetKey(key, data, ar.cfg.Storage.IdempotencyTTL)
+	}
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "[ storeIdempotencyEntry ] Can't store result"))
+	}
+}
+
+// repeatedResult returns stored result of request with the same idempotency key.
+func (ar *Runner) repeatedResult(key string, fp []byte) (interface{}, error) {
+	data, err := ar.idempotencyKeys.GetKey(key)
+	if err == seedmanager.ErrNotFound {
+		return nil, errors.New("[ repeatedResult ] Idempotency key is expired, retry request")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "[ repeatedResult ] Can't get idempotency key")
+	}
+
+	entry := idempotencyEntry{}
+	err = json.Unmarshal(data, &entry)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ repeatedResult ] Can't unmarshal idempotency entry")
+	}
+	if !bytes.Equal(entry.Fingerprint, fp) {
+		return nil, errors.New("[ repeatedResult ] Idempotency key is already used for another request")
+	}
+	if !entry.Done {
+		return nil, errors.New("[ repeatedResult ] Request with the same idempotency key is in progress")
+	}
+	if len(entry.Error) > 0 {
+		return nil, errors.New(entry.Error)
+	}
+	return entry.Result, nil
+}
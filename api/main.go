@@ -19,6 +19,7 @@ package api
 import (
 	"context"
 	"crypto"
+	"crypto/subtle"
 	"fmt"
 	"net"
 	"net/http"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/insolar/insolar/network"
 
+	"github.com/go-redis/redis"
 	"github.com/gorilla/rpc/v2"
 	jsonrpc "github.com/gorilla/rpc/v2/json2"
 	"github.com/insolar/insolar/application/extractor"
@@ -39,10 +41,16 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
+	"github.com/insolar/insolar/ledger/heavy/snapshot"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/logicrunner/artifacts"
 	"github.com/insolar/insolar/platformpolicy"
 )
 
+// AdminTokenHeader is a header of administrative requests, that holds admin token from API configuration.
+const AdminTokenHeader = "X-Insolar-Admin-Token"
+
 // Runner implements Component for API
 type Runner struct {
 	CertificateManager  insolar.CertificateManager  `inject:""`
@@ -58,8 +66,19 @@ type Runner struct {
 	cfg                 *configuration.APIRunner
 	keyCache            map[string]crypto.PublicKey
 	cacheLock           *sync.RWMutex
+	subscriptions       *subscriptionHub
+	rateLimits          *rateLimits
+	idempotencyKeys     seedmanager.Storage
 	SeedManager         *seedmanager.SeedManager
 	SeedGenerator       seedmanager.SeedGenerator
+	// Exporter is set on heavy material nodes only.
+	Exporter exporter.Exporter
+	// Snapshots is set on heavy material nodes only.
+	Snapshots snapshot.Saver
+	// Replication is set on light material nodes only.
+	Replication replication.StatusAccessor
+	// Queries is set on virtual nodes only.
+	Queries insolar.QueryExecutor
 }
 
 func checkConfig(cfg *configuration.APIRunner) error {
@@ -78,6 +97,9 @@ func checkConfig(cfg *configuration.APIRunner) error {
 	if cfg.Timeout == 0 {
 		return errors.New("[ checkConfig ] Timeout must not be null")
 	}
+	if len(cfg.Subscribe) > 0 && cfg.SubscribePeriod <= 0 {
+		return errors.New("[ checkConfig ] SubscribePeriod must be positive")
+	}
 
 	return nil
 }
@@ -108,6 +130,46 @@ func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: contract")
 	}
 
+	err = rpcServer.RegisterService(NewCallService(ar), "call")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: call")
+	}
+
+	err = rpcServer.RegisterService(NewObjectService(ar), "object")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: object")
+	}
+
+	err = rpcServer.RegisterService(NewEventService(ar), "event")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: event")
+	}
+
+	err = rpcServer.RegisterService(NewQueryService(ar), "query")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: query")
+	}
+
+	err = rpcServer.RegisterService(NewExporterService(ar), "exporter")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: exporter")
+	}
+
+	err = rpcServer.RegisterService(NewSnapshotService(ar), "snapshot")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: snapshot")
+	}
+
+	err = rpcServer.RegisterService(NewReplicationService(ar), "replication")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: replication")
+	}
+
+	err = rpcServer.RegisterService(NewPrototypeService(ar), "prototype")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: prototype")
+	}
+
 	return nil
 }
 
@@ -121,11 +183,13 @@ func NewRunner(cfg *configuration.APIRunner) (*Runner, error) {
 	addrStr := fmt.Sprint(cfg.Address)
 	rpcServer := rpc.NewServer()
 	ar := Runner{
-		server:    &http.Server{Addr: addrStr},
-		rpcServer: rpcServer,
-		cfg:       cfg,
-		keyCache:  make(map[string]crypto.PublicKey),
-		cacheLock: &sync.RWMutex{},
+		server:        &http.Server{Addr: addrStr},
+		rpcServer:     rpcServer,
+		cfg:           cfg,
+		keyCache:      make(map[string]crypto.PublicKey),
+		cacheLock:     &sync.RWMutex{},
+		subscriptions: newSubscriptionHub(),
+		rateLimits:    newRateLimits(cfg.RateLimits),
 	}
 
 	rpcServer.RegisterCodec(jsonrpc.NewCodec(), "application/json")
@@ -142,13 +206,45 @@ func (ar *Runner) IsAPIRunner() bool {
 	return true
 }
 
+// newStorage creates storage for seeds and idempotency keys. Redis storage is shared between API runners.
+func (ar *Runner) newStorage() (seedmanager.Storage, error) {
+	if len(ar.cfg.Storage.RedisAddress) == 0 {
+		return seedmanager.NewMemoryStorage(seedmanager.DefaultCleanPeriod), nil
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:     ar.cfg.Storage.RedisAddress,
+		Password: ar.cfg.Storage.RedisPassword,
+		DB:       ar.cfg.Storage.RedisDB,
+	})
+	if err := client.Ping().Err(); err != nil {
+		return nil, errors.Wrap(err, "[ newStorage ] Can't connect to redis")
+	}
+	return seedmanager.NewRedisStorage(client), nil
+}
+
 // Start runs api server
 func (ar *Runner) Start(ctx context.Context) error {
 	hc := NewHealthChecker(ar.CertificateManager, ar.NodeNetwork)
 	http.HandleFunc("/healthcheck", hc.CheckHandler)
-	ar.SeedManager = seedmanager.New()
+	storage, err := ar.newStorage()
+	if err != nil {
+		return errors.Wrap(err, "Can't create storage")
+	}
+	ar.SeedManager = seedmanager.NewWithStorage(storage, seedmanager.DefaultTTL)
+	ar.idempotencyKeys = storage
 	http.HandleFunc(ar.cfg.Call, ar.callHandler())
-	http.Handle(ar.cfg.RPC, ar.rpcServer)
+	if len(ar.cfg.BatchCall) > 0 {
+		http.HandleFunc(ar.cfg.BatchCall, ar.batchCallHandler())
+	}
+	if len(ar.cfg.SubmitCall) > 0 {
+		http.HandleFunc(ar.cfg.SubmitCall, ar.submitHandler())
+	}
+	if len(ar.cfg.Subscribe) > 0 {
+		http.HandleFunc(ar.cfg.Subscribe, ar.subscribeHandler())
+		go ar.subscriptions.run(ctx, ar, ar.cfg.SubscribePeriod)
+	}
+	http.Handle(ar.cfg.RPC, ar.rpcRateLimitHandler(ar.rpcServer))
 	inslog := inslogger.FromContext(ctx)
 	inslog.Info("Starting ApiRunner ...")
 	inslog.Info("Config: ", ar.cfg)
@@ -169,6 +265,7 @@ func (ar *Runner) Stop(ctx context.Context) error {
 	const timeOut = 5
 
 	inslogger.FromContext(ctx).Infof("Shutting down server gracefully ...(waiting for %d seconds)", timeOut)
+	ar.subscriptions.stop()
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeOut)*time.Second)
 	defer cancel()
 	err := ar.server.Shutdown(ctxWithTimeout)
@@ -179,6 +276,19 @@ func (ar *Runner) Stop(ctx context.Context) error {
 	return nil
 }
 
+// checkAdmin checks that request is authorized with admin token. All administrative requests are rejected if the token
+// is not configured.
+func (ar *Runner) checkAdmin(r *http.Request) error {
+	if len(ar.cfg.AdminToken) == 0 {
+		return errors.New("administrative requests are disabled")
+	}
+	token := r.Header.Get(AdminTokenHeader)
+	if subtle.ConstantTimeCompare([]byte(token), []byte(ar.cfg.AdminToken)) != 1 {
+		return errors.New("admin token is invalid")
+	}
+	return nil
+}
+
 func (ar *Runner) getMemberPubKey(ctx context.Context, ref string) (crypto.PublicKey, error) { //nolint
 	ar.cacheLock.RLock()
 	publicKey, ok := ar.keyCache[ref]
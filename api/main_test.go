@@ -100,6 +100,7 @@ func TestMainTestSuite(t *testing.T) {
 	ctx, _ := inslogger.WithTraceField(context.Background(), "APItests")
 	http.DefaultServeMux = new(http.ServeMux)
 	cfg := configuration.NewAPIRunner()
+	cfg.Subscribe = ""
 	api, _ := NewRunner(&cfg)
 
 	cm := certificate.NewCertificateManager(&certificate.Certificate{})
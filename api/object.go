@@ -0,0 +1,157 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+// ObjectHistoryArgs is arguments that Object service accepts for History method.
+type ObjectHistoryArgs struct {
+	Reference string
+	State     string
+	Limit     int
+}
+
+// ObjectHistoryReply is reply for Object service History requests.
+type ObjectHistoryReply struct {
+	States    []ObjectState
+	NextState string
+}
+
+// ObjectState is a state of an object.
+type ObjectState struct {
+	ID          string
+	PulseNumber uint32
+	Request     string
+	Prototype   string
+	// Memory is returned to administrative requests only.
+	Memory []byte `json:",omitempty"`
+}
+
+// ObjectService is a service that provides API for reading objects.
+type ObjectService struct {
+	runner *Runner
+}
+
+// NewObjectService creates new Object service instance.
+func NewObjectService(runner *Runner) *ObjectService {
+	return &ObjectService{runner: runner}
+}
+
+// History returns states of an object from newest to oldest. Empty State means the latest state of the object.
+// Returned NextState should be passed to the next call, it's empty when the first state of the object is reached.
+// Memory of states is private data of the object owner, so it's returned only if the request passes admin token in
+// X-Insolar-Admin-Token header.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "object.History",
+//     "params": {
+//       "Reference": str, // object reference
+//       "State": str, // state to start from, optional
+//       "Limit": int // max count of states in reply
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {
+//       "States": [{
+//         "ID": str,
+//         "PulseNumber": int,
+//         "Request": str, // request, that produced the state
+//         "Prototype": str,
+//         "Memory": str // base64 encoded object memory, for administrative requests only
+//       }],
+//       "NextState": str // cursor for the next request
+//     },
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *ObjectService) History(r *http.Request, args *ObjectHistoryArgs, reply *ObjectHistoryReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ObjectService.History ] Incoming request: %s", r.RequestURI)
+
+	head, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.History ] failed to parse reference")
+	}
+
+	var from *insolar.ID
+	if args.State != "" {
+		from, err = insolar.NewIDFromBase58(args.State)
+		if err != nil {
+			return errors.Wrap(err, "[ ObjectService.History ] failed to parse state")
+		}
+	}
+
+	limit := args.Limit
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	states, next, err := s.runner.ArtifactManager.GetHistory(ctx, *head, from, limit)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ ObjectService.History ] failed to fetch history"))
+		return errors.Wrap(err, "[ ObjectService.History ] failed to fetch history")
+	}
+
+	withMemory := s.runner.checkAdmin(r) == nil
+
+	reply.States = make([]ObjectState, 0, len(states))
+	for _, st := range states {
+		state := ObjectState{
+			ID:          st.ID.String(),
+			PulseNumber: uint32(st.ID.Pulse()),
+		}
+		if withMemory {
+			state.Memory = st.Memory
+		}
+		if st.Request != nil {
+			state.Request = st.Request.String()
+		}
+		if st.Prototype != nil {
+			state.Prototype = st.Prototype.String()
+		}
+		reply.States = append(reply.States, state)
+	}
+	if next != nil {
+		reply.NextState = next.String()
+	}
+
+	return nil
+}
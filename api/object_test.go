@@ -0,0 +1,71 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestObjectService_History(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	head := testutils.RandomRef()
+	request := testutils.RandomRef()
+	prototype := testutils.RandomRef()
+	state := artifacts.ObjectState{
+		ID:        testutils.RandomID(),
+		Request:   &request,
+		Prototype: &prototype,
+		Memory:    []byte{1, 2, 3},
+	}
+
+	am := artifacts.NewClientMock(mc)
+	am.GetHistoryMock.Return([]artifacts.ObjectState{state}, nil, nil)
+
+	runner := &Runner{
+		cfg:             &configuration.APIRunner{AdminToken: "secret"},
+		ArtifactManager: am,
+	}
+	s := NewObjectService(runner)
+	args := &ObjectHistoryArgs{Reference: head.String()}
+
+	r := httptest.NewRequest("POST", "/api/rpc", nil)
+	reply := &ObjectHistoryReply{}
+	require.NoError(t, s.History(r, args, reply))
+	require.Equal(t, []ObjectState{{
+		ID:          state.ID.String(),
+		PulseNumber: uint32(state.ID.Pulse()),
+		Request:     request.String(),
+		Prototype:   prototype.String(),
+	}}, reply.States, "memory is not returned to anonymous callers")
+	require.Empty(t, reply.NextState)
+
+	r.Header.Set(AdminTokenHeader, "secret")
+	reply = &ObjectHistoryReply{}
+	require.NoError(t, s.History(r, args, reply))
+	require.Len(t, reply.States, 1)
+	require.Equal(t, []byte{1, 2, 3}, reply.States[0].Memory)
+}
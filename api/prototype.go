@@ -0,0 +1,159 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+// DeclareSuccessorMethod is a method of signed request, that declares a successor of prototypes.
+const DeclareSuccessorMethod = "prototype.DeclareSuccessor"
+
+// DeclareSuccessorReply is reply for Prototype.DeclareSuccessor requests.
+type DeclareSuccessorReply struct{}
+
+// PrototypeService is a service that provides API for upgrading prototypes of objects.
+type PrototypeService struct {
+	runner *Runner
+}
+
+// NewPrototypeService creates new Prototype service instance.
+func NewPrototypeService(runner *Runner) *PrototypeService {
+	return &PrototypeService{runner: runner}
+}
+
+// DeclareSuccessor declares prototype a successor of other prototypes, so their objects are migrated to it on the
+// first call through its proxy. Request should be authorized with admin token from API configuration in
+// X-Insolar-Admin-Token header, and params are a request signed by the declarer like member calls. Declarer must be
+// the owner of every predecessor or the root member. Succession is authorized once here and stored in the prototype.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "prototype.DeclareSuccessor",
+//     "params": {
+//       "reference": str, // declarer member
+//       "method": "prototype.DeclareSuccessor",
+//       "params": str, // base64 of serialized arguments: prototype, predecessors ([]str), migration method
+//       "seed": str,
+//       "signature": str,
+//       "signatures": [str] // signatures of multi-signature member
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {},
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *PrototypeService) DeclareSuccessor(r *http.Request, args *Request, reply *DeclareSuccessorReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ PrototypeService.DeclareSuccessor ] Incoming request: %s", r.RequestURI)
+
+	err := s.runner.checkAdmin(r)
+	if err != nil {
+		inslog.Warn(errors.Wrap(err, "[ PrototypeService.DeclareSuccessor ] request is not authorized"))
+		return errors.Wrap(err, "[ PrototypeService.DeclareSuccessor ] request is not authorized")
+	}
+	// Signature of the member call can't be used to declare a successor.
+	if args.Method != DeclareSuccessorMethod {
+		return errors.Errorf(
+			"[ PrototypeService.DeclareSuccessor ] method of signed request must be %s", DeclareSuccessorMethod,
+		)
+	}
+
+	_, err = s.runner.checkSeedAndCall(ctx, DeclareSuccessorMethod, *args, s.runner.checkSeed, s.runner.declareSuccessor)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ PrototypeService.DeclareSuccessor ] failed to declare successor"))
+		return errors.Wrap(err, "[ PrototypeService.DeclareSuccessor ] failed to declare successor")
+	}
+	return nil
+}
+
+// declareSuccessor stores succession of signed request. Signature of the declarer is verified by the caller.
+func (ar *Runner) declareSuccessor(ctx context.Context, params Request) (interface{}, error) {
+	declarer, err := insolar.NewReferenceFromBase58(params.Reference)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ declareSuccessor ] Failed to parse params.Reference")
+	}
+
+	var (
+		prototypeStr    string
+		predecessorStrs []string
+		migrationMethod string
+	)
+	args := [3]interface{}{&prototypeStr, &predecessorStrs, &migrationMethod}
+	err = insolar.Deserialize(params.Params, &args)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ declareSuccessor ] Can't deserialize params")
+	}
+
+	prototype, err := insolar.NewReferenceFromBase58(prototypeStr)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ declareSuccessor ] Failed to parse prototype")
+	}
+	succession := artifacts.Succession{MigrationMethod: migrationMethod, Declarer: *declarer}
+	for _, predecessorStr := range predecessorStrs {
+		predecessor, err := insolar.NewReferenceFromBase58(predecessorStr)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ declareSuccessor ] Failed to parse predecessor")
+		}
+		succession.Predecessors = append(succession.Predecessors, *predecessor)
+	}
+
+	rootMember, err := ar.GenesisDataProvider.GetRootMember(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ declareSuccessor ] Can't get root member")
+	}
+
+	// Signed data is unique for every seed, so every declaration is registered as a separate request.
+	signed, err := insolar.MarshalArgs(*declarer, params.Method, params.Params, params.Seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ declareSuccessor ] Can't marshal signed data")
+	}
+	requestID, err := ar.ArtifactManager.RegisterRequest(ctx, record.Request{
+		Caller:    *declarer,
+		Object:    prototype,
+		Method:    params.Method,
+		Arguments: signed,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ declareSuccessor ] Can't register request")
+	}
+
+	err = artifacts.DeclareSuccessor(
+		ctx, ar.ArtifactManager,
+		insolar.Reference{}, *insolar.NewReference(insolar.ID{}, *requestID), *prototype, succession, *rootMember,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ declareSuccessor ] Can't declare successor")
+	}
+	return nil, nil
+}
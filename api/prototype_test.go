@@ -0,0 +1,123 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestPrototypeService_DeclareSuccessor(t *testing.T) {
+	runner := &Runner{cfg: &configuration.APIRunner{AdminToken: "secret"}}
+	s := NewPrototypeService(runner)
+
+	r := httptest.NewRequest("POST", "/api/rpc", nil)
+	err := s.DeclareSuccessor(r, &Request{Method: DeclareSuccessorMethod}, &DeclareSuccessorReply{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "request is not authorized")
+
+	r.Header.Set(AdminTokenHeader, "secret")
+	err = s.DeclareSuccessor(r, &Request{Method: "member.Transfer"}, &DeclareSuccessorReply{})
+	require.Error(t, err, "signature of another request can't be used")
+}
+
+func TestRunner_declareSuccessor(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	ctx := inslogger.TestContext(t)
+	owner := testutils.RandomRef()
+	rootMember := testutils.RandomRef()
+	prototype := testutils.RandomRef()
+	predecessor := testutils.RandomRef()
+	requestID := testutils.RandomID()
+
+	gdp := testutils.NewGenesisDataProviderMock(mc)
+	gdp.GetRootMemberMock.Return(&rootMember, nil)
+
+	predecessorDesc := artifacts.NewObjectDescriptorMock(mc)
+	predecessorDesc.ParentMock.Return(&owner)
+	prototypeDesc := artifacts.NewObjectDescriptorMock(mc)
+	prototypeDesc.IsPrototypeMock.Return(true)
+
+	var stored []byte
+	am := artifacts.NewClientMock(mc)
+	am.GetObjectFunc = func(_ context.Context, head insolar.Reference) (artifacts.ObjectDescriptor, error) {
+		if head.Equal(prototype) {
+			return prototypeDesc, nil
+		}
+		require.Equal(t, predecessor, head)
+		return predecessorDesc, nil
+	}
+	am.RegisterRequestFunc = func(_ context.Context, request record.Request) (*insolar.ID, error) {
+		require.Equal(t, prototype, *request.Object)
+		require.Equal(t, DeclareSuccessorMethod, request.Method)
+		return &requestID, nil
+	}
+	am.UpdatePrototypeFunc = func(
+		_ context.Context, _, _ insolar.Reference, object artifacts.ObjectDescriptor, memory []byte, _ *insolar.Reference,
+	) (artifacts.ObjectDescriptor, error) {
+		require.Equal(t, prototypeDesc, object)
+		stored = memory
+		return object, nil
+	}
+
+	runner := &Runner{ArtifactManager: am, GenesisDataProvider: gdp}
+	params, err := insolar.MarshalArgs(prototype.String(), []string{predecessor.String()}, "Migrate")
+	require.NoError(t, err)
+
+	t.Run("owner of predecessors", func(t *testing.T) {
+		_, err := runner.declareSuccessor(ctx, Request{
+			Reference: owner.String(),
+			Method:    DeclareSuccessorMethod,
+			Params:    params,
+		})
+		require.NoError(t, err)
+
+		succession := artifacts.Succession{}
+		err = insolar.Deserialize(stored, &succession)
+		require.NoError(t, err)
+		require.Equal(t, artifacts.Succession{
+			Predecessors:    []insolar.Reference{predecessor},
+			MigrationMethod: "Migrate",
+			Declarer:        owner,
+		}, succession)
+	})
+
+	t.Run("stranger", func(t *testing.T) {
+		stored = nil
+		_, err := runner.declareSuccessor(ctx, Request{
+			Reference: testutils.RandomRef().String(),
+			Method:    DeclareSuccessorMethod,
+			Params:    params,
+		})
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "succession is not authorized")
+		require.Nil(t, stored)
+	})
+}
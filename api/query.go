@@ -0,0 +1,126 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// QueryArgs is arguments that Query service accepts.
+type QueryArgs struct {
+	Reference string
+	Method    string
+	Params    []interface{}
+}
+
+// QueryReply is reply for Query service requests.
+type QueryReply struct {
+	Result      interface{}
+	State       string
+	PulseNumber uint32
+}
+
+// QueryService is a service that provides API for read-only calls to contracts.
+type QueryService struct {
+	runner *Runner
+}
+
+// NewQueryService creates new Query service instance.
+func NewQueryService(runner *Runner) *QueryService {
+	return &QueryService{runner: runner}
+}
+
+// Call executes method of a contract against its latest state without registering request on ledger. Reply contains
+// the state of the object, that was read, and the pulse the call was executed in.
+//
+// Query is anonymous, it's not signed by a member. Only methods, that are marked both immutable ("//ins:immutable")
+// and available from API (INSATTR_<Method>_API), can be queried, other methods are rejected before execution. Caller
+// of the method is insolar.AnonymousCaller, so methods, that check the caller, fail. Any client may query such
+// methods, so they should return only data, that may be disclosed to anyone.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "query.Call",
+//     "params": {
+//       "Reference": str, // contract reference
+//       "Method": str, // method name
+//       "Params": [] // method arguments
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {
+//       "Result": any, // method result
+//       "State": str, // state of the object, that was read
+//       "PulseNumber": int // pulse of execution
+//     },
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *QueryService) Call(r *http.Request, args *QueryArgs, reply *QueryReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ QueryService.Call ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Queries == nil {
+		return errors.New("[ QueryService.Call ] queries are not available on this node")
+	}
+
+	object, err := insolar.NewReferenceFromBase58(args.Reference)
+	if err != nil {
+		return errors.Wrap(err, "[ QueryService.Call ] failed to parse reference")
+	}
+	if args.Method == "" {
+		return errors.New("[ QueryService.Call ] method is required")
+	}
+
+	params, err := insolar.Serialize(args.Params)
+	if err != nil {
+		return errors.Wrap(err, "[ QueryService.Call ] failed to serialize params")
+	}
+
+	res, err := s.runner.Queries.Query(ctx, *object, args.Method, params)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ QueryService.Call ] failed to execute query"))
+		return errors.Wrap(err, "[ QueryService.Call ] failed to execute query")
+	}
+
+	result, contractErr, err := extractor.CallResponse(res.Result)
+	if err != nil {
+		return errors.Wrap(err, "[ QueryService.Call ] failed to extract result")
+	}
+	if contractErr != nil {
+		return errors.Wrap(errors.New(contractErr.S), "[ QueryService.Call ] error in called method")
+	}
+
+	reply.Result = result
+	reply.State = res.State.String()
+	reply.PulseNumber = uint32(res.Pulse)
+	return nil
+}
@@ -0,0 +1,78 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestQueryService_Call(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	object := testutils.RandomRef()
+	state := testutils.RandomID()
+	runner := &Runner{}
+	s := NewQueryService(runner)
+	r := httptest.NewRequest("POST", "/api/rpc", nil)
+
+	err := s.Call(r, &QueryArgs{Reference: object.String(), Method: "GetBalance"}, &QueryReply{})
+	require.Error(t, err, "queries are not available without executor")
+
+	queries := testutils.NewQueryExecutorMock(mc)
+	runner.Queries = queries
+	queries.QueryFunc = func(
+		_ context.Context, ref insolar.Reference, method string, args insolar.Arguments,
+	) (*insolar.QueryResult, error) {
+		require.Equal(t, object, ref)
+		params, err := insolar.Serialize([]interface{}{"USD"})
+		require.NoError(t, err)
+		require.Equal(t, insolar.Arguments(params), args)
+
+		if method == "Fail" {
+			result, err := insolar.MarshalArgs(nil, &foundation.Error{S: "failed"})
+			require.NoError(t, err)
+			return &insolar.QueryResult{Result: result}, nil
+		}
+
+		result, err := insolar.MarshalArgs("100", nil)
+		require.NoError(t, err)
+		return &insolar.QueryResult{Result: result, State: state, Pulse: insolar.FirstPulseNumber}, nil
+	}
+
+	reply := &QueryReply{}
+	err = s.Call(r, &QueryArgs{Reference: object.String(), Method: "GetBalance", Params: []interface{}{"USD"}}, reply)
+	require.NoError(t, err)
+	require.Equal(t, &QueryReply{
+		Result:      "100",
+		State:       state.String(),
+		PulseNumber: insolar.FirstPulseNumber,
+	}, reply)
+
+	err = s.Call(r, &QueryArgs{Reference: object.String(), Method: "Fail", Params: []interface{}{"USD"}}, &QueryReply{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed")
+}
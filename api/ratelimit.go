@@ -0,0 +1,219 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/metrics"
+)
+
+// Kinds of rate limits.
+const (
+	limitAddress = "address"
+	limitMember  = "member"
+	limitUpload  = "upload"
+)
+
+// RateLimitErrorCode is a JSON-RPC error code of requests rejected by rate limits.
+const RateLimitErrorCode = -32000
+
+// rateLimitCleanPeriod is a period of deleting idle buckets.
+const rateLimitCleanPeriod = time.Minute
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter keeps a token bucket per key. Bucket refills with rate tokens per second up to burst tokens, every
+// request takes one token.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+
+	lock    sync.Mutex
+	buckets map[string]*tokenBucket
+	cleaned time.Time
+}
+
+// newRateLimiter returns nil if rate is not positive, nil limiter allows all requests.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(burst),
+		buckets: make(map[string]*tokenBucket),
+		cleaned: time.Now(),
+	}
+}
+
+func (l *rateLimiter) allow(key string, now time.Time) bool {
+	if l == nil {
+		return true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if now.Sub(l.cleaned) > rateLimitCleanPeriod {
+		l.clean(now)
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// clean deletes buckets, that are refilled completely, they are equal to new ones.
+func (l *rateLimiter) clean(now time.Time) {
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+	l.cleaned = now
+}
+
+// rateLimits holds limiters of all kinds.
+type rateLimits struct {
+	limiters map[string]*rateLimiter
+}
+
+func newRateLimits(cfg configuration.APIRateLimits) *rateLimits {
+	return &rateLimits{
+		limiters: map[string]*rateLimiter{
+			limitAddress: newRateLimiter(cfg.AddressRate, cfg.AddressBurst),
+			limitMember:  newRateLimiter(cfg.MemberRate, cfg.MemberBurst),
+			limitUpload:  newRateLimiter(cfg.UploadRate, cfg.UploadBurst),
+		},
+	}
+}
+
+// allow takes a token of key from limiter of provided kind. Rejections are counted for endpoint.
+func (rl *rateLimits) allow(limit, endpoint, key string) bool {
+	if rl.limiters[limit].allow(key, time.Now()) {
+		return true
+	}
+	metrics.APIRateLimitedTotal.WithLabelValues(endpoint, limit).Inc()
+	return false
+}
+
+func (rl *rateLimits) enabled(limit string) bool {
+	return rl.limiters[limit] != nil
+}
+
+// remoteHost returns address of client without port, so all connections of client share the limit.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func rateLimitMessage(limit string) string {
+	return "[ rateLimit ] Too many requests, " + limit + " limit is exceeded"
+}
+
+type rpcErrorResponse struct {
+	Version string           `json:"jsonrpc"`
+	Error   rpcError         `json:"error"`
+	ID      *json.RawMessage `json:"id"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func writeRateLimitRPCError(response http.ResponseWriter, id *json.RawMessage, limit string) {
+	res, err := json.Marshal(rpcErrorResponse{
+		Version: "2.0",
+		Error:   rpcError{Code: RateLimitErrorCode, Message: rateLimitMessage(limit)},
+		ID:      id,
+	})
+	if err != nil {
+		res = []byte(`{"jsonrpc": "2.0", "error": {"code": -32000, "message": "can't marshal error to json"}, "id": null}`)
+	}
+	response.Header().Add("Content-Type", "application/json")
+	_, _ = response.Write(res)
+}
+
+// rpcRateLimitHandler checks limits of JSON-RPC requests before passing them to rpc server.
+func (ar *Runner) rpcRateLimitHandler(next http.Handler) http.Handler {
+	const endpoint = "rpc"
+
+	return http.HandlerFunc(func(response http.ResponseWriter, req *http.Request) {
+		host := remoteHost(req)
+		if !ar.rateLimits.allow(limitAddress, endpoint, host) {
+			writeRateLimitRPCError(response, nil, limitAddress)
+			return
+		}
+		if !ar.rateLimits.enabled(limitUpload) {
+			next.ServeHTTP(response, req)
+			return
+		}
+
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			http.Error(response, "can't read request body", http.StatusBadRequest)
+			return
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		// Malformed requests are passed through, rpc server replies to them with parse errors.
+		rpcReq := struct {
+			Method string           `json:"method"`
+			ID     *json.RawMessage `json:"id"`
+		}{}
+		if json.Unmarshal(body, &rpcReq) == nil && rpcReq.Method == "contract.Upload" {
+			if !ar.rateLimits.allow(limitUpload, endpoint, host) {
+				writeRateLimitRPCError(response, rpcReq.ID, limitUpload)
+				return
+			}
+		}
+
+		next.ServeHTTP(response, req)
+	})
+}
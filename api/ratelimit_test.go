@@ -0,0 +1,113 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	l := newRateLimiter(2, 3)
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		require.True(t, l.allow("key", now))
+	}
+	require.False(t, l.allow("key", now))
+	// Other keys have their own buckets.
+	require.True(t, l.allow("other", now))
+
+	// Bucket refills with rate tokens per second.
+	now = now.Add(500 * time.Millisecond)
+	require.True(t, l.allow("key", now))
+	require.False(t, l.allow("key", now))
+
+	// Bucket never holds more than burst tokens.
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		require.True(t, l.allow("key", now))
+	}
+	require.False(t, l.allow("key", now))
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	l := newRateLimiter(0, 10)
+	require.Nil(t, l)
+	for i := 0; i < 100; i++ {
+		require.True(t, l.allow("key", time.Now()))
+	}
+}
+
+func TestRateLimiter_Clean(t *testing.T) {
+	l := newRateLimiter(1, 1)
+	now := time.Now()
+	require.True(t, l.allow("idle", now))
+
+	now = now.Add(rateLimitCleanPeriod + time.Second)
+	require.True(t, l.allow("active", now))
+	require.NotContains(t, l.buckets, "idle")
+	require.Contains(t, l.buckets, "active")
+}
+
+func TestRunner_rpcRateLimitHandler(t *testing.T) {
+	ar := &Runner{rateLimits: newRateLimits(configuration.APIRateLimits{
+		AddressRate:  1,
+		AddressBurst: 3,
+		UploadRate:   1,
+		UploadBurst:  1,
+	})}
+	passed := 0
+	handler := ar.rpcRateLimitHandler(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		passed++
+	}))
+
+	send := func(body string) *rpcErrorResponse {
+		req := httptest.NewRequest("POST", "/api/rpc", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Body.Len() == 0 {
+			return nil
+		}
+		resp := &rpcErrorResponse{}
+		err := json.Unmarshal(rec.Body.Bytes(), resp)
+		require.NoError(t, err)
+		return resp
+	}
+
+	upload := `{"jsonrpc": "2.0", "method": "contract.Upload", "id": 7}`
+	require.Nil(t, send(upload))
+	resp := send(upload)
+	require.NotNil(t, resp)
+	require.Equal(t, RateLimitErrorCode, resp.Error.Code)
+	require.Contains(t, resp.Error.Message, limitUpload)
+	require.Equal(t, "7", string(*resp.ID))
+
+	require.Nil(t, send(`{"jsonrpc": "2.0", "method": "status.Get", "id": 8}`))
+	resp = send(`{"jsonrpc": "2.0", "method": "status.Get", "id": 9}`)
+	require.NotNil(t, resp)
+	require.Contains(t, resp.Error.Message, limitAddress)
+	require.Equal(t, 2, passed)
+}
@@ -0,0 +1,113 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// ReplicationArgs is arguments that Replication service accepts.
+type ReplicationArgs struct {
+	PulseNumber uint32
+}
+
+// ReplicationReply is reply for Replication service requests.
+type ReplicationReply struct {
+	Jets []ReplicationStatus
+}
+
+// ReplicationStatus is a replication state of a pulse and a jet.
+type ReplicationStatus struct {
+	PulseNumber  uint32
+	JetID        string
+	Acknowledged bool
+	Attempts     uint32
+	LastError    string
+}
+
+// ReplicationService is a service that provides API for checking replication from light to heavy.
+type ReplicationService struct {
+	runner *Runner
+}
+
+// NewReplicationService creates new Replication service instance.
+func NewReplicationService(runner *Runner) *ReplicationService {
+	return &ReplicationService{runner: runner}
+}
+
+// Status returns replication state of jets for a pulse. If pulse number is zero, all jets, that are not
+// acknowledged by heavy yet, are returned.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "replication.Status",
+//     "params": {
+//       "PulseNumber": int // pulse number or zero for pending jets
+//     },
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {
+//       "Jets": [{
+//         "PulseNumber": int,
+//         "JetID": str,
+//         "Acknowledged": bool, // heavy has accepted the data
+//         "Attempts": int, // count of failed attempts
+//         "LastError": str
+//       }]
+//     },
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *ReplicationService) Status(r *http.Request, args *ReplicationArgs, reply *ReplicationReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ReplicationService.Status ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.Replication == nil {
+		return errors.New("[ ReplicationService.Status ] replication status is not available on this node")
+	}
+
+	statuses, err := s.runner.Replication.Status(ctx, insolar.PulseNumber(args.PulseNumber))
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ ReplicationService.Status ] failed to fetch status"))
+		return errors.Wrap(err, "[ ReplicationService.Status ] failed to fetch status")
+	}
+
+	reply.Jets = make([]ReplicationStatus, 0, len(statuses))
+	for _, st := range statuses {
+		reply.Jets = append(reply.Jets, ReplicationStatus{
+			PulseNumber:  uint32(st.PulseNumber),
+			JetID:        st.JetID.DebugString(),
+			Acknowledged: st.Acknowledged,
+			Attempts:     st.Attempts,
+			LastError:    st.LastError,
+		})
+	}
+	return nil
+}
@@ -30,9 +30,14 @@ import (
 
 // UserConfigJSON holds info about user
 type UserConfigJSON struct {
-	PrivateKey       string `json:"private_key"`
-	Caller           string `json:"caller"`
-	privateKeyObject crypto.PrivateKey
+	PrivateKey string `json:"private_key"`
+	Caller     string `json:"caller"`
+	// Cosigners holds private keys of other signers of multi-signature member. Request is signed with all of them
+	// together with PrivateKey.
+	Cosigners []string `json:"cosigners,omitempty"`
+
+	privateKeyObject   crypto.PrivateKey
+	cosignerKeyObjects []crypto.PrivateKey
 }
 
 // RequestConfigJSON holds info about request
@@ -40,6 +45,8 @@ type RequestConfigJSON struct {
 	Params   []interface{} `json:"params"`
 	Method   string        `json:"method"`
 	LogLevel interface{}   `json:"logLevel,omitempty"`
+	// IdempotencyKey makes repeated requests with the same key return the result of the first one.
+	IdempotencyKey string `json:"idempotencyKey,omitempty"`
 }
 
 func readFile(path string, configType interface{}) error {
@@ -89,6 +96,11 @@ func ReadUserConfigFromFile(file string) (*UserConfigJSON, error) {
 		return nil, errors.Wrap(err, "[ readUserConfigFromFile ] Problem with reading private key")
 	}
 
+	cfgJSON.cosignerKeyObjects, err = importPrivateKeys(cfgJSON.Cosigners)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ readUserConfigFromFile ] Problem with reading cosigner key")
+	}
+
 	return cfgJSON, nil
 }
 
@@ -112,3 +124,33 @@ func CreateUserConfig(caller string, privKey string) (*UserConfigJSON, error) {
 	userConfig.privateKeyObject, err = ks.ImportPrivateKeyPEM([]byte(privKey))
 	return &userConfig, err
 }
+
+// CreateMultiSigUserConfig creates user config for multi-signature member. Requests are signed with all provided keys.
+func CreateMultiSigUserConfig(caller string, privKeys []string) (*UserConfigJSON, error) {
+	if len(privKeys) == 0 {
+		return nil, errors.New("[ CreateMultiSigUserConfig ] keys must not be empty")
+	}
+	userConfig, err := CreateUserConfig(caller, privKeys[0])
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CreateMultiSigUserConfig ]")
+	}
+	userConfig.Cosigners = privKeys[1:]
+	userConfig.cosignerKeyObjects, err = importPrivateKeys(userConfig.Cosigners)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CreateMultiSigUserConfig ]")
+	}
+	return userConfig, nil
+}
+
+func importPrivateKeys(keys []string) ([]crypto.PrivateKey, error) {
+	ks := platformpolicy.NewKeyProcessor()
+	res := make([]crypto.PrivateKey, 0, len(keys))
+	for _, key := range keys {
+		privateKey, err := ks.ImportPrivateKeyPEM([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, privateKey)
+	}
+	return res, nil
+}
@@ -19,6 +19,7 @@ package requester
 import (
 	"testing"
 
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/stretchr/testify/require"
 )
 
@@ -48,3 +49,27 @@ func TestReadUserConfigFromFile(t *testing.T) {
 	require.Contains(t, conf.PrivateKey, "MHcCAQEEIPOsF3ujjM7jnb7V")
 	require.Equal(t, "4FFB8zfQoGznSmzDxwv4njX1aR9ioL8GHSH17QXH2AFa.4K3NiGuqYGqKPnYp6XeGd2kdN4P9veL6rYcWkLKWXZCu", conf.Caller)
 }
+
+func TestCreateMultiSigUserConfig(t *testing.T) {
+	ks := platformpolicy.NewKeyProcessor()
+	var keys []string
+	for i := 0; i < 3; i++ {
+		privateKey, err := ks.GeneratePrivateKey()
+		require.NoError(t, err)
+		privateKeyStr, err := ks.ExportPrivateKeyPEM(privateKey)
+		require.NoError(t, err)
+		keys = append(keys, string(privateKeyStr))
+	}
+
+	conf, err := CreateMultiSigUserConfig("caller", keys)
+	require.NoError(t, err)
+	require.Equal(t, keys[0], conf.PrivateKey)
+	require.Equal(t, keys[1:], conf.Cosigners)
+	require.Len(t, conf.cosignerKeyObjects, 2)
+
+	_, err = CreateMultiSigUserConfig("caller", nil)
+	require.Error(t, err)
+
+	_, err = CreateMultiSigUserConfig("caller", []string{keys[0], "bad key"})
+	require.Error(t, err)
+}
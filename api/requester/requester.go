@@ -36,6 +36,8 @@ var httpClient *http.Client
 
 const (
 	RequestTimeout = 15 * time.Second
+	// AdminTokenHeader is a header of administrative requests, it's the same as api.AdminTokenHeader.
+	AdminTokenHeader = "X-Insolar-Admin-Token"
 )
 
 func init() {
@@ -80,7 +82,15 @@ type PostParams = map[string]interface{}
 
 // GetResponseBody makes request and extracts body
 func GetResponseBody(url string, postP PostParams) ([]byte, error) {
-	jsonValue, err := json.Marshal(postP)
+	return getResponseBody(url, postP)
+}
+
+func getResponseBody(url string, payload interface{}) ([]byte, error) {
+	return getResponseBodyWithHeader(url, payload, nil)
+}
+
+func getResponseBodyWithHeader(url string, payload interface{}, header http.Header) ([]byte, error) {
+	jsonValue, err := json.Marshal(payload)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ getResponseBody ] Problem with marshaling params")
 	}
@@ -89,6 +99,9 @@ func GetResponseBody(url string, postP PostParams) ([]byte, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "[ getResponseBody ] Problem with creating request")
 	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
 	req.Header.Set("Content-Type", "application/json")
 	postResp, err := httpClient.Do(req)
 	if err != nil {
@@ -149,14 +162,102 @@ func SendWithSeed(ctx context.Context, url string, userCfg *UserConfigJSON, reqC
 		return nil, errors.New("[ Send ] Configs must be initialized")
 	}
 
+	postParams, err := signRequest(ctx, userCfg, reqCfg, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Send ]")
+	}
+
+	body, err := GetResponseBody(url, postParams)
+
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Send ] Problem with sending target request")
+	}
+
+	return body, nil
+}
+
+// Submit first gets seed and after that submits target request without waiting for its result
+func Submit(ctx context.Context, url string, userCfg *UserConfigJSON, reqCfg *RequestConfigJSON) ([]byte, error) {
+	seed, err := GetSeed(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Submit ] Problem with getting seed")
+	}
+
+	response, err := SendWithSeed(ctx, url+"/call/submit", userCfg, reqCfg, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Submit ]")
+	}
+
+	return response, nil
+}
+
+// SendBatchWithSeed signs every request with known seed and sends them in one batch call
+func SendBatchWithSeed(ctx context.Context, url string, userCfg *UserConfigJSON, reqCfgs []*RequestConfigJSON, seed []byte) ([]byte, error) {
+	if userCfg == nil || len(reqCfgs) == 0 {
+		return nil, errors.New("[ SendBatch ] Configs must be initialized")
+	}
+
+	batch := make([]PostParams, 0, len(reqCfgs))
+	for _, reqCfg := range reqCfgs {
+		if reqCfg == nil {
+			return nil, errors.New("[ SendBatch ] Configs must be initialized")
+		}
+		postParams, err := signRequest(ctx, userCfg, reqCfg, seed)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ SendBatch ]")
+		}
+		batch = append(batch, postParams)
+	}
+
+	body, err := getResponseBody(url, batch)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatch ] Problem with sending target request")
+	}
+
+	return body, nil
+}
+
+// SendBatch first gets seed and after that makes batch request
+func SendBatch(ctx context.Context, url string, userCfg *UserConfigJSON, reqCfgs []*RequestConfigJSON) ([]byte, error) {
+	seed, err := GetSeed(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatch ] Problem with getting seed")
+	}
+
+	response, err := SendBatchWithSeed(ctx, url+"/call/batch", userCfg, reqCfgs, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendBatch ]")
+	}
+
+	return response, nil
+}
+
+// SignCallArgs returns params of call.Status and call.Result requests for request submitted by user. Reference of
+// request is signed with seed like params of member call.
+func SignCallArgs(ctx context.Context, userCfg *UserConfigJSON, method string, request string, seed []byte) (map[string]interface{}, error) {
+	postParams, err := signRequest(ctx, userCfg, &RequestConfigJSON{Method: method, Params: []interface{}{request}}, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SignCallArgs ]")
+	}
+
+	return map[string]interface{}{
+		"Reference": request,
+		"Caller":    userCfg.Caller,
+		"Seed":      seed,
+		"Signature": postParams["signature"],
+	}, nil
+}
+
+// signRequest serializes and signs request, result is ready to be sent to api
+func signRequest(ctx context.Context, userCfg *UserConfigJSON, reqCfg *RequestConfigJSON, seed []byte) (PostParams, error) {
 	params, err := constructParams(reqCfg.Params)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ Send ] Problem with serializing params")
+		return nil, errors.Wrap(err, "[ signRequest ] Problem with serializing params")
 	}
 
 	callerRef, err := insolar.NewReferenceFromBase58(userCfg.Caller)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ Send ] Failed to parse userCfg.Caller")
+		return nil, errors.Wrap(err, "[ signRequest ] Failed to parse userCfg.Caller")
 	}
 
 	serRequest, err := insolar.MarshalArgs(
@@ -165,14 +266,14 @@ func SendWithSeed(ctx context.Context, url string, userCfg *UserConfigJSON, reqC
 		params,
 		seed)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ Send ] Problem with serializing request")
+		return nil, errors.Wrap(err, "[ signRequest ] Problem with serializing request")
 	}
 
 	verboseInfo(ctx, "Signing request ...")
 	cs := scheme.Signer(userCfg.privateKeyObject)
 	signature, err := cs.Sign(serRequest)
 	if err != nil {
-		return nil, errors.Wrap(err, "[ Send ] Problem with signing request")
+		return nil, errors.Wrap(err, "[ signRequest ] Problem with signing request")
 	}
 	verboseInfo(ctx, "Signing request completed")
 
@@ -183,17 +284,26 @@ func SendWithSeed(ctx context.Context, url string, userCfg *UserConfigJSON, reqC
 		"seed":      seed,
 		"signature": signature.Bytes(),
 	}
+
+	if len(userCfg.cosignerKeyObjects) > 0 {
+		signatures := [][]byte{signature.Bytes()}
+		for _, key := range userCfg.cosignerKeyObjects {
+			cosignature, err := scheme.Signer(key).Sign(serRequest)
+			if err != nil {
+				return nil, errors.Wrap(err, "[ signRequest ] Problem with cosigning request")
+			}
+			signatures = append(signatures, cosignature.Bytes())
+		}
+		postParams["signatures"] = signatures
+	}
 	if reqCfg.LogLevel != nil {
 		postParams["logLevel"] = reqCfg.LogLevel
 	}
-
-	body, err := GetResponseBody(url, postParams)
-
-	if err != nil {
-		return nil, errors.Wrap(err, "[ Send ] Problem with sending target request")
+	if len(reqCfg.IdempotencyKey) > 0 {
+		postParams["idempotencyKey"] = reqCfg.IdempotencyKey
 	}
 
-	return body, nil
+	return postParams, nil
 }
 
 // Send first gets seed and after that makes target request
@@ -213,6 +323,37 @@ func Send(ctx context.Context, url string, userCfg *UserConfigJSON, reqCfg *Requ
 	return response, nil
 }
 
+// SendAdmin first gets seed, signs request like member call and sends it as params of administrative rpc method
+// reqCfg.Method. Request is authorized with admin token of API.
+func SendAdmin(
+	ctx context.Context, url string, adminToken string, userCfg *UserConfigJSON, reqCfg *RequestConfigJSON,
+) ([]byte, error) {
+	if userCfg == nil || reqCfg == nil {
+		return nil, errors.New("[ SendAdmin ] Configs must be initialized")
+	}
+
+	seed, err := GetSeed(url)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendAdmin ] Problem with getting seed")
+	}
+
+	postParams, err := signRequest(ctx, userCfg, reqCfg, seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendAdmin ]")
+	}
+	params := getDefaultRPCParams(reqCfg.Method)
+	params["params"] = postParams
+
+	header := http.Header{}
+	header.Set(AdminTokenHeader, adminToken)
+	body, err := getResponseBodyWithHeader(url+"/rpc", params, header)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SendAdmin ] Problem with sending target request")
+	}
+
+	return body, nil
+}
+
 func getDefaultRPCParams(method string) PostParams {
 	return PostParams{
 		"jsonrpc": "2.0",
@@ -16,10 +16,18 @@
 
 package sdk
 
+import (
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/api/requester"
+)
+
 // Member model object
 type Member struct {
 	Reference  string
 	PrivateKey string
+	// Cosigners holds private keys of other signers of multi-signature member.
+	Cosigners []string
 }
 
 // NewMember creates new Member
@@ -29,3 +37,39 @@ func NewMember(ref string, key string) *Member {
 		PrivateKey: key,
 	}
 }
+
+// NewMultiSigMember creates new multi-signature Member, that signs requests with all provided keys
+func NewMultiSigMember(ref string, keys []string) (*Member, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one key is required for multi-signature member")
+	}
+	return &Member{
+		Reference:  ref,
+		PrivateKey: keys[0],
+		Cosigners:  keys[1:],
+	}, nil
+}
+
+// Transaction is an entry of member wallet history
+type Transaction struct {
+	// Type is one of transfer_out, transfer_in, transfer_return, escrow, escrow_reclaim
+	Type         string `json:"type"`
+	Counterparty string `json:"counterparty"`
+	Allowance    string `json:"allowance"`
+	Amount       uint   `json:"amount"`
+	PulseNumber  uint32 `json:"pulseNumber"`
+	Request      string `json:"request"`
+}
+
+// History is a page of member wallet history
+type History struct {
+	Total        uint          `json:"total"`
+	Transactions []Transaction `json:"transactions"`
+}
+
+func (m *Member) userConfig() (*requester.UserConfigJSON, error) {
+	if len(m.Cosigners) == 0 {
+		return requester.CreateUserConfig(m.Reference, m.PrivateKey)
+	}
+	return requester.CreateMultiSigUserConfig(m.Reference, append([]string{m.PrivateKey}, m.Cosigners...))
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"sync"
+	"time"
 
 	"github.com/insolar/insolar/api/requester"
 	"github.com/insolar/insolar/insolar"
@@ -37,6 +38,12 @@ type response struct {
 	TraceID string
 }
 
+type batchResponse struct {
+	Error   string
+	Results []response
+	TraceID string
+}
+
 type ringBuffer struct {
 	sync.Mutex
 	urls   []string
@@ -122,6 +129,25 @@ func (sdk *SDK) sendRequest(ctx context.Context, method string, params []interfa
 	return body, nil
 }
 
+func (sdk *SDK) memberRequest(ctx context.Context, method string, params []interface{}, m *Member) (*response, error) {
+	config, err := m.userConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't create user config")
+	}
+
+	body, err := sdk.sendRequest(ctx, method, params, config)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't send request")
+	}
+
+	response, err := sdk.getResponse(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get response")
+	}
+
+	return response, nil
+}
+
 func (sdk *SDK) getResponse(body []byte) (*response, error) {
 	res := &response{}
 	err := json.Unmarshal(body, &res)
@@ -132,50 +158,153 @@ func (sdk *SDK) getResponse(body []byte) (*response, error) {
 	return res, nil
 }
 
+func generateKeys() (privateKey string, publicKey string, err error) {
+	ks := platformpolicy.NewKeyProcessor()
+
+	key, err := ks.GeneratePrivateKey()
+	if err != nil {
+		return "", "", errors.Wrap(err, "can't generate private key")
+	}
+
+	privateKeyStr, err := ks.ExportPrivateKeyPEM(key)
+	if err != nil {
+		return "", "", errors.Wrap(err, "can't export private key")
+	}
+
+	publicKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(key))
+	if err != nil {
+		return "", "", errors.Wrap(err, "can't extract public key")
+	}
+
+	return string(privateKeyStr), string(publicKeyStr), nil
+}
+
 // CreateMember api request creates member with new random keys
 func (sdk *SDK) CreateMember() (*Member, string, error) {
 	ctx := inslogger.ContextWithTrace(context.Background(), "CreateMember")
 	memberName := testutils.RandomString()
+
+	privateKeyStr, memberPubKeyStr, err := generateKeys()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ CreateMember ]")
+	}
+
+	params := []interface{}{memberName, memberPubKeyStr}
+	body, err := sdk.sendRequest(ctx, "CreateMember", params, sdk.rootMember)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ CreateMember ] can't send request")
+	}
+
+	response, err := sdk.getResponse(body)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ CreateMember ] can't get response")
+	}
+
+	if response.Error != "" {
+		return nil, response.TraceID, errors.New(response.Error)
+	}
+
+	return NewMember(response.Result.(string), privateKeyStr), response.TraceID, nil
+}
+
+// CreateMultiSigMember api request creates multi-signature member with given count of new random keys. Member
+// accepts requests signed with at least threshold of the keys.
+func (sdk *SDK) CreateMultiSigMember(keysCount uint, threshold uint) (*Member, string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "CreateMultiSigMember")
+	memberName := testutils.RandomString()
+
+	if keysCount == 0 {
+		return nil, "", errors.New("[ CreateMultiSigMember ] keys count must be positive")
+	}
+	if threshold == 0 || threshold > keysCount {
+		return nil, "", errors.Errorf("[ CreateMultiSigMember ] threshold must be between 1 and %d", keysCount)
+	}
+
+	privateKeys := make([]string, 0, keysCount)
+	publicKeys := make([]string, 0, keysCount)
+	for i := uint(0); i < keysCount; i++ {
+		privateKeyStr, publicKeyStr, err := generateKeys()
+		if err != nil {
+			return nil, "", errors.Wrap(err, "[ CreateMultiSigMember ]")
+		}
+		privateKeys = append(privateKeys, privateKeyStr)
+		publicKeys = append(publicKeys, publicKeyStr)
+	}
+
+	params := []interface{}{memberName, publicKeys, threshold}
+	body, err := sdk.sendRequest(ctx, "CreateMultiSigMember", params, sdk.rootMember)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ CreateMultiSigMember ] can't send request")
+	}
+
+	response, err := sdk.getResponse(body)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ CreateMultiSigMember ] can't get response")
+	}
+
+	if response.Error != "" {
+		return nil, response.TraceID, errors.New(response.Error)
+	}
+
+	member, err := NewMultiSigMember(response.Result.(string), privateKeys)
+	if err != nil {
+		return nil, response.TraceID, errors.Wrap(err, "[ CreateMultiSigMember ]")
+	}
+	return member, response.TraceID, nil
+}
+
+// RotateKey api request replaces private key of the member with new random one. Replaced key is revoked and can't
+// be used by the member anymore.
+func (sdk *SDK) RotateKey(m *Member) (*Member, string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "RotateKey")
 	ks := platformpolicy.NewKeyProcessor()
 
-	privateKey, err := ks.GeneratePrivateKey()
+	oldPrivateKey, err := ks.ImportPrivateKeyPEM([]byte(m.PrivateKey))
+	if err != nil {
+		return nil, "", errors.Wrap(err, "[ RotateKey ] can't import private key")
+	}
+	oldPublicKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(oldPrivateKey))
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't generate private key")
+		return nil, "", errors.Wrap(err, "[ RotateKey ] can't extract public key")
 	}
 
-	privateKeyStr, err := ks.ExportPrivateKeyPEM(privateKey)
+	privateKeyStr, publicKeyStr, err := generateKeys()
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't export private key")
+		return nil, "", errors.Wrap(err, "[ RotateKey ]")
 	}
 
-	memberPubKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(privateKey))
+	config, err := m.userConfig()
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't extract public key")
+		return nil, "", errors.Wrap(err, "[ RotateKey ] can't create user config")
 	}
 
-	params := []interface{}{memberName, string(memberPubKeyStr)}
-	body, err := sdk.sendRequest(ctx, "CreateMember", params, sdk.rootMember)
+	params := []interface{}{string(oldPublicKeyStr), publicKeyStr}
+	body, err := sdk.sendRequest(ctx, "RotateKey", params, config)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't send request")
+		return nil, "", errors.Wrap(err, "[ RotateKey ] can't send request")
 	}
 
 	response, err := sdk.getResponse(body)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "[ CreateMember ] can't get response")
+		return nil, "", errors.Wrap(err, "[ RotateKey ] can't get response")
 	}
 
 	if response.Error != "" {
 		return nil, response.TraceID, errors.New(response.Error)
 	}
 
-	return NewMember(response.Result.(string), string(privateKeyStr)), response.TraceID, nil
+	return &Member{
+		Reference:  m.Reference,
+		PrivateKey: privateKeyStr,
+		Cosigners:  m.Cosigners,
+	}, response.TraceID, nil
 }
 
 // Transfer method send money from one member to another
 func (sdk *SDK) Transfer(amount uint, from *Member, to *Member) (string, error) {
 	ctx := inslogger.ContextWithTrace(context.Background(), "Transfer")
 	params := []interface{}{amount, to.Reference}
-	config, err := requester.CreateUserConfig(from.Reference, from.PrivateKey)
+	config, err := from.userConfig()
 	if err != nil {
 		return "", errors.Wrap(err, "[ Transfer ] can't create user config")
 	}
@@ -197,11 +326,211 @@ func (sdk *SDK) Transfer(amount uint, from *Member, to *Member) (string, error)
 	return response.TraceID, nil
 }
 
+// TransferBatch sends money from one member to several others in a single batch call.
+// Returns per-transfer errors in order of recipients.
+func (sdk *SDK) TransferBatch(amount uint, from *Member, to []*Member) (string, []error, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "TransferBatch")
+	config, err := from.userConfig()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "[ TransferBatch ] can't create user config")
+	}
+
+	reqCfgs := make([]*requester.RequestConfigJSON, 0, len(to))
+	for _, m := range to {
+		reqCfgs = append(reqCfgs, &requester.RequestConfigJSON{
+			Params:   []interface{}{amount, m.Reference},
+			Method:   "Transfer",
+			LogLevel: sdk.logLevel,
+		})
+	}
+
+	body, err := requester.SendBatch(ctx, sdk.apiURLs.next(), config, reqCfgs)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "[ TransferBatch ] can't send request")
+	}
+
+	res := batchResponse{}
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return "", nil, errors.Wrap(err, "[ TransferBatch ] problems with unmarshal response")
+	}
+	if res.Error != "" {
+		return res.TraceID, nil, errors.New(res.Error)
+	}
+	if len(res.Results) != len(to) {
+		return res.TraceID, nil, errors.Errorf("[ TransferBatch ] expected %d results, got %d", len(to), len(res.Results))
+	}
+
+	errs := make([]error, len(res.Results))
+	for i, r := range res.Results {
+		if r.Error != "" {
+			errs[i] = errors.New(r.Error)
+		}
+	}
+
+	return res.TraceID, errs, nil
+}
+
+// IssueToken creates new token issued by member. Initial supply is minted to issuer wallet. Returns token reference.
+func (sdk *SDK) IssueToken(issuer *Member, name string, decimals uint, supply uint, mintable bool, burnable bool) (string, string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "IssueToken")
+	params := []interface{}{name, decimals, supply, mintable, burnable}
+	response, err := sdk.memberRequest(ctx, "IssueToken", params, issuer)
+	if err != nil {
+		return "", "", errors.Wrap(err, "[ IssueToken ]")
+	}
+
+	if response.Error != "" {
+		return "", response.TraceID, errors.New(response.Error)
+	}
+
+	return response.Result.(string), response.TraceID, nil
+}
+
+// MintToken emits amount of token to issuer wallet
+func (sdk *SDK) MintToken(issuer *Member, token string, amount uint) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "MintToken")
+	response, err := sdk.memberRequest(ctx, "MintToken", []interface{}{token, amount}, issuer)
+	if err != nil {
+		return "", errors.Wrap(err, "[ MintToken ]")
+	}
+
+	if response.Error != "" {
+		return response.TraceID, errors.New(response.Error)
+	}
+
+	return response.TraceID, nil
+}
+
+// TransferToken sends amount of token from one member to another
+func (sdk *SDK) TransferToken(token string, amount uint, from *Member, to *Member) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "TransferToken")
+	response, err := sdk.memberRequest(ctx, "TransferToken", []interface{}{token, amount, to.Reference}, from)
+	if err != nil {
+		return "", errors.Wrap(err, "[ TransferToken ]")
+	}
+
+	if response.Error != "" {
+		return response.TraceID, errors.New(response.Error)
+	}
+
+	return response.TraceID, nil
+}
+
+// BurnToken destroys amount of token held by member
+func (sdk *SDK) BurnToken(m *Member, token string, amount uint) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "BurnToken")
+	response, err := sdk.memberRequest(ctx, "BurnToken", []interface{}{token, amount}, m)
+	if err != nil {
+		return "", errors.Wrap(err, "[ BurnToken ]")
+	}
+
+	if response.Error != "" {
+		return response.TraceID, errors.New(response.Error)
+	}
+
+	return response.TraceID, nil
+}
+
+// GetTokenBalance returns balance of token held by member
+func (sdk *SDK) GetTokenBalance(m *Member, token string) (uint64, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "GetTokenBalance")
+	response, err := sdk.memberRequest(ctx, "GetTokenBalance", []interface{}{token}, m)
+	if err != nil {
+		return 0, errors.Wrap(err, "[ GetTokenBalance ]")
+	}
+
+	if response.Error != "" {
+		return 0, errors.New(response.Error)
+	}
+
+	return uint64(response.Result.(float64)), nil
+}
+
+// GetHistory returns page of member wallet transactions, newest first. Limit is capped by contract, zero limit means
+// default page size.
+func (sdk *SDK) GetHistory(m *Member, offset uint, limit uint) (*History, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "GetHistory")
+	response, err := sdk.memberRequest(ctx, "GetHistory", []interface{}{offset, limit}, m)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetHistory ]")
+	}
+
+	if response.Error != "" {
+		return nil, errors.New(response.Error)
+	}
+
+	data, err := json.Marshal(response.Result)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetHistory ] can't encode result")
+	}
+
+	history := &History{}
+	err = json.Unmarshal(data, history)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ GetHistory ] can't unmarshal history")
+	}
+
+	return history, nil
+}
+
+// CreateEscrow locks amount from one member for another till deadline. Funds are moved to recipient when recipient or
+// arbiter releases escrow, or can be reclaimed by sender after deadline. Arbiter is optional. Returns escrow reference.
+func (sdk *SDK) CreateEscrow(amount uint, from *Member, to *Member, deadline time.Time, arbiter *Member) (string, string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "CreateEscrow")
+	arbiterRef := ""
+	if arbiter != nil {
+		arbiterRef = arbiter.Reference
+	}
+	params := []interface{}{amount, to.Reference, uint(deadline.Unix()), arbiterRef}
+
+	response, err := sdk.memberRequest(ctx, "CreateEscrow", params, from)
+	if err != nil {
+		return "", "", errors.Wrap(err, "[ CreateEscrow ]")
+	}
+
+	if response.Error != "" {
+		return "", response.TraceID, errors.New(response.Error)
+	}
+
+	return response.Result.(string), response.TraceID, nil
+}
+
+// ReleaseEscrow moves escrow funds to recipient. Should be called by recipient or arbiter.
+func (sdk *SDK) ReleaseEscrow(m *Member, escrow string) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "ReleaseEscrow")
+	response, err := sdk.memberRequest(ctx, "ReleaseEscrow", []interface{}{escrow}, m)
+	if err != nil {
+		return "", errors.Wrap(err, "[ ReleaseEscrow ]")
+	}
+
+	if response.Error != "" {
+		return response.TraceID, errors.New(response.Error)
+	}
+
+	return response.TraceID, nil
+}
+
+// ReclaimEscrow returns funds of expired escrow to sender. Should be called by sender.
+func (sdk *SDK) ReclaimEscrow(m *Member, escrow string) (string, error) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "ReclaimEscrow")
+	response, err := sdk.memberRequest(ctx, "ReclaimEscrow", []interface{}{escrow}, m)
+	if err != nil {
+		return "", errors.Wrap(err, "[ ReclaimEscrow ]")
+	}
+
+	if response.Error != "" {
+		return response.TraceID, errors.New(response.Error)
+	}
+
+	return response.TraceID, nil
+}
+
 // GetBalance returns current balance of the given member.
 func (sdk *SDK) GetBalance(m *Member) (uint64, error) {
 	ctx := inslogger.ContextWithTrace(context.Background(), "GetBalance")
 	params := []interface{}{m.Reference}
-	config, err := requester.CreateUserConfig(m.Reference, m.PrivateKey)
+	config, err := m.userConfig()
 	if err != nil {
 		return 0, errors.Wrap(err, "[ GetBalance ] can't create user config")
 	}
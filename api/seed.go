@@ -73,7 +73,10 @@ func (s *SeedService) Get(r *http.Request, args *SeedArgs, reply *SeedReply) err
 	if err != nil {
 		return errors.Wrap(err, "[ GetSeed ]")
 	}
-	s.runner.SeedManager.Add(*seed)
+	err = s.runner.SeedManager.Add(*seed)
+	if err != nil {
+		return errors.Wrap(err, "[ GetSeed ] Can't store seed")
+	}
 
 	reply.Seed = seed[:]
 	reply.TraceID = traceID
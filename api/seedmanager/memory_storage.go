@@ -0,0 +1,136 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package seedmanager
+
+import (
+	"sync"
+	"time"
+)
+
+type keyEntry struct {
+	value      []byte
+	expiration Expiration
+}
+
+// memoryStorage keeps seeds and keys in memory of a single API runner.
+type memoryStorage struct {
+	mutex sync.RWMutex
+	seeds map[Seed]Expiration
+	keys  map[string]keyEntry
+}
+
+// NewMemoryStorage creates storage, that keeps data in memory and deletes expired data every clean period.
+func NewMemoryStorage(cleanPeriod time.Duration) Storage {
+	s := &memoryStorage{
+		seeds: make(map[Seed]Expiration),
+		keys:  make(map[string]keyEntry),
+	}
+	go func() {
+		for range time.Tick(cleanPeriod) {
+			s.deleteExpired()
+		}
+	}()
+
+	return s
+}
+
+func expiration(ttl time.Duration) Expiration {
+	return time.Now().Add(ttl).UnixNano()
+}
+
+func isExpired(expTime Expiration) bool {
+	return expTime < time.Now().UnixNano()
+}
+
+func (s *memoryStorage) AddSeed(seed Seed, ttl time.Duration) error {
+	expTime := expiration(ttl)
+
+	s.mutex.Lock()
+	s.seeds[seed] = expTime
+	s.mutex.Unlock()
+
+	return nil
+}
+
+func (s *memoryStorage) PopSeed(seed Seed) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	expTime, ok := s.seeds[seed]
+	if !ok {
+		return false, nil
+	}
+	delete(s.seeds, seed)
+
+	return !isExpired(expTime), nil
+}
+
+func (s *memoryStorage) SetKeyIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if entry, ok := s.keys[key]; ok && !isExpired(entry.expiration) {
+		return false, nil
+	}
+	s.keys[key] = keyEntry{value: append([]byte{}, value...), expiration: expiration(ttl)}
+
+	return true, nil
+}
+
+func (s *memoryStorage) SetKey(key string, value []byte, ttl time.Duration) error {
+	s.mutex.Lock()
+	s.keys[key] = keyEntry{value: append([]byte{}, value...), expiration: expiration(ttl)}
+	s.mutex.Unlock()
+
+	return nil
+}
+
+func (s *memoryStorage) GetKey(key string) ([]byte, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	entry, ok := s.keys[key]
+	if !ok || isExpired(entry.expiration) {
+		return nil, ErrNotFound
+	}
+
+	return append([]byte{}, entry.value...), nil
+}
+
+func (s *memoryStorage) DeleteKey(key string) error {
+	s.mutex.Lock()
+	delete(s.keys, key)
+	s.mutex.Unlock()
+
+	return nil
+}
+
+func (s *memoryStorage) deleteExpired() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for seed, expTime := range s.seeds {
+		if isExpired(expTime) {
+			delete(s.seeds, seed)
+		}
+	}
+	for key, entry := range s.keys {
+		if isExpired(entry.expiration) {
+			delete(s.keys, key)
+		}
+	}
+}
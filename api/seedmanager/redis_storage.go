@@ -0,0 +1,82 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package seedmanager
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+)
+
+const (
+	redisSeedPrefix = "insolar:api:seed:"
+	redisKeyPrefix  = "insolar:api:key:"
+)
+
+// redisStorage keeps seeds and keys in redis, so they survive restarts and are shared between API runners.
+type redisStorage struct {
+	client *redis.Client
+}
+
+// NewRedisStorage creates storage, that keeps data in redis. Expiration of data is handled by redis.
+func NewRedisStorage(client *redis.Client) Storage {
+	return &redisStorage{client: client}
+}
+
+func (s *redisStorage) AddSeed(seed Seed, ttl time.Duration) error {
+	err := s.client.Set(redisSeedPrefix+string(seed[:]), 1, ttl).Err()
+	return errors.Wrap(err, "failed to add seed")
+}
+
+func (s *redisStorage) PopSeed(seed Seed) (bool, error) {
+	// Deletion is atomic, so the seed is accepted by a single API runner only.
+	deleted, err := s.client.Del(redisSeedPrefix + string(seed[:])).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to pop seed")
+	}
+	return deleted > 0, nil
+}
+
+func (s *redisStorage) SetKeyIfAbsent(key string, value []byte, ttl time.Duration) (bool, error) {
+	ok, err := s.client.SetNX(redisKeyPrefix+key, value, ttl).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "failed to set key")
+	}
+	return ok, nil
+}
+
+func (s *redisStorage) SetKey(key string, value []byte, ttl time.Duration) error {
+	err := s.client.Set(redisKeyPrefix+key, value, ttl).Err()
+	return errors.Wrap(err, "failed to set key")
+}
+
+func (s *redisStorage) GetKey(key string) ([]byte, error) {
+	value, err := s.client.Get(redisKeyPrefix + key).Bytes()
+	if err == redis.Nil {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get key")
+	}
+	return value, nil
+}
+
+func (s *redisStorage) DeleteKey(key string) error {
+	err := s.client.Del(redisKeyPrefix + key).Err()
+	return errors.Wrap(err, "failed to delete key")
+}
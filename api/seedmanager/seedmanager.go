@@ -17,7 +17,6 @@
 package seedmanager
 
 import (
-	"sync"
 	"time"
 )
 
@@ -33,9 +32,8 @@ const DefaultCleanPeriod = time.Duration(1 * time.Second)
 // SeedManager manages working with seed pool
 // It's thread safe
 type SeedManager struct {
-	mutex    sync.RWMutex
-	seedPool map[Seed]Expiration
-	ttl      time.Duration
+	storage Storage
+	ttl     time.Duration
 }
 
 // New creates new seed manager with default params
@@ -45,55 +43,22 @@ func New() *SeedManager {
 
 // NewSpecified creates new seed manager with custom params
 func NewSpecified(TTL time.Duration, cleanPeriod time.Duration) *SeedManager {
-	sm := SeedManager{seedPool: make(map[Seed]Expiration), ttl: TTL}
-	go func() {
-		for range time.Tick(cleanPeriod) {
-			sm.deleteExpired()
-		}
-	}()
-
-	return &sm
-}
-
-// Add adds seed to pool
-func (sm *SeedManager) Add(seed Seed) {
-	expTime := time.Now().Add(sm.ttl).UnixNano()
-
-	sm.mutex.Lock()
-	sm.seedPool[seed] = expTime
-	sm.mutex.Unlock()
-
+	return NewWithStorage(NewMemoryStorage(cleanPeriod), TTL)
 }
 
-func (sm *SeedManager) isExpired(expTime Expiration) bool {
-	return expTime < time.Now().UnixNano()
+// NewWithStorage creates new seed manager, that keeps seeds in provided storage
+func NewWithStorage(storage Storage, TTL time.Duration) *SeedManager {
+	return &SeedManager{storage: storage, ttl: TTL}
 }
 
-// Exists checks whether seed in the pool
-func (sm *SeedManager) Exists(seed Seed) bool {
-	sm.mutex.RLock()
-	expTime, ok := sm.seedPool[seed]
-	sm.mutex.RUnlock()
-
-	isSeedOk := ok && !sm.isExpired(expTime)
-	if isSeedOk {
-		sm.mutex.Lock()
-		delete(sm.seedPool, seed)
-		sm.mutex.Unlock()
-	}
-
-	return isSeedOk
+// Add adds seed to pool
+func (sm *SeedManager) Add(seed Seed) error {
+	return sm.storage.AddSeed(seed, sm.ttl)
 }
 
-func (sm *SeedManager) deleteExpired() {
-	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
-
-	for seed, expTime := range sm.seedPool {
-		if sm.isExpired(expTime) {
-			delete(sm.seedPool, seed)
-		}
-	}
+// Exists checks whether seed in the pool. Seed is removed from the pool, so it can be used only once
+func (sm *SeedManager) Exists(seed Seed) (bool, error) {
+	return sm.storage.PopSeed(seed)
 }
 
 // SeedFromBytes converts slice of bytes to Seed. Returns nil if slice's size is not equal to SeedSize
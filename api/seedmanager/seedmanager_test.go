@@ -42,7 +42,7 @@ func TestSeedFromBytes(t *testing.T) {
 
 func TestNew(t *testing.T) {
 	sm := New()
-	require.Empty(t, sm.seedPool)
+	require.Empty(t, sm.storage.(*memoryStorage).seeds)
 }
 
 func getSeed(t *testing.T) Seed {
@@ -55,36 +55,50 @@ func getSeed(t *testing.T) Seed {
 func TestSeedManager_Add(t *testing.T) {
 	sm := NewSpecified(time.Duration(5*time.Millisecond), DefaultCleanPeriod)
 	seed := getSeed(t)
-	sm.Add(seed)
-	require.True(t, sm.Exists(seed))
+	err := sm.Add(seed)
+	require.NoError(t, err)
+	ok, err := sm.Exists(seed)
+	require.NoError(t, err)
+	require.True(t, ok)
 }
 
 func TestSeedManager_ExpiredSeed(t *testing.T) {
 	expTime := time.Duration(5 * time.Millisecond)
 	sm := NewSpecified(expTime, DefaultCleanPeriod)
 	seed := getSeed(t)
-	sm.Add(seed)
+	err := sm.Add(seed)
+	require.NoError(t, err)
 	<-time.After(expTime * 2)
-	require.False(t, sm.Exists(seed))
+	ok, err := sm.Exists(seed)
+	require.NoError(t, err)
+	require.False(t, ok)
 }
 
 func TestSeedManager_ExistsThanExpiredSeed(t *testing.T) {
 	seed := getSeed(t)
 	ttl := time.Duration(8 * time.Millisecond)
 	sm := NewSpecified(ttl, DefaultCleanPeriod)
-	sm.Add(seed)
-	require.True(t, sm.Exists(seed))
+	err := sm.Add(seed)
+	require.NoError(t, err)
+	ok, err := sm.Exists(seed)
+	require.NoError(t, err)
+	require.True(t, ok)
 	<-time.After(ttl * 2)
-	require.False(t, sm.Exists(seed))
+	ok, err = sm.Exists(seed)
+	require.NoError(t, err)
+	require.False(t, ok)
 }
 
 func TestSeedManager_ExpiredSeedAfterCleaning(t *testing.T) {
 	expTime := time.Duration(2 * time.Millisecond)
 	sm := NewSpecified(expTime, 2*time.Millisecond)
 	seed := getSeed(t)
-	sm.Add(seed)
+	err := sm.Add(seed)
+	require.NoError(t, err)
 	<-time.After(8 * time.Millisecond)
-	require.False(t, sm.Exists(seed))
+	ok, err := sm.Exists(seed)
+	require.NoError(t, err)
+	require.False(t, ok)
 }
 
 func TestRace(t *testing.T) {
@@ -102,13 +116,52 @@ func TestRace(t *testing.T) {
 			var seeds []Seed
 			for j := 0; j < 500; j++ {
 				seeds = append(seeds, getSeed(t))
-				sm.Add(seeds[len(seeds)-1])
+				_ = sm.Add(seeds[len(seeds)-1])
 			}
 			<-time.After(cleanPeriod)
 			for j := 0; j < 500; j++ {
-				sm.Exists(seeds[j])
+				_, _ = sm.Exists(seeds[j])
 			}
 		}()
 	}
 	wg.Wait()
 }
+
+func TestMemoryStorage_SetKeyIfAbsent(t *testing.T) {
+	s := NewMemoryStorage(DefaultCleanPeriod)
+
+	_, err := s.GetKey("key")
+	require.Equal(t, ErrNotFound, err)
+
+	ok, err := s.SetKeyIfAbsent("key", []byte("first"), time.Minute)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = s.SetKeyIfAbsent("key", []byte("second"), time.Minute)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	value, err := s.GetKey("key")
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), value)
+
+	err = s.DeleteKey("key")
+	require.NoError(t, err)
+	_, err = s.GetKey("key")
+	require.Equal(t, ErrNotFound, err)
+}
+
+func TestMemoryStorage_ExpiredKey(t *testing.T) {
+	ttl := 5 * time.Millisecond
+	s := NewMemoryStorage(DefaultCleanPeriod)
+
+	err := s.SetKey("key", []byte("value"), ttl)
+	require.NoError(t, err)
+	<-time.After(ttl * 2)
+
+	_, err = s.GetKey("key")
+	require.Equal(t, ErrNotFound, err)
+	ok, err := s.SetKeyIfAbsent("key", []byte("value"), ttl)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
@@ -0,0 +1,43 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package seedmanager
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned when storage has no value for a key.
+var ErrNotFound = errors.New("key not found")
+
+// Storage keeps seeds and idempotency keys of requests. Shared storages allow API runners behind a load balancer to
+// accept seeds and idempotency keys issued by each other.
+type Storage interface {
+	// AddSeed stores seed for ttl.
+	AddSeed(seed Seed, ttl time.Duration) error
+	// PopSeed removes seed and returns true if it was stored and not expired.
+	PopSeed(seed Seed) (bool, error)
+
+	// SetKeyIfAbsent stores value of key for ttl if key is not stored yet. Returns false if key is already stored.
+	SetKeyIfAbsent(key string, value []byte, ttl time.Duration) (bool, error)
+	// SetKey stores value of key for ttl.
+	SetKey(key string, value []byte, ttl time.Duration) error
+	// GetKey returns value of key or ErrNotFound.
+	GetKey(key string) ([]byte, error)
+	// DeleteKey removes key. Deleting a missing key is not an error.
+	DeleteKey(key string) error
+}
@@ -0,0 +1,134 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"crypto"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// queryMember calls immutable API method of member as a query and returns raw result. Queries read the latest state
+// of member without registering requests on ledger.
+func (ar *Runner) queryMember(ctx context.Context, member *insolar.Reference, method string) ([]byte, error) {
+	if ar.Queries == nil {
+		return nil, errors.New("[ queryMember ] Queries are not available on this node")
+	}
+	args, err := insolar.Serialize([]interface{}{})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ queryMember ] Can't serialize arguments")
+	}
+	res, err := ar.Queries.Query(ctx, *member, method, args)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ queryMember ] Can't query %s", method)
+	}
+	return res.Result, nil
+}
+
+// memberKeys returns current public keys of member and count of signatures required to sign its requests. Keys are
+// not cached, because member may replace them at any time, they are read by queries, so checks of signatures don't
+// write to ledger.
+func (ar *Runner) memberKeys(ctx context.Context, member *insolar.Reference) ([]crypto.PublicKey, uint, error) {
+	data, err := ar.queryMember(ctx, member, "GetPublicKeys")
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "[ memberKeys ]")
+	}
+	pemKeys, err := extractor.PublicKeysResponse(data)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "[ memberKeys ]")
+	}
+
+	threshold := uint(1)
+	if len(pemKeys) > 0 {
+		data, err = ar.queryMember(ctx, member, "GetThreshold")
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "[ memberKeys ]")
+		}
+		threshold, err = extractor.ThresholdResponse(data)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "[ memberKeys ]")
+		}
+		if threshold == 0 {
+			threshold = 1
+		}
+	} else {
+		data, err = ar.queryMember(ctx, member, "GetPublicKey")
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "[ memberKeys ]")
+		}
+		pemKey, err := extractor.PublicKeyResponse(data)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "[ memberKeys ]")
+		}
+		pemKeys = []string{pemKey}
+	}
+
+	kp := platformpolicy.NewKeyProcessor()
+	keys := make([]crypto.PublicKey, 0, len(pemKeys))
+	for _, pemKey := range pemKeys {
+		key, err := kp.ImportPublicKeyPEM([]byte(pemKey))
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "[ memberKeys ] Invalid public key of member")
+		}
+		keys = append(keys, key)
+	}
+	return keys, threshold, nil
+}
+
+// verifySignature checks signature of request the same way member contract does and returns reference of member,
+// that signed it. Seed is not checked here.
+func (ar *Runner) verifySignature(ctx context.Context, params Request) (*insolar.Reference, error) {
+	member, err := insolar.NewReferenceFromBase58(params.Reference)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ verifySignature ] Failed to parse params.Reference")
+	}
+	args, err := insolar.MarshalArgs(*member, params.Method, params.Params, params.Seed)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ verifySignature ] Can't marshal signed data")
+	}
+
+	keys, threshold, err := ar.memberKeys(ctx, member)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ verifySignature ] Can't get keys of member")
+	}
+
+	signatures := params.Signatures
+	if len(signatures) == 0 {
+		signatures = [][]byte{params.Signature}
+	}
+
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	var signed uint
+	for _, key := range keys {
+		verifier := scheme.Verifier(key)
+		for _, s := range signatures {
+			if verifier.Verify(insolar.SignatureFromBytes(s), args) {
+				signed++
+				break
+			}
+		}
+		if signed >= threshold {
+			return member, nil
+		}
+	}
+	return nil, errors.New("[ verifySignature ] Incorrect signature")
+}
@@ -0,0 +1,94 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// SnapshotArgs is arguments that Snapshot service accepts.
+type SnapshotArgs struct{}
+
+// SnapshotReply is reply for Snapshot service requests.
+type SnapshotReply struct {
+	PulseNumber uint32
+	Path        string
+}
+
+// SnapshotService is a service that provides API for taking snapshots of heavy's storage.
+type SnapshotService struct {
+	runner *Runner
+}
+
+// NewSnapshotService creates new Snapshot service instance.
+func NewSnapshotService(runner *Runner) *SnapshotService {
+	return &SnapshotService{runner: runner}
+}
+
+// Create takes a snapshot of the storage at the current pulse boundary and saves it to the node's snapshot directory.
+// Saved archive can be restored to a fresh heavy node with "insolar ledger restore" command. Request should be
+// authorized with admin token from API configuration in X-Insolar-Admin-Token header.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "snapshot.Create",
+//     "params": {},
+//     "id": str|int|null
+//   }
+//
+//   Response structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "result": {
+//       "PulseNumber": int, // latest pulse in the snapshot
+//       "Path": str // path to the archive on the node
+//     },
+//     "id": str|int|null // same as in request
+//   }
+//
+func (s *SnapshotService) Create(r *http.Request, args *SnapshotArgs, reply *SnapshotReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ SnapshotService.Create ] Incoming request: %s", r.RequestURI)
+
+	err := s.runner.checkAdmin(r)
+	if err != nil {
+		inslog.Warn(errors.Wrap(err, "[ SnapshotService.Create ] request is not authorized"))
+		return errors.Wrap(err, "[ SnapshotService.Create ] request is not authorized")
+	}
+
+	if s.runner.Snapshots == nil {
+		return errors.New("[ SnapshotService.Create ] snapshots are not available on this node")
+	}
+
+	info, err := s.runner.Snapshots.Save(ctx)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ SnapshotService.Create ] failed to save snapshot"))
+		return errors.Wrap(err, "[ SnapshotService.Create ] failed to save snapshot")
+	}
+
+	reply.PulseNumber = uint32(info.PulseNumber)
+	reply.Path = info.Path
+	return nil
+}
@@ -0,0 +1,595 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/application/extractor"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// Subscription topics.
+const (
+	// TopicPulse notifies about every new pulse.
+	TopicPulse = "pulse"
+	// TopicNetworkState notifies about changes of network state.
+	TopicNetworkState = "network"
+	// TopicResult notifies about completion of a request with provided reference.
+	TopicResult = "result"
+	// TopicEvent notifies about events with provided name, that are emitted by a contract with provided reference.
+	TopicEvent = "event"
+)
+
+// Subscription methods.
+const (
+	SubscribeMethod   = "subscribe"
+	UnsubscribeMethod = "unsubscribe"
+)
+
+// subscriberBufferSize is a count of events, that can wait for sending to a subscriber. Subscribers, that are too slow
+// to receive pulse and network events, are disconnected, so they know that events are missed.
+const subscriberBufferSize = 64
+
+// eventReplicationWindow is a count of pulse numbers before the newest sent event, in which events are still fetched.
+// Heavy receives events of a pulse from different jets at different times, so events of older pulses may appear after
+// events of newer ones.
+const eventReplicationWindow insolar.PulseNumber = 600
+
+// maxSubscriptions is a maximum count of request results and event filters, that one connection may subscribe to.
+const maxSubscriptions = 100
+
+// SubscriptionRequest is a message, that client sends to subscription endpoint.
+//
+//   {
+//     "Method": str, // "subscribe" or "unsubscribe"
+//     "Topic": str, // "pulse", "network", "result" or "event"
+//     "Reference": str, // request reference for "result" topic, contract reference for "event" topic
+//     "Name": str, // event name, for "event" topic only
+//     "FromPulse": int // pulse to replay events from, for "event" topic only, optional
+//   }
+//
+// Events are sent once their pulse is replicated to heavy. Without FromPulse events are sent starting from the
+// current pulse.
+type SubscriptionRequest struct {
+	Method    string
+	Topic     string
+	Reference string
+	Name      string
+	FromPulse uint32
+}
+
+// SubscriptionEvent is a message, that subscription endpoint sends to client. It is sent as acknowledgement of
+// subscription requests too, in this case Data is empty.
+//
+//   {
+//     "Topic": str,
+//     "Reference": str, // for "result" and "event" topics only
+//     "Name": str, // for "event" topic only
+//     "Data": PulseEvent|NetworkStateEvent|ResultEvent|Event,
+//     "Error": str,
+//     "TraceID": str
+//   }
+type SubscriptionEvent struct {
+	Topic     string
+	Reference string      `json:",omitempty"`
+	Name      string      `json:",omitempty"`
+	Data      interface{} `json:",omitempty"`
+	Error     string      `json:",omitempty"`
+	TraceID   string
+}
+
+// PulseEvent is data of event for pulse topic.
+type PulseEvent struct {
+	PulseNumber uint32
+	Entropy     []byte
+	Timestamp   int64
+}
+
+// NetworkStateEvent is data of event for network topic.
+type NetworkStateEvent struct {
+	NetworkState string
+}
+
+// ResultEvent is data of event for result topic.
+type ResultEvent struct {
+	Result interface{}
+	Error  string
+}
+
+// eventFilter selects events of a contract with the same name.
+type eventFilter struct {
+	contract insolar.Reference
+	name     string
+}
+
+// eventPosition identifies an event among events of a pulse.
+type eventPosition struct {
+	result   insolar.ID
+	position uint32
+}
+
+// eventCursor tracks events, that were sent to subscriber. Events are fetched starting from pulse "from", that lags
+// behind the newest sent event by eventReplicationWindow, and sent events of these pulses are remembered, so events
+// replicated late are sent once too.
+type eventCursor struct {
+	from insolar.PulseNumber
+	sent map[eventPosition]struct{}
+}
+
+type subscriber struct {
+	conn   *websocket.Conn
+	events chan SubscriptionEvent
+	done   chan struct{}
+	once   sync.Once
+
+	lock     sync.Mutex
+	topics   map[string]struct{}
+	requests map[insolar.Reference]struct{}
+	filters  map[eventFilter]*eventCursor
+}
+
+func newSubscriber(conn *websocket.Conn) *subscriber {
+	return &subscriber{
+		conn:     conn,
+		events:   make(chan SubscriptionEvent, subscriberBufferSize),
+		done:     make(chan struct{}),
+		topics:   make(map[string]struct{}),
+		requests: make(map[insolar.Reference]struct{}),
+		filters:  make(map[eventFilter]*eventCursor),
+	}
+}
+
+func (s *subscriber) close() {
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
+
+func (s *subscriber) subscribed(topic string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.topics[topic]
+	return ok
+}
+
+// pending returns copy of request references, that subscriber waits results for.
+func (s *subscriber) pending() []insolar.Reference {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res := make([]insolar.Reference, 0, len(s.requests))
+	for ref := range s.requests {
+		res = append(res, ref)
+	}
+	return res
+}
+
+// eventFilters returns copy of event filters of subscriber with their current pulses.
+func (s *subscriber) eventFilters() map[eventFilter]insolar.PulseNumber {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	res := make(map[eventFilter]insolar.PulseNumber, len(s.filters))
+	for f, c := range s.filters {
+		res[f] = c.from
+	}
+	return res
+}
+
+// startEvents sets starting pulse for event filter, that was subscribed without it.
+func (s *subscriber) startEvents(f eventFilter, pn insolar.PulseNumber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if c, ok := s.filters[f]; ok && c.from == 0 {
+		c.from = pn
+	}
+}
+
+// markEvent remembers event as sent and returns true if it was not sent before. Cursor follows the pulse of the
+// newest event within eventReplicationWindow, events older than the cursor are considered sent.
+func (s *subscriber) markEvent(f eventFilter, result insolar.ID, position uint32) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	c, ok := s.filters[f]
+	if !ok {
+		// Client unsubscribed while events were fetched.
+		return false
+	}
+
+	pn := result.Pulse()
+	if pn < c.from {
+		return false
+	}
+
+	pos := eventPosition{result: result, position: position}
+	if _, ok := c.sent[pos]; ok {
+		return false
+	}
+	c.sent[pos] = struct{}{}
+
+	if pn > eventReplicationWindow && pn-eventReplicationWindow > c.from {
+		c.from = pn - eventReplicationWindow
+		for sent := range c.sent {
+			if sent.result.Pulse() < c.from {
+				delete(c.sent, sent)
+			}
+		}
+	}
+	return true
+}
+
+func (s *subscriber) apply(req SubscriptionRequest) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if req.Method == SubscribeMethod && (req.Topic == TopicResult || req.Topic == TopicEvent) &&
+		len(s.requests)+len(s.filters) >= maxSubscriptions {
+		return errors.Errorf("too many subscriptions, max %d", maxSubscriptions)
+	}
+
+	switch req.Topic {
+	case TopicPulse, TopicNetworkState:
+		switch req.Method {
+		case SubscribeMethod:
+			s.topics[req.Topic] = struct{}{}
+		case UnsubscribeMethod:
+			delete(s.topics, req.Topic)
+		default:
+			return errors.Errorf("unknown method %s", req.Method)
+		}
+	case TopicResult:
+		ref, err := insolar.NewReferenceFromBase58(req.Reference)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse reference")
+		}
+		switch req.Method {
+		case SubscribeMethod:
+			s.requests[*ref] = struct{}{}
+		case UnsubscribeMethod:
+			delete(s.requests, *ref)
+		default:
+			return errors.Errorf("unknown method %s", req.Method)
+		}
+	case TopicEvent:
+		ref, err := insolar.NewReferenceFromBase58(req.Reference)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse reference")
+		}
+		if req.Name == "" {
+			return errors.New("event name is required")
+		}
+		f := eventFilter{contract: *ref, name: req.Name}
+		switch req.Method {
+		case SubscribeMethod:
+			s.filters[f] = &eventCursor{
+				from: insolar.PulseNumber(req.FromPulse),
+				sent: make(map[eventPosition]struct{}),
+			}
+		case UnsubscribeMethod:
+			delete(s.filters, f)
+		default:
+			return errors.Errorf("unknown method %s", req.Method)
+		}
+	default:
+		return errors.Errorf("unknown topic %s", req.Topic)
+	}
+
+	return nil
+}
+
+// complete removes request reference from subscriber and returns true if subscriber was still waiting for it.
+func (s *subscriber) complete(ref insolar.Reference) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.requests[ref]
+	delete(s.requests, ref)
+	return ok
+}
+
+// send queues event for sending without blocking. Returns false if the buffer is full.
+func (s *subscriber) send(event SubscriptionEvent) bool {
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+// sendWait queues event for sending and waits for free space in the buffer. Returns false if subscriber is closed.
+func (s *subscriber) sendWait(event SubscriptionEvent) bool {
+	select {
+	case s.events <- event:
+		return true
+	case <-s.done:
+		return false
+	}
+}
+
+// subscriptionHub tracks pulses and network state and notifies subscribers about changes.
+type subscriptionHub struct {
+	lock        sync.RWMutex
+	subscribers map[*subscriber]struct{}
+
+	pulse insolar.PulseNumber
+	state insolar.NetworkState
+	done  chan struct{}
+}
+
+func newSubscriptionHub() *subscriptionHub {
+	return &subscriptionHub{
+		subscribers: make(map[*subscriber]struct{}),
+		done:        make(chan struct{}),
+	}
+}
+
+func (h *subscriptionHub) add(s *subscriber) {
+	h.lock.Lock()
+	h.subscribers[s] = struct{}{}
+	h.lock.Unlock()
+}
+
+func (h *subscriptionHub) remove(s *subscriber) {
+	h.lock.Lock()
+	delete(h.subscribers, s)
+	h.lock.Unlock()
+}
+
+func (h *subscriptionHub) broadcast(ctx context.Context, event SubscriptionEvent) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	for s := range h.subscribers {
+		if !s.subscribed(event.Topic) {
+			continue
+		}
+		if !s.send(event) {
+			inslogger.FromContext(ctx).Warnf("[ subscriptionHub ] subscriber is too slow for %s events, disconnecting", event.Topic)
+			s.close()
+		}
+	}
+}
+
+// check notifies subscribers if pulse or network state was changed since the previous check.
+func (h *subscriptionHub) check(ctx context.Context, ar *Runner) {
+	pulse, err := ar.PulseAccessor.Latest(ctx)
+	if err != nil {
+		inslogger.FromContext(ctx).Debug(errors.Wrap(err, "[ subscriptionHub ] failed to fetch pulse"))
+	} else if pulse.PulseNumber != h.pulse {
+		h.pulse = pulse.PulseNumber
+		h.broadcast(ctx, SubscriptionEvent{
+			Topic: TopicPulse,
+			Data: PulseEvent{
+				PulseNumber: uint32(pulse.PulseNumber),
+				Entropy:     pulse.Entropy[:],
+				Timestamp:   pulse.PulseTimestamp,
+			},
+		})
+	}
+
+	state := ar.ServiceNetwork.GetState()
+	if state != h.state {
+		h.state = state
+		h.broadcast(ctx, SubscriptionEvent{
+			Topic: TopicNetworkState,
+			Data:  NetworkStateEvent{NetworkState: state.String()},
+		})
+	}
+}
+
+func (h *subscriptionHub) run(ctx context.Context, ar *Runner, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			h.check(ctx, ar)
+		case <-h.done:
+			return
+		}
+	}
+}
+
+// stop stops tracking and disconnects all subscribers, because hijacked connections are not closed on server shutdown.
+func (h *subscriptionHub) stop() {
+	close(h.done)
+
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	for s := range h.subscribers {
+		s.close()
+	}
+}
+
+// subscribeHandler upgrades connection to websocket and serves subscriptions of the client.
+func (ar *Runner) subscribeHandler() func(http.ResponseWriter, *http.Request) {
+	upgrader := websocket.Upgrader{
+		// Wallets are served from their own origins, so any origin is allowed.
+		CheckOrigin: func(*http.Request) bool { return true },
+	}
+
+	return func(response http.ResponseWriter, req *http.Request) {
+		traceID := utils.RandTraceID()
+		ctx, inslog := inslogger.WithTraceField(context.Background(), traceID)
+
+		if !ar.rateLimits.allow(limitAddress, "subscribeHandler", remoteHost(req)) {
+			http.Error(response, rateLimitMessage(limitAddress), http.StatusTooManyRequests)
+			return
+		}
+
+		conn, err := upgrader.Upgrade(response, req, nil)
+		if err != nil {
+			// Upgrader replies to client by itself.
+			inslog.Error(errors.Wrap(err, "[ subscribeHandler ] Can't upgrade connection"))
+			return
+		}
+		defer conn.Close()
+
+		s := newSubscriber(conn)
+		ar.subscriptions.add(s)
+		defer ar.subscriptions.remove(s)
+
+		go ar.readSubscriptions(ctx, s, traceID)
+		go ar.pollSubscriptions(ctx, s)
+		ar.writeEvents(ctx, s)
+	}
+}
+
+// readSubscriptions applies subscription requests of the client until connection is closed.
+func (ar *Runner) readSubscriptions(ctx context.Context, s *subscriber, traceID string) {
+	defer s.close()
+
+	for {
+		req := SubscriptionRequest{}
+		if err := s.conn.ReadJSON(&req); err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				inslogger.FromContext(ctx).Debug(errors.Wrap(err, "[ readSubscriptions ] Can't read request"))
+			}
+			return
+		}
+
+		ack := SubscriptionEvent{Topic: req.Topic, Reference: req.Reference, Name: req.Name, TraceID: traceID}
+		if err := s.apply(req); err != nil {
+			ack.Error = err.Error()
+		}
+
+		select {
+		case s.events <- ack:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// writeEvents sends queued events to the client. It is the only writer to the connection.
+func (ar *Runner) writeEvents(ctx context.Context, s *subscriber) {
+	for {
+		select {
+		case event := <-s.events:
+			if err := s.conn.WriteJSON(event); err != nil {
+				inslogger.FromContext(ctx).Debug(errors.Wrap(err, "[ writeEvents ] Can't write event"))
+				s.close()
+				return
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// pollSubscriptions checks results of requests and contract events, that client waits for, until connection is closed.
+// Fetching from ledger may be slow, so it is done apart from writing.
+func (ar *Runner) pollSubscriptions(ctx context.Context, s *subscriber) {
+	ticker := time.NewTicker(ar.cfg.SubscribePeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ar.checkResults(ctx, s)
+			ar.checkEvents(ctx, s)
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// checkResults sends results of completed requests. Requests, that failed to be checked, are checked again on the
+// next tick.
+func (ar *Runner) checkResults(ctx context.Context, s *subscriber) {
+	for _, ref := range s.pending() {
+		res, err := ar.ArtifactManager.GetResult(ctx, ref)
+		if err == insolar.ErrNoResult {
+			continue
+		}
+		if err != nil {
+			inslogger.FromContext(ctx).Debug(errors.Wrapf(err, "[ checkResults ] Can't fetch result of %s", ref.String()))
+			continue
+		}
+
+		data := ResultEvent{}
+		result, contractErr, err := extractor.CallResponse(res.Payload)
+		if err != nil {
+			data.Error = errors.Wrap(err, "Can't extract response").Error()
+		} else {
+			data.Result = result
+			if contractErr != nil {
+				data.Error = contractErr.S
+			}
+		}
+
+		if !s.complete(ref) {
+			// Client unsubscribed while result was fetched.
+			continue
+		}
+		if !s.sendWait(SubscriptionEvent{Topic: TopicResult, Reference: ref.String(), Data: data}) {
+			return
+		}
+	}
+}
+
+// checkEvents sends contract events, that were not sent to subscriber yet. Filters, that failed to be checked, are
+// checked again on the next tick.
+func (ar *Runner) checkEvents(ctx context.Context, s *subscriber) {
+	for f, from := range s.eventFilters() {
+		if from == 0 {
+			pulse, err := ar.PulseAccessor.Latest(ctx)
+			if err != nil {
+				inslogger.FromContext(ctx).Debug(errors.Wrap(err, "[ checkEvents ] Can't fetch pulse"))
+				return
+			}
+			s.startEvents(f, pulse.PulseNumber)
+			continue
+		}
+
+		for from != 0 {
+			events, next, err := ar.ArtifactManager.GetEvents(ctx, f.contract, f.name, from, maxEventsLimit)
+			if err != nil {
+				inslogger.FromContext(ctx).Debug(errors.Wrapf(
+					err, "[ checkEvents ] Can't fetch %s events of %s", f.name, f.contract.String(),
+				))
+				break
+			}
+
+			for _, e := range events {
+				if !s.markEvent(f, e.Result, e.Position) {
+					continue
+				}
+				data := newEvent(f.contract, e)
+				event := SubscriptionEvent{Topic: TopicEvent, Reference: data.Contract, Name: f.name, Data: data}
+				if !s.sendWait(event) {
+					return
+				}
+			}
+			from = next
+		}
+	}
+}
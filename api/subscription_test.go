@@ -0,0 +1,255 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/testutils"
+)
+
+const SubscribeUrl = "ws://localhost:19193/api/subscribe"
+
+func readEvent(t *testing.T, conn *websocket.Conn) SubscriptionEvent {
+	err := conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	require.NoError(t, err)
+
+	event := SubscriptionEvent{}
+	err = conn.ReadJSON(&event)
+	require.NoError(t, err)
+	return event
+}
+
+func TestRunner_subscribeHandler(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	http.DefaultServeMux = new(http.ServeMux)
+	cfg := configuration.NewAPIRunner()
+	cfg.Address = "localhost:19193"
+	cfg.SubscribePeriod = 10 * time.Millisecond
+	api, err := NewRunner(&cfg)
+	require.NoError(t, err)
+
+	var currentPulse uint32 = insolar.FirstPulseNumber
+	pa := pulse.NewAccessorMock(t)
+	pa.LatestFunc = func(context.Context) (insolar.Pulse, error) {
+		return insolar.Pulse{PulseNumber: insolar.PulseNumber(atomic.LoadUint32(&currentPulse))}, nil
+	}
+	api.PulseAccessor = pa
+
+	sn := testutils.NewNetworkMock(t)
+	sn.GetStateMock.Return(insolar.CompleteNetworkState)
+	api.ServiceNetwork = sn
+
+	request := testutils.RandomRef()
+	var contractErr *foundation.Error
+	payload, err := insolar.MarshalArgs("OK", contractErr)
+	require.NoError(t, err)
+	am := artifacts.NewClientMock(t)
+	am.GetResultFunc = func(_ context.Context, ref insolar.Reference) (*record.Result, error) {
+		require.Equal(t, request, ref)
+		return &record.Result{Request: ref, Payload: payload}, nil
+	}
+	contract := testutils.RandomRef()
+	eventPN := insolar.PulseNumber(insolar.FirstPulseNumber + 5)
+	eventID := insolar.NewID(eventPN, testutils.RandomID().Hash())
+	am.GetEventsFunc = func(
+		_ context.Context, ref insolar.Reference, name string, from insolar.PulseNumber, limit int,
+	) ([]artifacts.Event, insolar.PulseNumber, error) {
+		require.Equal(t, contract, ref)
+		require.Equal(t, "Transfer", name)
+		if from > eventPN {
+			return nil, 0, nil
+		}
+		// Heavy returns the same event on every poll, it's sent only once.
+		return []artifacts.Event{{Request: request, Result: *eventID, Name: name, Data: []byte{1}}}, 0, nil
+	}
+	api.ArtifactManager = am
+
+	err = api.Start(ctx)
+	require.NoError(t, err)
+	defer api.Stop(ctx)
+
+	conn, _, err := websocket.DefaultDialer.Dial(SubscribeUrl, nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	t.Run("unknown topic", func(t *testing.T) {
+		err := conn.WriteJSON(SubscriptionRequest{Method: SubscribeMethod, Topic: "unknown"})
+		require.NoError(t, err)
+
+		event := readEvent(t, conn)
+		require.Contains(t, event.Error, "unknown topic")
+	})
+
+	t.Run("pulse", func(t *testing.T) {
+		err := conn.WriteJSON(SubscriptionRequest{Method: SubscribeMethod, Topic: TopicPulse})
+		require.NoError(t, err)
+
+		event := readEvent(t, conn)
+		require.Equal(t, TopicPulse, event.Topic)
+		require.Empty(t, event.Error)
+		require.Nil(t, event.Data)
+
+		// Hub may notify about the first pulse if it was not checked before subscription.
+		atomic.StoreUint32(&currentPulse, insolar.FirstPulseNumber+10)
+		var data map[string]interface{}
+		for data["PulseNumber"] != float64(insolar.FirstPulseNumber+10) {
+			event = readEvent(t, conn)
+			require.Equal(t, TopicPulse, event.Topic)
+			var ok bool
+			data, ok = event.Data.(map[string]interface{})
+			require.True(t, ok)
+		}
+
+		err = conn.WriteJSON(SubscriptionRequest{Method: UnsubscribeMethod, Topic: TopicPulse})
+		require.NoError(t, err)
+		event = readEvent(t, conn)
+		require.Empty(t, event.Error)
+	})
+
+	t.Run("result", func(t *testing.T) {
+		err := conn.WriteJSON(SubscriptionRequest{
+			Method:    SubscribeMethod,
+			Topic:     TopicResult,
+			Reference: request.String(),
+		})
+		require.NoError(t, err)
+
+		event := readEvent(t, conn)
+		require.Equal(t, TopicResult, event.Topic)
+		require.Empty(t, event.Error)
+
+		event = readEvent(t, conn)
+		require.Equal(t, TopicResult, event.Topic)
+		require.Equal(t, request.String(), event.Reference)
+		data, ok := event.Data.(map[string]interface{})
+		require.True(t, ok)
+		require.Equal(t, "OK", data["Result"])
+		require.Equal(t, "", data["Error"])
+	})
+	t.Run("event", func(t *testing.T) {
+		err := conn.WriteJSON(SubscriptionRequest{
+			Method:    SubscribeMethod,
+			Topic:     TopicEvent,
+			Reference: contract.String(),
+		})
+		require.NoError(t, err)
+		event := readEvent(t, conn)
+		require.Contains(t, event.Error, "event name is required")
+
+		err = conn.WriteJSON(SubscriptionRequest{
+			Method:    SubscribeMethod,
+			Topic:     TopicEvent,
+			Reference: contract.String(),
+			Name:      "Transfer",
+			FromPulse: insolar.FirstPulseNumber,
+		})
+		require.NoError(t, err)
+
+		event = readEvent(t, conn)
+		require.Equal(t, TopicEvent, event.Topic)
+		require.Empty(t, event.Error)
+
+		event = readEvent(t, conn)
+		require.Equal(t, TopicEvent, event.Topic)
+		require.Equal(t, contract.String(), event.Reference)
+		require.Equal(t, "Transfer", event.Name)
+		data, ok := event.Data.(map[string]interface{})
+		require.True(t, ok)
+		require.Equal(t, request.String(), data["Request"])
+		require.Equal(t, float64(eventPN), data["PulseNumber"])
+
+		err = conn.WriteJSON(SubscriptionRequest{
+			Method:    UnsubscribeMethod,
+			Topic:     TopicEvent,
+			Reference: contract.String(),
+			Name:      "Transfer",
+		})
+		require.NoError(t, err)
+		// Event is sent only once, so the next message is acknowledgement of unsubscription.
+		event = readEvent(t, conn)
+		require.Equal(t, TopicEvent, event.Topic)
+		require.Nil(t, event.Data)
+	})
+}
+
+func TestSubscriber_apply_MaxSubscriptions(t *testing.T) {
+	s := newSubscriber(nil)
+	for i := 0; i < maxSubscriptions; i++ {
+		err := s.apply(SubscriptionRequest{
+			Method:    SubscribeMethod,
+			Topic:     TopicResult,
+			Reference: testutils.RandomRef().String(),
+		})
+		require.NoError(t, err)
+	}
+
+	err := s.apply(SubscriptionRequest{
+		Method:    SubscribeMethod,
+		Topic:     TopicEvent,
+		Reference: testutils.RandomRef().String(),
+		Name:      "Transfer",
+	})
+	require.Contains(t, err.Error(), "too many subscriptions")
+
+	// Pulse topic is not limited.
+	err = s.apply(SubscriptionRequest{Method: SubscribeMethod, Topic: TopicPulse})
+	require.NoError(t, err)
+}
+
+func TestSubscriber_markEvent(t *testing.T) {
+	s := newSubscriber(nil)
+	f := eventFilter{contract: testutils.RandomRef(), name: "Transfer"}
+	start := insolar.PulseNumber(insolar.FirstPulseNumber)
+	s.filters[f] = &eventCursor{from: start, sent: make(map[eventPosition]struct{})}
+
+	eventID := func(pn insolar.PulseNumber) insolar.ID {
+		return *insolar.NewID(pn, testutils.RandomID().Hash())
+	}
+	early := eventID(start + 10)
+	late := eventID(start + 20)
+	newest := eventID(start + 30)
+
+	require.True(t, s.markEvent(f, early, 0))
+	require.True(t, s.markEvent(f, newest, 0))
+	require.False(t, s.markEvent(f, newest, 0))
+	require.True(t, s.markEvent(f, newest, 1))
+
+	// Event of older pulse replicated after newer events is sent too.
+	require.True(t, s.markEvent(f, late, 0))
+	require.False(t, s.markEvent(f, early, 0))
+
+	// Cursor moves when events leave replication window.
+	require.True(t, s.markEvent(f, eventID(start+30+eventReplicationWindow), 0))
+	require.Equal(t, start+30, s.eventFilters()[f])
+	require.False(t, s.markEvent(f, eventID(start+20), 0))
+}
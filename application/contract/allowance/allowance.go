@@ -27,9 +27,19 @@ import (
 
 type Allowance struct {
 	foundation.BaseContract
+	// From is a member, that owns sender wallet. Could be empty for allowances created with New.
+	From       insolar.Reference
 	To         insolar.Reference
 	Amount     uint
 	ExpireTime int64
+	// Escrow is set for allowances created with NewEscrow. Escrow allowance can be taken only after it is released.
+	Escrow bool
+	// Arbiter is a member, that can release escrow allowance on behalf of both sides. Could be empty for escrow
+	// without arbiter.
+	Arbiter insolar.Reference
+	// Released is set when recipient or arbiter released allowance. Released allowance can be taken after expiration
+	// and can't be returned to owner.
+	Released bool
 }
 
 func (a *Allowance) isExpired() bool {
@@ -41,7 +51,10 @@ func (a *Allowance) TakeAmount() (uint, error) {
 	if *(a.GetContext().Caller) != a.To {
 		return 0, fmt.Errorf("[ TakeAmount ] Only recepient can take amount")
 	}
-	if a.isExpired() {
+	if a.Escrow && !a.Released {
+		return 0, fmt.Errorf("[ TakeAmount ] Escrow allowance is not released")
+	}
+	if a.isExpired() && !a.Released {
 		return 0, fmt.Errorf("[ TakeAmount ] Allowance expiried")
 	}
 	if err := a.SelfDestruct(); err != nil {
@@ -50,6 +63,11 @@ func (a *Allowance) TakeAmount() (uint, error) {
 	return a.Amount, nil
 }
 
+// GetSender returns member, that sent allowance
+func (a *Allowance) GetSender() (insolar.Reference, error) {
+	return a.From, nil
+}
+
 // GetBalanceForOwner returns balance
 func (a *Allowance) GetBalanceForOwner() (uint, error) {
 	return a.Amount, nil
@@ -60,7 +78,7 @@ func (a *Allowance) GetExpiredBalance() (uint, error) {
 	if *(a.GetContext().Caller) != *(a.GetContext().Parent) {
 		return 0, fmt.Errorf("[ DeleteExpiredAllowance ] Only owner can delete expiried Allowance")
 	}
-	if a.isExpired() {
+	if a.isExpired() && !a.Released {
 		if err := a.SelfDestruct(); err != nil {
 			return 0, err
 		}
@@ -69,6 +87,31 @@ func (a *Allowance) GetExpiredBalance() (uint, error) {
 	return 0, nil
 }
 
+// Release lets recipient wallet take amount. Arbiter can release allowance any time before owner reclaimed it,
+// recipient (owner of recipient wallet) - only before expiration.
+func (a *Allowance) Release() error {
+	if !a.Escrow {
+		return fmt.Errorf("[ Release ] Only escrow allowance can be released")
+	}
+	caller := *a.GetContext().Caller
+	if a.Arbiter.IsEmpty() || caller != a.Arbiter {
+		w, err := wallet.GetImplementationFrom(caller)
+		if err != nil || w.GetReference() != a.To {
+			return fmt.Errorf("[ Release ] Only recipient or arbiter can release allowance")
+		}
+		if a.isExpired() {
+			return fmt.Errorf("[ Release ] Allowance expiried")
+		}
+	}
+	if a.Released {
+		return fmt.Errorf("[ Release ] Allowance is already released")
+	}
+
+	a.Released = true
+	ref := a.GetReference()
+	return wallet.GetObject(a.To).AcceptNoWait(&ref)
+}
+
 // New check is caller wallet and makes new allowance
 func New(to *insolar.Reference, amount uint, expire int64) (*Allowance, error) {
 	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
@@ -76,3 +119,19 @@ func New(to *insolar.Reference, amount uint, expire int64) (*Allowance, error) {
 	}
 	return &Allowance{To: *to, Amount: amount, ExpireTime: expire}, nil
 }
+
+// NewWithSender check is caller wallet and makes new allowance with known sender member
+func NewWithSender(from insolar.Reference, to *insolar.Reference, amount uint, expire int64) (*Allowance, error) {
+	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
+		return nil, fmt.Errorf("[ NewWithSender ] : Can't create allowance from not wallet contract")
+	}
+	return &Allowance{From: from, To: *to, Amount: amount, ExpireTime: expire}, nil
+}
+
+// NewEscrow check is caller wallet and makes new allowance, that should be released by recipient or arbiter
+func NewEscrow(from insolar.Reference, to *insolar.Reference, arbiter insolar.Reference, amount uint, expire int64) (*Allowance, error) {
+	if !wallet.PrototypeReference.Equal(*foundation.GetContext().CallerPrototype) {
+		return nil, fmt.Errorf("[ NewEscrow ] : Can't create allowance from not wallet contract")
+	}
+	return &Allowance{From: from, To: *to, Escrow: true, Arbiter: arbiter, Amount: amount, ExpireTime: expire}, nil
+}
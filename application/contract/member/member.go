@@ -17,13 +17,14 @@
 package member
 
 import (
-	"errors"
 	"fmt"
 	"math"
 
 	"github.com/insolar/insolar/application/contract/member/signer"
+	"github.com/insolar/insolar/application/proxy/allowance"
 	"github.com/insolar/insolar/application/proxy/nodedomain"
 	"github.com/insolar/insolar/application/proxy/rootdomain"
+	"github.com/insolar/insolar/application/proxy/token"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
@@ -33,6 +34,12 @@ type Member struct {
 	foundation.BaseContract
 	Name      string
 	PublicKey string
+	// PublicKeys and Threshold are set for multi-signature members only. Such member accepts a call if it is
+	// signed by at least Threshold of PublicKeys.
+	PublicKeys []string
+	Threshold  uint
+	// RevokedKeys holds keys, that were replaced or removed. They can't be used by the member again.
+	RevokedKeys []string
 }
 
 func (m *Member) GetName() (string, error) {
@@ -41,10 +48,29 @@ func (m *Member) GetName() (string, error) {
 
 var INSATTR_GetPublicKey_API = true
 
+//ins:immutable
 func (m *Member) GetPublicKey() (string, error) {
 	return m.PublicKey, nil
 }
 
+var INSATTR_GetPublicKeys_API = true
+
+// GetPublicKeys returns public keys of multi-signature member
+//
+//ins:immutable
+func (m *Member) GetPublicKeys() ([]string, error) {
+	return m.PublicKeys, nil
+}
+
+var INSATTR_GetThreshold_API = true
+
+// GetThreshold returns count of signatures required by multi-signature member
+//
+//ins:immutable
+func (m *Member) GetThreshold() (uint, error) {
+	return m.Threshold, nil
+}
+
 func New(name string, key string) (*Member, error) {
 	return &Member{
 		Name:      name,
@@ -52,11 +78,60 @@ func New(name string, key string) (*Member, error) {
 	}, nil
 }
 
+// NewMultiSig creates member controlled by threshold of provided keys
+func NewMultiSig(name string, keys []string, threshold uint) (*Member, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("[ NewMultiSig ] Keys must not be empty")
+	}
+	if threshold == 0 || threshold > uint(len(keys)) {
+		return nil, fmt.Errorf("[ NewMultiSig ] Threshold must be between 1 and %d", len(keys))
+	}
+	m := &Member{
+		Name:      name,
+		Threshold: threshold,
+	}
+	for _, key := range keys {
+		if err := m.checkNewKey(key); err != nil {
+			return nil, fmt.Errorf("[ NewMultiSig ]: %s", err.Error())
+		}
+		m.PublicKeys = append(m.PublicKeys, key)
+	}
+	return m, nil
+}
+
+func (m *Member) isMultiSig() bool {
+	return len(m.PublicKeys) > 0
+}
+
+func (m *Member) checkNewKey(key string) error {
+	if _, err := foundation.ImportPublicKey(key); err != nil {
+		return fmt.Errorf("[ checkNewKey ] Invalid public key")
+	}
+	if key == m.PublicKey {
+		return fmt.Errorf("[ checkNewKey ] Key already exists")
+	}
+	for _, k := range m.PublicKeys {
+		if k == key {
+			return fmt.Errorf("[ checkNewKey ] Key already exists")
+		}
+	}
+	for _, k := range m.RevokedKeys {
+		if k == key {
+			return fmt.Errorf("[ checkNewKey ] Key was revoked")
+		}
+	}
+	return nil
+}
+
 func (m *Member) verifySig(method string, params []byte, seed []byte, sign []byte) error {
 	args, err := insolar.MarshalArgs(m.GetReference(), method, params, seed)
 	if err != nil {
 		return fmt.Errorf("[ verifySig ] Can't MarshalArgs: %s", err.Error())
 	}
+	if m.isMultiSig() {
+		return m.verifyMultiSig(args, sign)
+	}
+
 	key, err := m.GetPublicKey()
 	if err != nil {
 		return fmt.Errorf("[ verifySig ]: %s", err.Error())
@@ -74,6 +149,33 @@ func (m *Member) verifySig(method string, params []byte, seed []byte, sign []byt
 	return nil
 }
 
+// verifyMultiSig checks that args are signed by at least Threshold distinct keys of the member. Signatures are
+// passed as serialized list, plain signature is treated as list of one.
+func (m *Member) verifyMultiSig(args []byte, sign []byte) error {
+	var signatures [][]byte
+	if err := signer.UnmarshalParams(sign, &signatures); err != nil {
+		signatures = [][]byte{sign}
+	}
+
+	var signed uint
+	for _, key := range m.PublicKeys {
+		publicKey, err := foundation.ImportPublicKey(key)
+		if err != nil {
+			return fmt.Errorf("[ verifyMultiSig ] Invalid public key")
+		}
+		for _, s := range signatures {
+			if foundation.Verify(args, s, publicKey) {
+				signed++
+				break
+			}
+		}
+		if signed >= m.Threshold {
+			return nil
+		}
+	}
+	return fmt.Errorf("[ verifyMultiSig ] Not enough signatures: %d of %d", signed, m.Threshold)
+}
+
 var INSATTR_Call_API = true
 
 // Call method for authorized calls
@@ -82,6 +184,8 @@ func (m *Member) Call(rootDomain insolar.Reference, method string, params []byte
 	switch method {
 	case "CreateMember":
 		return m.createMemberCall(rootDomain, params)
+	case "CreateMultiSigMember":
+		return m.createMultiSigMemberCall(rootDomain, params)
 	}
 
 	if err := m.verifySig(method, params, seed, sign); err != nil {
@@ -95,6 +199,24 @@ func (m *Member) Call(rootDomain insolar.Reference, method string, params []byte
 		return m.getBalanceCall(params)
 	case "Transfer":
 		return m.transferCall(params)
+	case "IssueToken":
+		return m.issueTokenCall(params)
+	case "MintToken":
+		return m.mintTokenCall(params)
+	case "TransferToken":
+		return m.transferTokenCall(params)
+	case "BurnToken":
+		return m.burnTokenCall(params)
+	case "GetTokenBalance":
+		return m.getTokenBalanceCall(params)
+	case "GetHistory":
+		return m.getHistoryCall(params)
+	case "CreateEscrow":
+		return m.createEscrowCall(params)
+	case "ReleaseEscrow":
+		return m.releaseEscrowCall(params)
+	case "ReclaimEscrow":
+		return m.reclaimEscrowCall(params)
 	case "DumpUserInfo":
 		return m.dumpUserInfoCall(rootDomain, params)
 	case "DumpAllUsers":
@@ -103,6 +225,14 @@ func (m *Member) Call(rootDomain insolar.Reference, method string, params []byte
 		return m.registerNodeCall(rootDomain, params)
 	case "GetNodeRef":
 		return m.getNodeRefCall(rootDomain, params)
+	case "AddKey":
+		return m.addKeyCall(params)
+	case "RotateKey":
+		return m.rotateKeyCall(params)
+	case "RevokeKey":
+		return m.revokeKeyCall(params)
+	case "RotateNodeKey":
+		return m.rotateNodeKeyCall(rootDomain, params)
 	}
 	return nil, &foundation.Error{S: "Unknown method"}
 }
@@ -117,6 +247,87 @@ func (m *Member) createMemberCall(ref insolar.Reference, params []byte) (interfa
 	return rootDomain.CreateMember(name, key)
 }
 
+func (m *Member) createMultiSigMemberCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	rootDomain := rootdomain.GetObject(ref)
+	var name string
+	var keys []string
+	var inThreshold interface{}
+	if err := signer.UnmarshalParams(params, &name, &keys, &inThreshold); err != nil {
+		return nil, fmt.Errorf("[ createMultiSigMemberCall ]: %s", err.Error())
+	}
+	threshold, err := parseUint("threshold", inThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("[ createMultiSigMemberCall ]: %s", err.Error())
+	}
+	return rootDomain.CreateMultiSigMember(name, keys, threshold)
+}
+
+func (m *Member) addKeyCall(params []byte) (interface{}, error) {
+	var key string
+	if err := signer.UnmarshalParams(params, &key); err != nil {
+		return nil, fmt.Errorf("[ addKeyCall ] Can't unmarshal params: %s", err.Error())
+	}
+	if !m.isMultiSig() {
+		return nil, fmt.Errorf("[ addKeyCall ] Member is not multi-signature")
+	}
+	if err := m.checkNewKey(key); err != nil {
+		return nil, fmt.Errorf("[ addKeyCall ]: %s", err.Error())
+	}
+	m.PublicKeys = append(m.PublicKeys, key)
+	return nil, nil
+}
+
+func (m *Member) rotateKeyCall(params []byte) (interface{}, error) {
+	var oldKey string
+	var newKey string
+	if err := signer.UnmarshalParams(params, &oldKey, &newKey); err != nil {
+		return nil, fmt.Errorf("[ rotateKeyCall ] Can't unmarshal params: %s", err.Error())
+	}
+	if err := m.checkNewKey(newKey); err != nil {
+		return nil, fmt.Errorf("[ rotateKeyCall ]: %s", err.Error())
+	}
+
+	if !m.isMultiSig() {
+		if oldKey != m.PublicKey {
+			return nil, fmt.Errorf("[ rotateKeyCall ] Key not found")
+		}
+		m.PublicKey = newKey
+		m.RevokedKeys = append(m.RevokedKeys, oldKey)
+		return nil, nil
+	}
+
+	for i, k := range m.PublicKeys {
+		if k == oldKey {
+			m.PublicKeys[i] = newKey
+			m.RevokedKeys = append(m.RevokedKeys, oldKey)
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("[ rotateKeyCall ] Key not found")
+}
+
+func (m *Member) revokeKeyCall(params []byte) (interface{}, error) {
+	var key string
+	if err := signer.UnmarshalParams(params, &key); err != nil {
+		return nil, fmt.Errorf("[ revokeKeyCall ] Can't unmarshal params: %s", err.Error())
+	}
+	if !m.isMultiSig() {
+		return nil, fmt.Errorf("[ revokeKeyCall ] Single key can't be revoked, use RotateKey instead")
+	}
+	if uint(len(m.PublicKeys)) <= m.Threshold {
+		return nil, fmt.Errorf("[ revokeKeyCall ] Threshold would become unreachable")
+	}
+
+	for i, k := range m.PublicKeys {
+		if k == key {
+			m.PublicKeys = append(m.PublicKeys[:i], m.PublicKeys[i+1:]...)
+			m.RevokedKeys = append(m.RevokedKeys, key)
+			return nil, nil
+		}
+	}
+	return nil, fmt.Errorf("[ revokeKeyCall ] Key not found")
+}
+
 func (m *Member) getMyBalanceCall() (interface{}, error) {
 	w, err := wallet.GetImplementationFrom(m.GetReference())
 	if err != nil {
@@ -144,32 +355,14 @@ func (m *Member) getBalanceCall(params []byte) (interface{}, error) {
 }
 
 func (m *Member) transferCall(params []byte) (interface{}, error) {
-	var amount uint
 	var toStr string
 	var inAmount interface{}
 	if err := signer.UnmarshalParams(params, &inAmount, &toStr); err != nil {
 		return nil, fmt.Errorf("[ transferCall ] Can't unmarshal params: %s", err.Error())
 	}
-	switch a := inAmount.(type) {
-	case uint:
-		amount = a
-	case uint64:
-		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
-		}
-		amount = uint(a)
-	case float32:
-		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
-		}
-		amount = uint(a)
-	case float64:
-		if a > math.MaxUint32 {
-			return nil, errors.New("Transfer ammount bigger than integer")
-		}
-		amount = uint(a)
-	default:
-		return nil, fmt.Errorf("Wrong type for amount %t", inAmount)
+	amount, err := parseUint("Transfer ammount", inAmount)
+	if err != nil {
+		return nil, err
 	}
 	to, err := insolar.NewReferenceFromBase58(toStr)
 	if err != nil {
@@ -186,6 +379,237 @@ func (m *Member) transferCall(params []byte) (interface{}, error) {
 	return nil, w.Transfer(amount, to)
 }
 
+func (m *Member) issueTokenCall(params []byte) (interface{}, error) {
+	var name string
+	var inDecimals interface{}
+	var inSupply interface{}
+	var mintable bool
+	var burnable bool
+	if err := signer.UnmarshalParams(params, &name, &inDecimals, &inSupply, &mintable, &burnable); err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	decimals, err := parseUint("decimals", inDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] %s", err.Error())
+	}
+	supply, err := parseUint("supply", inSupply)
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] %s", err.Error())
+	}
+
+	t, err := token.New(name, decimals, mintable, burnable).AsChild(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ issueTokenCall ] Can't save as child: %s", err.Error())
+	}
+	if supply > 0 {
+		if err := t.Mint(supply); err != nil {
+			return nil, fmt.Errorf("[ issueTokenCall ] Can't mint initial supply: %s", err.Error())
+		}
+	}
+
+	return t.GetReference().String(), nil
+}
+
+func (m *Member) mintTokenCall(params []byte) (interface{}, error) {
+	var tokenStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &tokenStr, &inAmount); err != nil {
+		return nil, fmt.Errorf("[ mintTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseUint("amount", inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ mintTokenCall ] %s", err.Error())
+	}
+	tokenRef, err := insolar.NewReferenceFromBase58(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ mintTokenCall ] Failed to parse 'token' param: %s", err.Error())
+	}
+
+	return nil, token.GetObject(*tokenRef).Mint(amount)
+}
+
+func (m *Member) transferTokenCall(params []byte) (interface{}, error) {
+	var tokenStr string
+	var inAmount interface{}
+	var toStr string
+	if err := signer.UnmarshalParams(params, &tokenStr, &inAmount, &toStr); err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseUint("amount", inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] %s", err.Error())
+	}
+	tokenRef, err := insolar.NewReferenceFromBase58(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] Failed to parse 'token' param: %s", err.Error())
+	}
+	to, err := insolar.NewReferenceFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ transferTokenCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.TransferToken(tokenRef, amount, to)
+}
+
+func (m *Member) burnTokenCall(params []byte) (interface{}, error) {
+	var tokenStr string
+	var inAmount interface{}
+	if err := signer.UnmarshalParams(params, &tokenStr, &inAmount); err != nil {
+		return nil, fmt.Errorf("[ burnTokenCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseUint("amount", inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ burnTokenCall ] %s", err.Error())
+	}
+	tokenRef, err := insolar.NewReferenceFromBase58(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ burnTokenCall ] Failed to parse 'token' param: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ burnTokenCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.BurnToken(tokenRef, amount)
+}
+
+func (m *Member) getTokenBalanceCall(params []byte) (interface{}, error) {
+	var tokenStr string
+	if err := signer.UnmarshalParams(params, &tokenStr); err != nil {
+		return nil, fmt.Errorf("[ getTokenBalanceCall ] Can't unmarshal params: %s", err.Error())
+	}
+	tokenRef, err := insolar.NewReferenceFromBase58(tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ getTokenBalanceCall ] Failed to parse 'token' param: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ getTokenBalanceCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.GetTokenBalance(tokenRef)
+}
+
+func (m *Member) getHistoryCall(params []byte) (interface{}, error) {
+	var inOffset interface{}
+	var inLimit interface{}
+	if err := signer.UnmarshalParams(params, &inOffset, &inLimit); err != nil {
+		return nil, fmt.Errorf("[ getHistoryCall ] Can't unmarshal params: %s", err.Error())
+	}
+	offset, err := parseUint("offset", inOffset)
+	if err != nil {
+		return nil, fmt.Errorf("[ getHistoryCall ] %s", err.Error())
+	}
+	limit, err := parseUint("limit", inLimit)
+	if err != nil {
+		return nil, fmt.Errorf("[ getHistoryCall ] %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ getHistoryCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.GetHistory(offset, limit)
+}
+
+func (m *Member) createEscrowCall(params []byte) (interface{}, error) {
+	var inAmount interface{}
+	var toStr string
+	var inDeadline interface{}
+	var arbiterStr string
+	if err := signer.UnmarshalParams(params, &inAmount, &toStr, &inDeadline, &arbiterStr); err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] Can't unmarshal params: %s", err.Error())
+	}
+	amount, err := parseUint("amount", inAmount)
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] %s", err.Error())
+	}
+	deadline, err := parseUint("deadline", inDeadline)
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] %s", err.Error())
+	}
+	to, err := insolar.NewReferenceFromBase58(toStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] Failed to parse 'to' param: %s", err.Error())
+	}
+	if m.GetReference() == *to {
+		return nil, fmt.Errorf("[ createEscrowCall ] Recipient must be different from the sender")
+	}
+	var arbiter insolar.Reference
+	if arbiterStr != "" {
+		a, err := insolar.NewReferenceFromBase58(arbiterStr)
+		if err != nil {
+			return nil, fmt.Errorf("[ createEscrowCall ] Failed to parse 'arbiter' param: %s", err.Error())
+		}
+		arbiter = *a
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ createEscrowCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return w.CreateEscrow(amount, to, arbiter, int64(deadline))
+}
+
+func (m *Member) releaseEscrowCall(params []byte) (interface{}, error) {
+	var escrowStr string
+	if err := signer.UnmarshalParams(params, &escrowStr); err != nil {
+		return nil, fmt.Errorf("[ releaseEscrowCall ] Can't unmarshal params: %s", err.Error())
+	}
+	escrow, err := insolar.NewReferenceFromBase58(escrowStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ releaseEscrowCall ] Failed to parse 'escrow' param: %s", err.Error())
+	}
+
+	return nil, allowance.GetObject(*escrow).Release()
+}
+
+func (m *Member) reclaimEscrowCall(params []byte) (interface{}, error) {
+	var escrowStr string
+	if err := signer.UnmarshalParams(params, &escrowStr); err != nil {
+		return nil, fmt.Errorf("[ reclaimEscrowCall ] Can't unmarshal params: %s", err.Error())
+	}
+	escrow, err := insolar.NewReferenceFromBase58(escrowStr)
+	if err != nil {
+		return nil, fmt.Errorf("[ reclaimEscrowCall ] Failed to parse 'escrow' param: %s", err.Error())
+	}
+	w, err := wallet.GetImplementationFrom(m.GetReference())
+	if err != nil {
+		return nil, fmt.Errorf("[ reclaimEscrowCall ] Can't get implementation: %s", err.Error())
+	}
+
+	return nil, w.ReclaimEscrow(escrow)
+}
+
+// parseUint converts numeric param to uint. Params could be decoded from JSON and so contain floats.
+func parseUint(name string, in interface{}) (uint, error) {
+	switch a := in.(type) {
+	case uint:
+		return a, nil
+	case uint64:
+		if a > math.MaxUint32 {
+			return 0, fmt.Errorf("%s bigger than integer", name)
+		}
+		return uint(a), nil
+	case float32:
+		if a > math.MaxUint32 {
+			return 0, fmt.Errorf("%s bigger than integer", name)
+		}
+		return uint(a), nil
+	case float64:
+		if a > math.MaxUint32 {
+			return 0, fmt.Errorf("%s bigger than integer", name)
+		}
+		return uint(a), nil
+	default:
+		return 0, fmt.Errorf("Wrong type for %s %t", name, in)
+	}
+}
+
 func (m *Member) dumpUserInfoCall(ref insolar.Reference, params []byte) (interface{}, error) {
 	rootDomain := rootdomain.GetObject(ref)
 	var user string
@@ -222,6 +646,26 @@ func (m *Member) registerNodeCall(ref insolar.Reference, params []byte) (interfa
 	return string(cert), nil
 }
 
+func (m *Member) rotateNodeKeyCall(ref insolar.Reference, params []byte) (interface{}, error) {
+	var oldKey string
+	var newKey string
+	if err := signer.UnmarshalParams(params, &oldKey, &newKey); err != nil {
+		return nil, fmt.Errorf("[ rotateNodeKeyCall ] Can't unmarshal params: %s", err.Error())
+	}
+
+	rootDomain := rootdomain.GetObject(ref)
+	nodeDomainRef, err := rootDomain.GetNodeDomainRef()
+	if err != nil {
+		return nil, fmt.Errorf("[ rotateNodeKeyCall ] %s", err.Error())
+	}
+
+	nd := nodedomain.GetObject(nodeDomainRef)
+	if err := nd.RotateNodeKey(oldKey, newKey); err != nil {
+		return nil, fmt.Errorf("[ rotateNodeKeyCall ] Problems with RotateNodeKey: %s", err.Error())
+	}
+	return nil, nil
+}
+
 func (m *Member) getNodeRefCall(ref insolar.Reference, params []byte) (interface{}, error) {
 	var publicKey string
 	if err := signer.UnmarshalParams(params, &publicKey); err != nil {
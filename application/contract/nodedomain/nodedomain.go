@@ -30,12 +30,15 @@ type NodeDomain struct {
 	foundation.BaseContract
 
 	NodeIndexPK map[string]string
+	// RevokedPK holds node references by keys, that were replaced by rotation.
+	RevokedPK map[string]string
 }
 
 // NewNodeDomain create new NodeDomain
 func NewNodeDomain() (*NodeDomain, error) {
 	return &NodeDomain{
 		NodeIndexPK: make(map[string]string),
+		RevokedPK:   make(map[string]string),
 	}, nil
 }
 
@@ -70,11 +73,52 @@ func (nd *NodeDomain) RegisterNode(publicKey string, role string) (string, error
 func (nd *NodeDomain) GetNodeRefByPK(publicKey string) (string, error) {
 	nodeRef, ok := nd.NodeIndexPK[publicKey]
 	if !ok {
+		if _, revoked := nd.RevokedPK[publicKey]; revoked {
+			return "", fmt.Errorf("[ GetNodeRefByPK ] PK was revoked: %s", publicKey)
+		}
 		return nodeRef, fmt.Errorf("[ GetNodeRefByPK ] NetworkNode not found by PK: %s", publicKey)
 	}
 	return nodeRef, nil
 }
 
+// RotateNodeKey replaces public key of registered node
+func (nd *NodeDomain) RotateNodeKey(oldKey string, newKey string) error {
+	root, err := rootdomain.GetObject(*nd.GetContext().Parent).GetRootMemberRef()
+	if err != nil {
+		return fmt.Errorf("[ RotateNodeKey ] Couldn't get root member reference: %s", err.Error())
+	}
+	if *nd.GetContext().Caller != *root {
+		return fmt.Errorf("[ RotateNodeKey ] Only Root member can rotate node key")
+	}
+
+	nodeRef, ok := nd.NodeIndexPK[oldKey]
+	if !ok {
+		return fmt.Errorf("[ RotateNodeKey ] NetworkNode not found by PK: %s", oldKey)
+	}
+	if _, ok := nd.NodeIndexPK[newKey]; ok {
+		return fmt.Errorf("[ RotateNodeKey ] PK is already registered: %s", newKey)
+	}
+	if _, ok := nd.RevokedPK[newKey]; ok {
+		return fmt.Errorf("[ RotateNodeKey ] PK was revoked: %s", newKey)
+	}
+
+	ref, err := insolar.NewReferenceFromBase58(nodeRef)
+	if err != nil {
+		return fmt.Errorf("[ RotateNodeKey ] Bad node reference: %s", err.Error())
+	}
+	if err := nd.getNodeRecord(*ref).SetPublicKey(newKey); err != nil {
+		return fmt.Errorf("[ RotateNodeKey ] Can't set public key: %s", err.Error())
+	}
+
+	if nd.RevokedPK == nil {
+		nd.RevokedPK = make(map[string]string)
+	}
+	delete(nd.NodeIndexPK, oldKey)
+	nd.NodeIndexPK[newKey] = nodeRef
+	nd.RevokedPK[oldKey] = nodeRef
+	return nil
+}
+
 // RemoveNode deletes node from registry
 func (nd *NodeDomain) RemoveNode(nodeRef insolar.Reference) error {
 	node := nd.getNodeRecord(nodeRef)
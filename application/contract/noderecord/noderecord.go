@@ -77,6 +77,19 @@ func (nr *NodeRecord) GetRole() (insolar.StaticRole, error) {
 	return nr.Record.Role, nil
 }
 
+// SetPublicKey replaces public key of the node. Only parent node domain can change the key, so its index stays
+// consistent.
+func (nr *NodeRecord) SetPublicKey(publicKey string) error {
+	if len(publicKey) == 0 {
+		return fmt.Errorf("[ SetPublicKey ] public key is required")
+	}
+	if *nr.GetContext().Caller != *nr.GetContext().Parent {
+		return fmt.Errorf("[ SetPublicKey ] Only node domain can change public key")
+	}
+	nr.Record.PublicKey = publicKey
+	return nil
+}
+
 // Destroy makes request to destroy current node record
 func (nr *NodeRecord) Destroy() error {
 	return nr.SelfDestruct()
@@ -68,3 +68,11 @@ func TestNodeRecord_GetRole(t *testing.T) {
 	r := insolar.GetStaticRoleFromString(TestRole)
 	require.Equal(t, r, role)
 }
+
+func TestNodeRecord_SetPublicKey_Empty(t *testing.T) {
+	record, err := NewNodeRecord(TestPubKey, TestRole)
+	require.NoError(t, err)
+	err = record.SetPublicKey("")
+	require.EqualError(t, err, "[ SetPublicKey ] public key is required")
+	require.Equal(t, TestPubKey, record.Record.PublicKey)
+}
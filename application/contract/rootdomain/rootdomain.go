@@ -37,16 +37,34 @@ var INSATTR_CreateMember_API = true
 
 // CreateMember processes create member request
 func (rd *RootDomain) CreateMember(name string, key string) (string, error) {
-	memberHolder := member.New(name, key)
+	ref, err := rd.createMember(member.New(name, key))
+	if err != nil {
+		return "", fmt.Errorf("[ CreateMember ] %s", err.Error())
+	}
+	return ref, nil
+}
+
+var INSATTR_CreateMultiSigMember_API = true
+
+// CreateMultiSigMember processes create multi-signature member request
+func (rd *RootDomain) CreateMultiSigMember(name string, keys []string, threshold uint) (string, error) {
+	ref, err := rd.createMember(member.NewMultiSig(name, keys, threshold))
+	if err != nil {
+		return "", fmt.Errorf("[ CreateMultiSigMember ] %s", err.Error())
+	}
+	return ref, nil
+}
+
+func (rd *RootDomain) createMember(memberHolder *member.ContractConstructorHolder) (string, error) {
 	m, err := memberHolder.AsChild(rd.GetReference())
 	if err != nil {
-		return "", fmt.Errorf("[ CreateMember ] Can't save as child: %s", err.Error())
+		return "", fmt.Errorf("Can't save as child: %s", err.Error())
 	}
 
 	wHolder := wallet.New(1000 * 1000 * 1000)
 	_, err = wHolder.AsDelegate(m.GetReference())
 	if err != nil {
-		return "", fmt.Errorf("[ CreateMember ] Can't save as delegate: %s", err.Error())
+		return "", fmt.Errorf("Can't save as delegate: %s", err.Error())
 	}
 
 	return m.GetReference().String(), nil
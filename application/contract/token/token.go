@@ -0,0 +1,120 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package token
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/insolar/insolar/application/contract/wallet/safemath"
+	"github.com/insolar/insolar/application/proxy/wallet"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+// Token is a fungible asset definition. Balances of the token are held by member wallets.
+type Token struct {
+	foundation.BaseContract
+	Name     string
+	Decimals uint
+	Supply   uint
+	// Issuer is a member, that created token. Only issuer can mint.
+	Issuer insolar.Reference
+	// Mintable allows issuer to mint after initial emission.
+	Mintable bool
+	// Burnable allows holders to burn their tokens.
+	Burnable bool
+	// Minted is set after initial emission.
+	Minted bool
+}
+
+// New creates token issued by calling member
+func New(name string, decimals uint, mintable bool, burnable bool) (*Token, error) {
+	if name == "" {
+		return nil, fmt.Errorf("[ New Token ] Name is required")
+	}
+	return &Token{
+		Name:     name,
+		Decimals: decimals,
+		Issuer:   *foundation.GetContext().Caller,
+		Mintable: mintable,
+		Burnable: burnable,
+	}, nil
+}
+
+var INSATTR_GetInfo_API = true
+
+// GetInfo returns JSON with token description
+func (t *Token) GetInfo() ([]byte, error) {
+	res, err := json.Marshal(map[string]interface{}{
+		"name":     t.Name,
+		"decimals": t.Decimals,
+		"supply":   t.Supply,
+		"issuer":   t.Issuer.String(),
+		"mintable": t.Mintable,
+		"burnable": t.Burnable,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ GetInfo ] Can't marshal info: %s", err.Error())
+	}
+	return res, nil
+}
+
+// Mint emits amount of tokens to issuer wallet. Not mintable token can be minted only once.
+func (t *Token) Mint(amount uint) error {
+	if *t.GetContext().Caller != t.Issuer {
+		return fmt.Errorf("[ Mint ] Only issuer can mint tokens")
+	}
+	if t.Minted && !t.Mintable {
+		return fmt.Errorf("[ Mint ] Token is not mintable")
+	}
+
+	supply, err := safemath.Add(t.Supply, amount)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Supply overflow: %s", err.Error())
+	}
+
+	w, err := wallet.GetImplementationFrom(t.Issuer)
+	if err != nil {
+		return fmt.Errorf("[ Mint ] Can't get issuer wallet: %s", err.Error())
+	}
+	ref := t.GetReference()
+	if err := w.ReceiveToken(&ref, amount); err != nil {
+		return fmt.Errorf("[ Mint ] Can't receive tokens: %s", err.Error())
+	}
+
+	t.Supply = supply
+	t.Minted = true
+	return nil
+}
+
+// Burn decreases supply. Is called by wallet, that already removed amount from its balance.
+func (t *Token) Burn(amount uint) error {
+	if !wallet.PrototypeReference.Equal(*t.GetContext().CallerPrototype) {
+		return fmt.Errorf("[ Burn ] Only wallet can burn tokens")
+	}
+	if !t.Burnable {
+		return fmt.Errorf("[ Burn ] Token is not burnable")
+	}
+
+	supply, err := safemath.Sub(t.Supply, amount)
+	if err != nil {
+		return fmt.Errorf("[ Burn ] Not enough supply: %s", err.Error())
+	}
+	t.Supply = supply
+	return nil
+}
@@ -21,15 +21,90 @@ import (
 
 	"github.com/insolar/insolar/application/contract/wallet/safemath"
 	"github.com/insolar/insolar/application/proxy/allowance"
+	"github.com/insolar/insolar/application/proxy/token"
 	"github.com/insolar/insolar/application/proxy/wallet"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
 )
 
+// Types of wallet history transactions
+const (
+	TxTransferOut    = "transfer_out"
+	TxTransferIn     = "transfer_in"
+	TxTransferReturn = "transfer_return"
+	TxEscrow         = "escrow"
+	TxEscrowReclaim  = "escrow_reclaim"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+	// maxHistorySize is a count of the latest transactions kept in wallet history.
+	maxHistorySize = 1000
+)
+
+// Transaction is an entry of wallet history
+type Transaction struct {
+	Type         string              `json:"type"`
+	Counterparty string              `json:"counterparty,omitempty"`
+	Allowance    string              `json:"allowance"`
+	Amount       uint                `json:"amount"`
+	PulseNumber  insolar.PulseNumber `json:"pulseNumber"`
+	Request      string              `json:"request,omitempty"`
+}
+
+// History is a page of wallet history
+type History struct {
+	Total        uint          `json:"total"`
+	Transactions []Transaction `json:"transactions"`
+}
+
 // Wallet - basic wallet contract
 type Wallet struct {
 	foundation.BaseContract
 	Balance uint
+	// History holds the latest balance changes in order they happened. Older transactions are dropped, when history
+	// grows over maxHistorySize.
+	History []Transaction
+	// Tokens holds balances of issued tokens by token reference
+	Tokens map[string]uint
+}
+
+func (w *Wallet) addHistory(txType string, counterparty string, aRef insolar.Reference, amount uint) {
+	ctx := w.GetContext()
+	tx := Transaction{
+		Type:         txType,
+		Counterparty: counterparty,
+		Allowance:    aRef.String(),
+		Amount:       amount,
+		PulseNumber:  ctx.Pulse.PulseNumber,
+	}
+	if ctx.Request != nil {
+		tx.Request = ctx.Request.String()
+	}
+	w.History = append(w.History, tx)
+	if len(w.History) > maxHistorySize {
+		w.History = append([]Transaction{}, w.History[len(w.History)-maxHistorySize:]...)
+	}
+}
+
+// addReturnHistory records return of outgoing allowance to wallet
+func (w *Wallet) addReturnHistory(aRef insolar.Reference, amount uint) {
+	a := aRef.String()
+	for i := len(w.History) - 1; i >= 0; i-- {
+		tx := w.History[i]
+		if tx.Allowance != a {
+			continue
+		}
+		if tx.Type == TxEscrow {
+			w.addHistory(TxEscrowReclaim, tx.Counterparty, aRef, amount)
+			return
+		}
+		if tx.Type == TxTransferOut {
+			break
+		}
+	}
+	w.addHistory(TxTransferReturn, "", aRef, amount)
 }
 
 // Transfer transfers money to given wallet
@@ -47,7 +122,7 @@ func (w *Wallet) Transfer(amount uint, to *insolar.Reference) error {
 		return fmt.Errorf("[ Transfer ] Not enough balance for transfer: %s", err.Error())
 	}
 
-	ah := allowance.New(&toWalletRef, amount, w.GetContext().Time.Unix()+10)
+	ah := allowance.NewWithSender(*w.GetContext().Parent, &toWalletRef, amount, w.GetContext().Time.Unix()+10)
 	a, err := ah.AsChild(w.GetReference())
 	if err != nil {
 		return fmt.Errorf("[ Transfer ] Can't save as child: %s", err.Error())
@@ -57,13 +132,76 @@ func (w *Wallet) Transfer(amount uint, to *insolar.Reference) error {
 	w.Balance = newBalance
 
 	r := a.GetReference()
+	w.addHistory(TxTransferOut, to.String(), r, amount)
 	err = toWallet.AcceptNoWait(&r)
 	return err
 }
 
-// Accept transforms allowance to balance
+// CreateEscrow locks amount in allowance for given member's wallet. Allowance should be released by recipient or
+// arbiter before the deadline, otherwise it can be reclaimed.
+func (w *Wallet) CreateEscrow(amount uint, to *insolar.Reference, arbiter insolar.Reference, deadline int64) (string, error) {
+	if deadline <= w.GetContext().Time.Unix() {
+		return "", fmt.Errorf("[ CreateEscrow ] Deadline must be in the future")
+	}
+
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return "", fmt.Errorf("[ CreateEscrow ] Can't get implementation: %s", err.Error())
+	}
+
+	toWalletRef := toWallet.GetReference()
+
+	newBalance, err := safemath.Sub(w.Balance, amount)
+	if err != nil {
+		return "", fmt.Errorf("[ CreateEscrow ] Not enough balance for escrow: %s", err.Error())
+	}
+
+	ah := allowance.NewEscrow(*w.GetContext().Parent, &toWalletRef, arbiter, amount, deadline)
+	a, err := ah.AsChild(w.GetReference())
+	if err != nil {
+		return "", fmt.Errorf("[ CreateEscrow ] Can't save as child: %s", err.Error())
+	}
+
+	// Changing balance only after allowance was successfully create
+	w.Balance = newBalance
+
+	w.addHistory(TxEscrow, to.String(), a.GetReference(), amount)
+	return a.GetReference().String(), nil
+}
+
+// ReclaimEscrow returns amount of expired allowance to balance
+func (w *Wallet) ReclaimEscrow(aRef *insolar.Reference) error {
+	b, err := allowance.GetObject(*aRef).GetExpiredBalance()
+	if err != nil {
+		return fmt.Errorf("[ ReclaimEscrow ] Can't get expired balance: %s", err.Error())
+	}
+	if b == 0 {
+		return fmt.Errorf("[ ReclaimEscrow ] Allowance is not expired or is released")
+	}
+	w.Balance, err = safemath.Add(w.Balance, b)
+	if err != nil {
+		return fmt.Errorf("[ ReclaimEscrow ] Couldn't add amount to balance: %s", err.Error())
+	}
+	w.addReturnHistory(*aRef, b)
+	return nil
+}
+
+// Accept transforms allowance to balance. It can be called by owner of the wallet, by released allowance itself or by
+// sender wallet on transfer.
 func (w *Wallet) Accept(aRef *insolar.Reference) error {
-	b, err := allowance.GetObject(*aRef).TakeAmount()
+	ctx := w.GetContext()
+	caller := *ctx.Caller
+	fromWallet := ctx.CallerPrototype != nil && wallet.PrototypeReference.Equal(*ctx.CallerPrototype)
+	if caller != *ctx.Parent && caller != *aRef && !fromWallet {
+		return fmt.Errorf("[ Accept ] Only owner, sender wallet or allowance can accept allowance")
+	}
+
+	a := allowance.GetObject(*aRef)
+	from, err := a.GetSender()
+	if err != nil {
+		return fmt.Errorf("[ Accept ] Can't get sender: %s", err.Error())
+	}
+	b, err := a.TakeAmount()
 	if err != nil {
 		return fmt.Errorf("[ Accept ] Can't take amount: %s", err.Error())
 	}
@@ -71,6 +209,11 @@ func (w *Wallet) Accept(aRef *insolar.Reference) error {
 	if err != nil {
 		return fmt.Errorf("[ Accept ] Couldn't add amount to balance: %s", err.Error())
 	}
+	counterparty := ""
+	if !from.IsEmpty() {
+		counterparty = from.String()
+	}
+	w.addHistory(TxTransferIn, counterparty, *aRef, b)
 	return nil
 }
 
@@ -100,11 +243,104 @@ func (w *Wallet) GetBalance() (uint, error) {
 			if err != nil {
 				return 0, fmt.Errorf("[ GetBalance ] Couldn't add expired allowance to balance: %s", err.Error())
 			}
+			if balance > 0 {
+				w.addReturnHistory(cref, balance)
+			}
 		}
 	}
 	return w.Balance, nil
 }
 
+// GetHistory returns total count of kept wallet transactions and a page of them, newest first
+func (w *Wallet) GetHistory(offset uint, limit uint) (History, error) {
+	if limit == 0 {
+		limit = defaultHistoryLimit
+	}
+	if limit > maxHistoryLimit {
+		limit = maxHistoryLimit
+	}
+
+	total := uint(len(w.History))
+	page := []Transaction{}
+	for i := offset; i < total && uint(len(page)) < limit; i++ {
+		page = append(page, w.History[total-1-i])
+	}
+
+	return History{Total: total, Transactions: page}, nil
+}
+
+// GetTokenBalance returns balance of given token
+func (w *Wallet) GetTokenBalance(tokenRef *insolar.Reference) (uint, error) {
+	return w.Tokens[tokenRef.String()], nil
+}
+
+// TransferToken transfers amount of token to given member's wallet
+func (w *Wallet) TransferToken(tokenRef *insolar.Reference, amount uint, to *insolar.Reference) error {
+	if amount == 0 {
+		return fmt.Errorf("[ TransferToken ] Amount must be positive")
+	}
+	newBalance, err := safemath.Sub(w.Tokens[tokenRef.String()], amount)
+	if err != nil {
+		return fmt.Errorf("[ TransferToken ] Not enough balance for transfer: %s", err.Error())
+	}
+
+	toWallet, err := wallet.GetImplementationFrom(*to)
+	if err != nil {
+		return fmt.Errorf("[ TransferToken ] Can't get implementation: %s", err.Error())
+	}
+	if toWallet.GetReference() == w.GetReference() {
+		return fmt.Errorf("[ TransferToken ] Recipient must be different from the sender")
+	}
+
+	err = toWallet.ReceiveToken(tokenRef, amount)
+	if err != nil {
+		return fmt.Errorf("[ TransferToken ] Recipient can't receive tokens: %s", err.Error())
+	}
+
+	// Changing balance only after recipient received tokens
+	w.Tokens[tokenRef.String()] = newBalance
+	return nil
+}
+
+// ReceiveToken adds amount of token to balance. Can be called only by other wallet or by token on mint.
+func (w *Wallet) ReceiveToken(tokenRef *insolar.Reference, amount uint) error {
+	ctx := w.GetContext()
+	fromWallet := wallet.PrototypeReference.Equal(*ctx.CallerPrototype)
+	fromToken := tokenRef.Equal(*ctx.Caller) && token.PrototypeReference.Equal(*ctx.CallerPrototype)
+	if !fromWallet && !fromToken {
+		return fmt.Errorf("[ ReceiveToken ] Tokens can be received only from wallet or token contract")
+	}
+
+	newBalance, err := safemath.Add(w.Tokens[tokenRef.String()], amount)
+	if err != nil {
+		return fmt.Errorf("[ ReceiveToken ] Couldn't add amount to balance: %s", err.Error())
+	}
+	if w.Tokens == nil {
+		w.Tokens = make(map[string]uint)
+	}
+	w.Tokens[tokenRef.String()] = newBalance
+	return nil
+}
+
+// BurnToken removes amount of token from balance and decreases token supply
+func (w *Wallet) BurnToken(tokenRef *insolar.Reference, amount uint) error {
+	if amount == 0 {
+		return fmt.Errorf("[ BurnToken ] Amount must be positive")
+	}
+	newBalance, err := safemath.Sub(w.Tokens[tokenRef.String()], amount)
+	if err != nil {
+		return fmt.Errorf("[ BurnToken ] Not enough balance for burn: %s", err.Error())
+	}
+
+	err = token.GetObject(*tokenRef).Burn(amount)
+	if err != nil {
+		return fmt.Errorf("[ BurnToken ] Can't burn: %s", err.Error())
+	}
+
+	w.Tokens[tokenRef.String()] = newBalance
+	return nil
+}
+
 // New creates new allowance
 func New(balance uint) (*Wallet, error) {
 	return &Wallet{
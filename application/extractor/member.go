@@ -37,3 +37,31 @@ func CallResponse(data []byte) (interface{}, *foundation.Error, error) {
 func PublicKeyResponse(data []byte) (string, error) {
 	return stringResponse(data)
 }
+
+// PublicKeysResponse extracts response of GetPublicKeys
+func PublicKeysResponse(data []byte) ([]string, error) {
+	var result []string
+	var contractErr *foundation.Error
+	_, err := insolar.UnMarshalResponse(data, []interface{}{&result, &contractErr})
+	if err != nil {
+		return nil, errors.Wrap(err, "[ PublicKeysResponse ] Can't unmarshal response ")
+	}
+	if contractErr != nil {
+		return nil, errors.Wrap(contractErr, "[ PublicKeysResponse ] Has error in response")
+	}
+	return result, nil
+}
+
+// ThresholdResponse extracts response of GetThreshold
+func ThresholdResponse(data []byte) (uint, error) {
+	var result uint
+	var contractErr *foundation.Error
+	_, err := insolar.UnMarshalResponse(data, []interface{}{&result, &contractErr})
+	if err != nil {
+		return 0, errors.Wrap(err, "[ ThresholdResponse ] Can't unmarshal response ")
+	}
+	if contractErr != nil {
+		return 0, errors.Wrap(contractErr, "[ ThresholdResponse ] Has error in response")
+	}
+	return result, nil
+}
@@ -90,3 +90,38 @@ func TestCallResponse_UnmarshalError(t *testing.T) {
 	require.Nil(t, contractErr)
 	require.Nil(t, result)
 }
+
+func TestPublicKeysResponse(t *testing.T) {
+	testValue := []string{"first_public_key", "second_public_key"}
+
+	data, err := insolar.Serialize([]interface{}{testValue, nil})
+	require.NoError(t, err)
+
+	result, err := PublicKeysResponse(data)
+
+	require.NoError(t, err)
+	require.Equal(t, testValue, result)
+}
+
+func TestPublicKeysResponse_ErrorResponse(t *testing.T) {
+	contractErr := &foundation.Error{S: "Custom test error"}
+
+	data, err := insolar.Serialize([]interface{}{[]string{}, contractErr})
+	require.NoError(t, err)
+
+	result, err := PublicKeysResponse(data)
+
+	require.Contains(t, err.Error(), "Has error in response")
+	require.Contains(t, err.Error(), "Custom test error")
+	require.Nil(t, result)
+}
+
+func TestThresholdResponse(t *testing.T) {
+	data, err := insolar.Serialize([]interface{}{uint(2), nil})
+	require.NoError(t, err)
+
+	result, err := ThresholdResponse(data)
+
+	require.NoError(t, err)
+	require.Equal(t, uint(2), result)
+}
@@ -92,6 +92,41 @@ func New(to *insolar.Reference, amount uint, expire int64) *ContractConstructorH
 	return &ContractConstructorHolder{constructorName: "New", argsSerialized: argsSerialized}
 }
 
+// NewWithSender is constructor
+func NewWithSender(from insolar.Reference, to *insolar.Reference, amount uint, expire int64) *ContractConstructorHolder {
+	var args [4]interface{}
+	args[0] = from
+	args[1] = to
+	args[2] = amount
+	args[3] = expire
+
+	var argsSerialized []byte
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		panic(err)
+	}
+
+	return &ContractConstructorHolder{constructorName: "NewWithSender", argsSerialized: argsSerialized}
+}
+
+// NewEscrow is constructor
+func NewEscrow(from insolar.Reference, to *insolar.Reference, arbiter insolar.Reference, amount uint, expire int64) *ContractConstructorHolder {
+	var args [5]interface{}
+	args[0] = from
+	args[1] = to
+	args[2] = arbiter
+	args[3] = amount
+	args[4] = expire
+
+	var argsSerialized []byte
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		panic(err)
+	}
+
+	return &ContractConstructorHolder{constructorName: "NewEscrow", argsSerialized: argsSerialized}
+}
+
 // GetReference returns reference of the object
 func (r *Allowance) GetReference() insolar.Reference {
 	return r.Reference
@@ -241,6 +276,91 @@ func (r *Allowance) TakeAmountAsImmutable() (uint, error) {
 	return ret0, nil
 }
 
+// GetSender is proxy generated method
+func (r *Allowance) GetSender() (insolar.Reference, error) {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 insolar.Reference
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "GetSender", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetSenderNoWait is proxy generated method
+func (r *Allowance) GetSenderNoWait() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "GetSender", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSenderAsImmutable is proxy generated method
+func (r *Allowance) GetSenderAsImmutable() (insolar.Reference, error) {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 insolar.Reference
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "GetSender", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
 // GetBalanceForOwner is proxy generated method
 func (r *Allowance) GetBalanceForOwner() (uint, error) {
 	var args [0]interface{}
@@ -410,3 +530,84 @@ func (r *Allowance) GetExpiredBalanceAsImmutable() (uint, error) {
 	}
 	return ret0, nil
 }
+
+// Release is proxy generated method
+func (r *Allowance) Release() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "Release", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// ReleaseNoWait is proxy generated method
+func (r *Allowance) ReleaseNoWait() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "Release", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseAsImmutable is proxy generated method
+func (r *Allowance) ReleaseAsImmutable() error {
+	var args [0]interface{}
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "Release", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
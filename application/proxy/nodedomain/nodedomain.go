@@ -332,6 +332,93 @@ func (r *NodeDomain) GetNodeRefByPKAsImmutable(publicKey string) (string, error)
 	return ret0, nil
 }
 
+// RotateNodeKey is proxy generated method
+func (r *NodeDomain) RotateNodeKey(oldKey string, newKey string) error {
+	var args [2]interface{}
+	args[0] = oldKey
+	args[1] = newKey
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "RotateNodeKey", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// RotateNodeKeyNoWait is proxy generated method
+func (r *NodeDomain) RotateNodeKeyNoWait(oldKey string, newKey string) error {
+	var args [2]interface{}
+	args[0] = oldKey
+	args[1] = newKey
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "RotateNodeKey", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RotateNodeKeyAsImmutable is proxy generated method
+func (r *NodeDomain) RotateNodeKeyAsImmutable(oldKey string, newKey string) error {
+	var args [2]interface{}
+	args[0] = oldKey
+	args[1] = newKey
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "RotateNodeKey", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
 // RemoveNode is proxy generated method
 func (r *NodeDomain) RemoveNode(nodeRef insolar.Reference) error {
 	var args [1]interface{}
@@ -244,6 +244,100 @@ func (r *RootDomain) CreateMemberAsImmutable(name string, key string) (string, e
 	return ret0, nil
 }
 
+// CreateMultiSigMember is proxy generated method
+func (r *RootDomain) CreateMultiSigMember(name string, keys []string, threshold uint) (string, error) {
+	var args [3]interface{}
+	args[0] = name
+	args[1] = keys
+	args[2] = threshold
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 string
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "CreateMultiSigMember", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// CreateMultiSigMemberNoWait is proxy generated method
+func (r *RootDomain) CreateMultiSigMemberNoWait(name string, keys []string, threshold uint) error {
+	var args [3]interface{}
+	args[0] = name
+	args[1] = keys
+	args[2] = threshold
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "CreateMultiSigMember", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateMultiSigMemberAsImmutable is proxy generated method
+func (r *RootDomain) CreateMultiSigMemberAsImmutable(name string, keys []string, threshold uint) (string, error) {
+	var args [3]interface{}
+	args[0] = name
+	args[1] = keys
+	args[2] = threshold
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 string
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "CreateMultiSigMember", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
 // GetRootMemberRef is proxy generated method
 func (r *RootDomain) GetRootMemberRef() (*insolar.Reference, error) {
 	var args [0]interface{}
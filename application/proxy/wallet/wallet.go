@@ -22,6 +22,20 @@ import (
 	"github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 )
 
+type Transaction struct {
+	Type         string              `json:"type"`
+	Counterparty string              `json:"counterparty,omitempty"`
+	Allowance    string              `json:"allowance"`
+	Amount       uint                `json:"amount"`
+	PulseNumber  insolar.PulseNumber `json:"pulseNumber"`
+	Request      string              `json:"request,omitempty"`
+}
+
+type History struct {
+	Total        uint          `json:"total"`
+	Transactions []Transaction `json:"transactions"`
+}
+
 // PrototypeReference to prototype of this contract
 // error checking hides in generator
 var PrototypeReference, _ = insolar.NewReferenceFromBase58("1111KNes6JwRyqX7HTNnX56VdGzD3UttLTDMYmDrx6.11111111111111111111111111111111")
@@ -241,6 +255,187 @@ func (r *Wallet) TransferAsImmutable(amount uint, to *insolar.Reference) error {
 	return nil
 }
 
+// CreateEscrow is proxy generated method
+func (r *Wallet) CreateEscrow(amount uint, to *insolar.Reference, arbiter insolar.Reference, deadline int64) (string, error) {
+	var args [4]interface{}
+	args[0] = amount
+	args[1] = to
+	args[2] = arbiter
+	args[3] = deadline
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 string
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "CreateEscrow", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// CreateEscrowNoWait is proxy generated method
+func (r *Wallet) CreateEscrowNoWait(amount uint, to *insolar.Reference, arbiter insolar.Reference, deadline int64) error {
+	var args [4]interface{}
+	args[0] = amount
+	args[1] = to
+	args[2] = arbiter
+	args[3] = deadline
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "CreateEscrow", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateEscrowAsImmutable is proxy generated method
+func (r *Wallet) CreateEscrowAsImmutable(amount uint, to *insolar.Reference, arbiter insolar.Reference, deadline int64) (string, error) {
+	var args [4]interface{}
+	args[0] = amount
+	args[1] = to
+	args[2] = arbiter
+	args[3] = deadline
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 string
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "CreateEscrow", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// ReclaimEscrow is proxy generated method
+func (r *Wallet) ReclaimEscrow(aRef *insolar.Reference) error {
+	var args [1]interface{}
+	args[0] = aRef
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "ReclaimEscrow", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// ReclaimEscrowNoWait is proxy generated method
+func (r *Wallet) ReclaimEscrowNoWait(aRef *insolar.Reference) error {
+	var args [1]interface{}
+	args[0] = aRef
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "ReclaimEscrow", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReclaimEscrowAsImmutable is proxy generated method
+func (r *Wallet) ReclaimEscrowAsImmutable(aRef *insolar.Reference) error {
+	var args [1]interface{}
+	args[0] = aRef
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "ReclaimEscrow", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
 // Accept is proxy generated method
 func (r *Wallet) Accept(aRef *insolar.Reference) error {
 	var args [1]interface{}
@@ -409,3 +604,446 @@ func (r *Wallet) GetBalanceAsImmutable() (uint, error) {
 	}
 	return ret0, nil
 }
+
+// GetHistory is proxy generated method
+func (r *Wallet) GetHistory(offset uint, limit uint) (History, error) {
+	var args [2]interface{}
+	args[0] = offset
+	args[1] = limit
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 History
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "GetHistory", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetHistoryNoWait is proxy generated method
+func (r *Wallet) GetHistoryNoWait(offset uint, limit uint) error {
+	var args [2]interface{}
+	args[0] = offset
+	args[1] = limit
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "GetHistory", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetHistoryAsImmutable is proxy generated method
+func (r *Wallet) GetHistoryAsImmutable(offset uint, limit uint) (History, error) {
+	var args [2]interface{}
+	args[0] = offset
+	args[1] = limit
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 History
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "GetHistory", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetTokenBalance is proxy generated method
+func (r *Wallet) GetTokenBalance(tokenRef *insolar.Reference) (uint, error) {
+	var args [1]interface{}
+	args[0] = tokenRef
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 uint
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "GetTokenBalance", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// GetTokenBalanceNoWait is proxy generated method
+func (r *Wallet) GetTokenBalanceNoWait(tokenRef *insolar.Reference) error {
+	var args [1]interface{}
+	args[0] = tokenRef
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "GetTokenBalance", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTokenBalanceAsImmutable is proxy generated method
+func (r *Wallet) GetTokenBalanceAsImmutable(tokenRef *insolar.Reference) (uint, error) {
+	var args [1]interface{}
+	args[0] = tokenRef
+
+	var argsSerialized []byte
+
+	ret := [2]interface{}{}
+	var ret0 uint
+	ret[0] = &ret0
+	var ret1 *foundation.Error
+	ret[1] = &ret1
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return ret0, err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "GetTokenBalance", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return ret0, err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return ret0, err
+	}
+
+	if ret1 != nil {
+		return ret0, ret1
+	}
+	return ret0, nil
+}
+
+// TransferToken is proxy generated method
+func (r *Wallet) TransferToken(tokenRef *insolar.Reference, amount uint, to *insolar.Reference) error {
+	var args [3]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+	args[2] = to
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "TransferToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// TransferTokenNoWait is proxy generated method
+func (r *Wallet) TransferTokenNoWait(tokenRef *insolar.Reference, amount uint, to *insolar.Reference) error {
+	var args [3]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+	args[2] = to
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "TransferToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TransferTokenAsImmutable is proxy generated method
+func (r *Wallet) TransferTokenAsImmutable(tokenRef *insolar.Reference, amount uint, to *insolar.Reference) error {
+	var args [3]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+	args[2] = to
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "TransferToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// ReceiveToken is proxy generated method
+func (r *Wallet) ReceiveToken(tokenRef *insolar.Reference, amount uint) error {
+	var args [2]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "ReceiveToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// ReceiveTokenNoWait is proxy generated method
+func (r *Wallet) ReceiveTokenNoWait(tokenRef *insolar.Reference, amount uint) error {
+	var args [2]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "ReceiveToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ReceiveTokenAsImmutable is proxy generated method
+func (r *Wallet) ReceiveTokenAsImmutable(tokenRef *insolar.Reference, amount uint) error {
+	var args [2]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "ReceiveToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// BurnToken is proxy generated method
+func (r *Wallet) BurnToken(tokenRef *insolar.Reference, amount uint) error {
+	var args [2]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, false, "BurnToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
+
+// BurnTokenNoWait is proxy generated method
+func (r *Wallet) BurnTokenNoWait(tokenRef *insolar.Reference, amount uint) error {
+	var args [2]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	_, err = proxyctx.Current.RouteCall(r.Reference, false, false, "BurnToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BurnTokenAsImmutable is proxy generated method
+func (r *Wallet) BurnTokenAsImmutable(tokenRef *insolar.Reference, amount uint) error {
+	var args [2]interface{}
+	args[0] = tokenRef
+	args[1] = amount
+
+	var argsSerialized []byte
+
+	ret := [1]interface{}{}
+	var ret0 *foundation.Error
+	ret[0] = &ret0
+
+	err := proxyctx.Current.Serialize(args, &argsSerialized)
+	if err != nil {
+		return err
+	}
+
+	res, err := proxyctx.Current.RouteCall(r.Reference, true, true, "BurnToken", argsSerialized, *PrototypeReference)
+	if err != nil {
+		return err
+	}
+
+	err = proxyctx.Current.Deserialize(res, &ret)
+	if err != nil {
+		return err
+	}
+
+	if ret0 != nil {
+		return ret0
+	}
+	return nil
+}
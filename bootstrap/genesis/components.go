@@ -77,7 +77,7 @@ func createCertificateManager(
 }
 
 type storageComponents struct {
-	storeBadgerDB *store.BadgerDB
+	storeDB store.DB
 
 	dropDB   *drop.DB
 	blobDB   *blob.DB
@@ -87,13 +87,13 @@ type storageComponents struct {
 }
 
 func initStorageComponents(conf configuration.Ledger) storageComponents {
-	db, err := store.NewBadgerDB(conf.Storage.DataDirectory)
+	db, err := store.NewDB(conf.Storage.Backend, conf.Storage.DataDirectory)
 	if err != nil {
 		panic(errors.Wrap(err, "failed to initialize DB"))
 	}
 
 	return storageComponents{
-		storeBadgerDB: db,
+		storeDB: db,
 
 		dropDB:   drop.NewDB(db),
 		blobDB:   blob.NewDB(db),
@@ -46,9 +46,10 @@ const (
 	walletContract    = "wallet"
 	memberContract    = "member"
 	allowanceContract = "allowance"
+	tokenContract     = "token"
 )
 
-var contractNames = []string{walletContract, memberContract, allowanceContract, rootDomain, nodeDomain, nodeRecord}
+var contractNames = []string{walletContract, memberContract, allowanceContract, tokenContract, rootDomain, nodeDomain, nodeRecord}
 
 type nodeInfo struct {
 	privateKey crypto.PrivateKey
@@ -83,7 +83,7 @@ func (s *Initializer) Run() {
 		sc.blobDB,
 		sc.dropDB,
 		sc.recordDB,
-		sc.storeBadgerDB,
+		sc.storeDB,
 		sc.pulseDB,
 	)
 
@@ -94,7 +94,7 @@ func (s *Initializer) Run() {
 	checkError(ctx, err, "failed to start components")
 
 	genesisBaseRecord := &genesis.BaseRecord{
-		DB:                    sc.storeBadgerDB,
+		DB:                    sc.storeDB,
 		DropModifier:          sc.dropDB,
 		PulseAppender:         sc.pulseDB,
 		PulseAccessor:         sc.pulseDB,
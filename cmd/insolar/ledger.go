@@ -0,0 +1,77 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/heavy/snapshot"
+	"github.com/insolar/insolar/ledger/heavy/verifier"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func verifyLedger(dataDir string, backend string) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+
+	db, err := store.NewReadOnlyDB(backend, dataDir)
+	check("Failed to open ledger storage:", err)
+
+	v := verifier.NewVerifier(db, platformpolicy.NewPlatformCryptographyScheme())
+	report, err := v.Verify(ctx)
+	check("Failed to verify ledger:", err)
+
+	fmt.Printf("Pulses  : %d\n", report.Pulses)
+	fmt.Printf("Records : %d\n", report.Records)
+	fmt.Printf("Blobs   : %d\n", report.Blobs)
+	fmt.Printf("Indexes : %d\n", report.Indexes)
+	fmt.Printf("Drops   : %d\n", report.Drops)
+
+	if report.OK() {
+		fmt.Println("Ledger is consistent")
+		return
+	}
+
+	fmt.Printf("Found divergences in %d jets:\n", len(report.Divergences))
+	for _, d := range report.Divergences {
+		fmt.Println(d.String())
+	}
+	os.Exit(1)
+}
+
+func restoreLedger(archive string, dataDir string, backend string) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+
+	f, err := os.Open(archive)
+	check("Failed to open snapshot archive:", err)
+	defer f.Close() // nolint: errcheck
+
+	db, err := store.NewDB(backend, dataDir)
+	check("Failed to open ledger storage:", err)
+
+	header, err := snapshot.Restore(ctx, db, f)
+	check("Failed to restore snapshot:", err)
+
+	if stopper, ok := db.(interface{ Stop(context.Context) error }); ok {
+		check("Failed to close ledger storage:", stopper.Stop(ctx))
+	}
+
+	fmt.Printf("Ledger is restored up to pulse %d\n", header.PulseNumber)
+}
@@ -19,6 +19,7 @@ package main
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -26,6 +27,7 @@ import (
 	"github.com/insolar/insolar/api/requester"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/version"
 	"github.com/spf13/cobra"
@@ -81,6 +83,42 @@ func main() {
 	addURLFlag(createMemberCmd.Flags())
 	rootCmd.AddCommand(createMemberCmd)
 
+	var memberKeysFile string
+	var rotateKeyCmd = &cobra.Command{
+		Use:   "rotate-key",
+		Short: "replaces member key with random keys pair, old key is revoked",
+		Run: func(cmd *cobra.Command, args []string) {
+			rotateKey(sendURL, memberKeysFile, logLevel)
+		},
+	}
+	rotateKeyCmd.Flags().StringVarP(
+		&memberKeysFile, "member-keys", "k", "", "path to json with member reference and key pair (create-member output)")
+	rotateKeyCmd.Flags().StringVarP(
+		&logLevel, "log-level-server", "L", "", "log level passed on server via request")
+	addURLFlag(rotateKeyCmd.Flags())
+	rootCmd.AddCommand(rotateKeyCmd)
+
+	var (
+		adminToken      string
+		migrationMethod string
+	)
+	var declareSuccessorCmd = &cobra.Command{
+		Use:   "declare-successor <prototype> <predecessor>...",
+		Short: "declares prototype a successor of other prototypes, signed by their owner or root member",
+		Args:  cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			declareSuccessor(sendURL, adminToken, memberKeysFile, args[0], args[1:], migrationMethod)
+		},
+	}
+	declareSuccessorCmd.Flags().StringVarP(
+		&memberKeysFile, "member-keys", "k", "", "path to json with declarer reference and keys (create-member output)")
+	declareSuccessorCmd.Flags().StringVarP(
+		&adminToken, "admin-token", "t", os.Getenv("INSOLAR_ADMIN_TOKEN"), "admin token of API")
+	declareSuccessorCmd.Flags().StringVarP(
+		&migrationMethod, "migration-method", "m", "", "method of successor, that converts state of migrated objects")
+	addURLFlag(declareSuccessorCmd.Flags())
+	rootCmd.AddCommand(declareSuccessorCmd)
+
 	var genKeysPairCmd = &cobra.Command{
 		Use:   "gen-key-pair",
 		Short: "generates public/private keys pair",
@@ -143,6 +181,43 @@ func main() {
 		&certFile, "node-cert", "c", "cert.json", "The OUT file the node certificate")
 	rootCmd.AddCommand(certgenCmd)
 
+	var (
+		dataDir string
+		backend string
+		archive string
+	)
+	var ledgerCmd = &cobra.Command{
+		Use:   "ledger",
+		Short: "offline operations with heavy node storage",
+	}
+	var verifyCmd = &cobra.Command{
+		Use:   "verify",
+		Short: "checks consistency of heavy node storage",
+		Run: func(cmd *cobra.Command, args []string) {
+			verifyLedger(dataDir, backend)
+		},
+	}
+	verifyCmd.Flags().StringVarP(
+		&dataDir, "data-dir", "d", "./data", "path to heavy node data directory")
+	verifyCmd.Flags().StringVarP(
+		&backend, "backend", "b", store.BackendBadger, "storage backend of the data directory")
+	ledgerCmd.AddCommand(verifyCmd)
+	var restoreCmd = &cobra.Command{
+		Use:   "restore",
+		Short: "restores heavy node storage from snapshot archive",
+		Run: func(cmd *cobra.Command, args []string) {
+			restoreLedger(archive, dataDir, backend)
+		},
+	}
+	restoreCmd.Flags().StringVarP(
+		&archive, "archive", "a", "", "path to snapshot archive")
+	restoreCmd.Flags().StringVarP(
+		&dataDir, "data-dir", "d", "./data", "path to empty heavy node data directory")
+	restoreCmd.Flags().StringVarP(
+		&backend, "backend", "b", store.BackendBadger, "storage backend of the data directory")
+	ledgerCmd.AddCommand(restoreCmd)
+	rootCmd.AddCommand(ledgerCmd)
+
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -210,6 +285,62 @@ func createMember(sendURL string, userName string, serverLogLevel string) {
 	mustWrite(os.Stdout, string(result))
 }
 
+func rotateKey(sendURL string, memberKeysFile string, serverLogLevel string) {
+	ks := platformpolicy.NewKeyProcessor()
+
+	logLevelInsolar, err := insolar.ParseLevel(serverLogLevel)
+	check("Failed to parse logging level", err)
+
+	ucfg, err := requester.ReadUserConfigFromFile(memberKeysFile)
+	check("Problems with reading member config:", err)
+	if ucfg.Caller == "" {
+		check("Problems with reading member config:", errors.New("member reference (caller) is required"))
+	}
+
+	oldPrivKey, err := ks.ImportPrivateKeyPEM([]byte(ucfg.PrivateKey))
+	check("Problems with parsing of private key:", err)
+
+	oldPubKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(oldPrivKey))
+	check("Problems with serialization of public key:", err)
+
+	privKey, err := ks.GeneratePrivateKey()
+	check("Problems with generating of private key:", err)
+
+	privKeyStr, err := ks.ExportPrivateKeyPEM(privKey)
+	check("Problems with serialization of private key:", err)
+
+	pubKeyStr, err := ks.ExportPublicKeyPEM(ks.ExtractPublicKey(privKey))
+	check("Problems with serialization of public key:", err)
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	req := requester.RequestConfigJSON{
+		Params:   []interface{}{string(oldPubKeyStr), string(pubKeyStr)},
+		Method:   "RotateKey",
+		LogLevel: logLevelInsolar,
+	}
+	r, err := requester.Send(ctx, sendURL, ucfg, &req)
+	check("Problems with sending request", err)
+
+	var rStruct struct {
+		Error string `json:"error"`
+	}
+	err = json.Unmarshal(r, &rStruct)
+	check("Problems with understanding result", err)
+	if rStruct.Error != "" {
+		check("Problems with rotating key:", errors.New(rStruct.Error))
+	}
+
+	cfg := mixedConfig{
+		PrivateKey: string(privKeyStr),
+		PublicKey:  string(pubKeyStr),
+		Caller:     ucfg.Caller,
+	}
+	result, err := json.MarshalIndent(cfg, "", "    ")
+	check("Problems with marshaling config:", err)
+
+	mustWrite(os.Stdout, string(result))
+}
+
 func verboseInfo(msg string) {
 	if verbose {
 		fmt.Fprintln(os.Stderr, msg)
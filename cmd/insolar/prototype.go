@@ -0,0 +1,57 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func declareSuccessor(
+	sendURL string, adminToken string, memberKeysFile string,
+	prototype string, predecessors []string, migrationMethod string,
+) {
+	ucfg, err := requester.ReadUserConfigFromFile(memberKeysFile)
+	check("Problems with reading member config:", err)
+	if ucfg.Caller == "" {
+		check("Problems with reading member config:", errors.New("member reference (caller) is required"))
+	}
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	req := requester.RequestConfigJSON{
+		Params: []interface{}{prototype, predecessors, migrationMethod},
+		Method: "prototype.DeclareSuccessor",
+	}
+	r, err := requester.SendAdmin(ctx, sendURL, adminToken, ucfg, &req)
+	check("Problems with sending request", err)
+
+	var rStruct struct {
+		Error interface{} `json:"error"`
+	}
+	err = json.Unmarshal(r, &rStruct)
+	check("Problems with understanding result", err)
+	if rStruct.Error != nil {
+		check("Problems with declaring successor:", fmt.Errorf("%v", rStruct.Error))
+	}
+
+	fmt.Printf("Prototype %s succeeds %v\n", prototype, predecessors)
+}
@@ -18,27 +18,73 @@ package configuration
 
 import (
 	"fmt"
+	"time"
 )
 
 // APIRunner holds configuration for api
 type APIRunner struct {
-	Address string
-	Call    string
-	RPC     string
-	Timeout uint32
+	Address    string
+	Call       string
+	BatchCall  string
+	SubmitCall string
+	RPC        string
+	Timeout    uint32
+	// Subscribe is a path of websocket endpoint for subscriptions, empty value disables it.
+	Subscribe string
+	// SubscribePeriod is a period of checking pulses, network state and results for subscribers.
+	SubscribePeriod time.Duration
+	// Storage configures where seeds and idempotency keys of requests are kept.
+	Storage APIStorage
+	// RateLimits configures admission control of API requests.
+	RateLimits APIRateLimits
+	// AdminToken is a secret, that administrative requests (e.g. snapshot.Create) should pass in X-Insolar-Admin-Token
+	// header. Administrative requests are rejected if it's empty.
+	AdminToken string
+}
+
+// APIRateLimits holds configuration of token bucket limits of API requests. Rate is a count of requests per second
+// and burst is a count of requests allowed at once. Zero rate disables the limit.
+type APIRateLimits struct {
+	// AddressRate limits all requests from a single remote address, every item of batch call takes a token.
+	AddressRate  float64
+	AddressBurst int
+	// MemberRate limits contract calls signed by a single member.
+	MemberRate  float64
+	MemberBurst int
+	// UploadRate limits contract uploads from a single remote address.
+	UploadRate  float64
+	UploadBurst int
+}
+
+// APIStorage holds configuration of storage for seeds and idempotency keys
+type APIStorage struct {
+	// RedisAddress is an address of redis server. Redis keeps seeds and idempotency keys durably and shares them
+	// between API runners behind a load balancer. Data is kept in memory of API runner if address is empty.
+	RedisAddress  string
+	RedisPassword string
+	RedisDB       int
+	// IdempotencyTTL is a period, during which repeated requests with the same idempotency key get the original result.
+	IdempotencyTTL time.Duration
 }
 
 // NewAPIRunner creates new api config
 func NewAPIRunner() APIRunner {
 	return APIRunner{
-		Address: "localhost:19101",
-		Call:    "/api/call",
-		RPC:     "/api/rpc",
-		Timeout: 15,
+		Address:         "localhost:19101",
+		Call:            "/api/call",
+		BatchCall:       "/api/call/batch",
+		SubmitCall:      "/api/call/submit",
+		RPC:             "/api/rpc",
+		Timeout:         15,
+		Subscribe:       "/api/subscribe",
+		SubscribePeriod: time.Second,
+		Storage: APIStorage{
+			IdempotencyTTL: 24 * time.Hour,
+		},
 	}
 }
 
 func (ar *APIRunner) String() string {
-	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC)
+	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", BatchCall ->", ar.BatchCall, ", SubmitCall ->", ar.SubmitCall, ", RPC ->", ar.RPC, ", Subscribe ->", ar.Subscribe)
 	return res
 }
@@ -22,6 +22,8 @@ import (
 
 // Storage configures Ledger's storage.
 type Storage struct {
+	// Backend is a name of the database engine. Supported values are "badger" and "bolt".
+	Backend string
 	// DataDirectory is a directory where database's files live.
 	DataDirectory string
 	// TxRetriesOnConflict defines how many retries on transaction conflicts
@@ -33,6 +35,8 @@ type Storage struct {
 type PulseManager struct {
 	// SplitThreshold is a drop size threshold in bytes to perform split.
 	SplitThreshold uint64
+	// MergeThreshold is a summary drop size threshold in bytes of two sibling jets to perform merge.
+	MergeThreshold uint64
 }
 
 // Backoff configures retry backoff algorithm
@@ -52,6 +56,12 @@ type Exporter struct {
 	ExportLag uint32
 }
 
+// Snapshot holds configuration of heavy's storage snapshots.
+type Snapshot struct {
+	// Directory is a directory where snapshot archives are saved.
+	Directory string
+}
+
 // Ledger holds configuration for ledger.
 type Ledger struct {
 	// Storage defines storage configuration.
@@ -67,9 +77,17 @@ type Ledger struct {
 	// IMPORTANT: It should be the same on ALL nodes.
 	LightChainLimit int
 
+	// LightStorageBudget is a max size in bytes of records and blobs, that light keeps for replicated pulses.
+	// When it's exceeded, records and blobs of the oldest pulses confirmed by heavy are removed before
+	// LightChainLimit is reached. Zero means no budget.
+	LightStorageBudget uint64
+
 	// Exporter holds configuration of Exporter
 	Exporter Exporter
 
+	// Snapshot holds configuration of snapshots
+	Snapshot Snapshot
+
 	// PendingRequestsLimit holds a number of pending requests, what can be stored in the system
 	// before they are declined
 	PendingRequestsLimit int
@@ -79,12 +97,14 @@ type Ledger struct {
 func NewLedger() Ledger {
 	return Ledger{
 		Storage: Storage{
+			Backend:             "badger",
 			DataDirectory:       "./data",
 			TxRetriesOnConflict: 3,
 		},
 
 		PulseManager: PulseManager{
-			SplitThreshold: 10 * 100, // 10 megabytes.
+			SplitThreshold: 10 * 1024 * 1024, // 10 megabytes.
+			MergeThreshold: 1024 * 1024,      // 1 megabyte.
 		},
 		LightChainLimit: 5, // 5 pulses
 
@@ -92,6 +112,10 @@ func NewLedger() Ledger {
 			ExportLag: 40, // 40 seconds
 		},
 
+		Snapshot: Snapshot{
+			Directory: "./snapshots",
+		},
+
 		PendingRequestsLimit: 1000,
 	}
 }
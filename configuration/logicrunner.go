@@ -16,6 +16,10 @@
 
 package configuration
 
+import (
+	"time"
+)
+
 // LogicRunner configuration
 type LogicRunner struct {
 	// RPCListen - address logic runner binds RPC API to
@@ -27,6 +31,51 @@ type LogicRunner struct {
 	BuiltIn *BuiltIn
 	// GoPlugin - configuration of executor based on Go plugins
 	GoPlugin *GoPlugin
+	// Limits - default resource limits of a single contract call
+	Limits ExecutionLimits
+	// PrototypeLimits - resource limits overridden for prototypes, keys are prototype references, zero fields keep
+	// default limits
+	PrototypeLimits map[string]ExecutionLimits
+}
+
+// ExecutionLimits - resource limits of a single contract call, zero value disables a limit
+type ExecutionLimits struct {
+	// Timeout - wall time of a call. Unlike other limits it's not deterministic: the same call may fit the limit on
+	// one node and exceed it on another, so time limit errors can't be repeated by validation.
+	Timeout time.Duration
+	// MaxOutgoingCalls - number of nested calls (RouteCall, SaveAsChild, SaveAsDelegate) made by a call
+	MaxOutgoingCalls int
+	// MaxStateSize - size of serialized object state produced by a call, in bytes
+	MaxStateSize int
+	// MaxEvents - number of events emitted by a call
+	MaxEvents int
+	// MaxEventSize - size of name and data of an event, in bytes
+	MaxEventSize int
+}
+
+// LimitsFor - returns resource limits of calls to the prototype, limits that are not overridden for the prototype
+// (zero fields of override) are taken from default limits
+func (lr *LogicRunner) LimitsFor(prototype string) ExecutionLimits {
+	limits, ok := lr.PrototypeLimits[prototype]
+	if !ok {
+		return lr.Limits
+	}
+	if limits.Timeout == 0 {
+		limits.Timeout = lr.Limits.Timeout
+	}
+	if limits.MaxOutgoingCalls == 0 {
+		limits.MaxOutgoingCalls = lr.Limits.MaxOutgoingCalls
+	}
+	if limits.MaxStateSize == 0 {
+		limits.MaxStateSize = lr.Limits.MaxStateSize
+	}
+	if limits.MaxEvents == 0 {
+		limits.MaxEvents = lr.Limits.MaxEvents
+	}
+	if limits.MaxEventSize == 0 {
+		limits.MaxEventSize = lr.Limits.MaxEventSize
+	}
+	return limits
 }
 
 // BuiltIn configuration, no options at the moment
@@ -39,6 +88,38 @@ type GoPlugin struct {
 	// RunnerProtocol - protocol (network) of above address,
 	// e.g. "tcp", "unix"... see `net.Dial`
 	RunnerProtocol string
+	// Runners - pool of Go plugins executors, calls are spread between them by object reference,
+	// the single runner above is used if the pool is empty
+	Runners []GoPluginRunner
+	// HealthCheckCode - reference of the healthcheck contract code preloaded into runners (see `insgorund --code`),
+	// runners are only checked for connectivity if it's empty
+	HealthCheckCode string
+	// HealthCheckPeriod - period of runners health checks, zero disables checks
+	HealthCheckPeriod time.Duration
+	// HealthCheckTimeout - time a runner has to answer a health check
+	HealthCheckTimeout time.Duration
+	// RestartAfter - count of failed health checks in a row after which a runner with Command is restarted,
+	// zero disables restarts
+	RestartAfter int
+}
+
+// GoPluginRunner - address of a single Go plugins executor
+type GoPluginRunner struct {
+	// Listen - address the executor listens to
+	Listen string
+	// Protocol - protocol (network) of above address, see `net.Dial`
+	Protocol string
+	// Command - command line that starts the executor, the logic runner starts it and restarts it if it fails
+	// health checks; the executor is managed externally if it's empty
+	Command []string
+}
+
+// RunnerList - returns runners of the pool
+func (gp *GoPlugin) RunnerList() []GoPluginRunner {
+	if len(gp.Runners) > 0 {
+		return gp.Runners
+	}
+	return []GoPluginRunner{{Listen: gp.RunnerListen, Protocol: gp.RunnerProtocol}}
 }
 
 // NewLogicRunner - returns default config of the logic runner
@@ -48,8 +129,16 @@ func NewLogicRunner() LogicRunner {
 		RPCProtocol: "tcp",
 		BuiltIn:     &BuiltIn{},
 		GoPlugin: &GoPlugin{
-			RunnerListen:   "127.0.0.1:7777",
-			RunnerProtocol: "tcp",
+			RunnerListen:       "127.0.0.1:7777",
+			RunnerProtocol:     "tcp",
+			HealthCheckPeriod:  5 * time.Second,
+			HealthCheckTimeout: time.Second,
+			RestartAfter:       3,
+		},
+		Limits: ExecutionLimits{
+			Timeout:      10 * time.Minute,
+			MaxEvents:    100,
+			MaxEventSize: 64 * 1024,
 		},
 	}
 }
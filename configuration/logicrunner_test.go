@@ -0,0 +1,47 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogicRunner_LimitsFor(t *testing.T) {
+	lr := NewLogicRunner()
+	lr.Limits = ExecutionLimits{
+		Timeout:          time.Minute,
+		MaxOutgoingCalls: 10,
+		MaxStateSize:     1024,
+		MaxEvents:        5,
+		MaxEventSize:     128,
+	}
+	lr.PrototypeLimits = map[string]ExecutionLimits{
+		"heavy": {MaxStateSize: 1024 * 1024, MaxEvents: 50},
+	}
+
+	require.Equal(t, lr.Limits, lr.LimitsFor("unknown"))
+	require.Equal(t, ExecutionLimits{
+		Timeout:          time.Minute,
+		MaxOutgoingCalls: 10,
+		MaxStateSize:     1024 * 1024,
+		MaxEvents:        50,
+		MaxEventSize:     128,
+	}, lr.LimitsFor("heavy"))
+}
@@ -0,0 +1,75 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/stretchr/testify/require"
+)
+
+type batchResponse struct {
+	Results []response
+	Error   string
+}
+
+func TestBatchTransfer(t *testing.T) {
+	sender := createMember(t, "Sender")
+	first := createMember(t, "First")
+	second := createMember(t, "Second")
+	senderBalance := getBalanceNoErr(t, sender, sender.ref)
+	firstBalance := getBalanceNoErr(t, first, first.ref)
+	secondBalance := getBalanceNoErr(t, second, second.ref)
+
+	cfg, err := requester.CreateUserConfig(sender.ref, sender.privKey)
+	require.NoError(t, err)
+
+	res, err := requester.SendBatch(context.TODO(), TestAPIURL, cfg, []*requester.RequestConfigJSON{
+		{Method: "Transfer", Params: []interface{}{10, first.ref}},
+		{Method: "Transfer", Params: []interface{}{10, sender.ref}},
+		{Method: "Transfer", Params: []interface{}{20, second.ref}},
+	})
+	require.NoError(t, err)
+
+	resp := batchResponse{}
+	err = json.Unmarshal(res, &resp)
+	require.NoError(t, err)
+	require.Empty(t, resp.Error)
+	require.Len(t, resp.Results, 3)
+	require.Empty(t, resp.Results[0].Error)
+	require.Contains(t, resp.Results[1].Error, "Recipient must be different from the sender")
+	require.Empty(t, resp.Results[2].Error)
+
+	checkBalanceFewTimes(t, first, first.ref, firstBalance+10)
+	checkBalanceFewTimes(t, second, second.ref, secondBalance+20)
+	require.Equal(t, senderBalance-30, getBalanceNoErr(t, sender, sender.ref))
+}
+
+func TestBatchEmpty(t *testing.T) {
+	body, err := requester.GetResponseBody(TestCallUrl+"/batch", requester.PostParams{})
+	require.NoError(t, err)
+
+	resp := batchResponse{}
+	err = json.Unmarshal(body, &resp)
+	require.NoError(t, err)
+	require.Contains(t, resp.Error, "Can't unmarshal input params")
+}
@@ -0,0 +1,98 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createEscrow(t *testing.T, from *user, to *user, amount int, deadline time.Time, arbiter string) string {
+	res, err := signedRequest(from, "CreateEscrow", amount, to.ref, deadline.Unix(), arbiter)
+	require.NoError(t, err)
+	escrow, ok := res.(string)
+	require.True(t, ok)
+	return escrow
+}
+
+func TestEscrowReleaseByRecipient(t *testing.T) {
+	sender := createMember(t, "Sender")
+	recipient := createMember(t, "Recipient")
+	senderBalance := getBalanceNoErr(t, sender, sender.ref)
+	recipientBalance := getBalanceNoErr(t, recipient, recipient.ref)
+
+	escrow := createEscrow(t, sender, recipient, 100, time.Now().Add(time.Minute), "")
+	require.Equal(t, senderBalance-100, getBalanceNoErr(t, sender, sender.ref))
+
+	_, err := signedRequest(sender, "ReleaseEscrow", escrow)
+	require.Contains(t, err.Error(), "Only recipient or arbiter can release allowance")
+
+	_, err = signedRequest(recipient, "ReleaseEscrow", escrow)
+	require.NoError(t, err)
+
+	checkBalanceFewTimes(t, recipient, recipient.ref, recipientBalance+100)
+	require.Equal(t, senderBalance-100, getBalanceNoErr(t, sender, sender.ref))
+}
+
+func TestEscrowReleaseByArbiter(t *testing.T) {
+	sender := createMember(t, "Sender")
+	recipient := createMember(t, "Recipient")
+	arbiter := createMember(t, "Arbiter")
+	recipientBalance := getBalanceNoErr(t, recipient, recipient.ref)
+
+	escrow := createEscrow(t, sender, recipient, 100, time.Now().Add(time.Minute), arbiter.ref)
+
+	_, err := signedRequest(arbiter, "ReleaseEscrow", escrow)
+	require.NoError(t, err)
+
+	_, err = signedRequest(arbiter, "ReleaseEscrow", escrow)
+	require.Error(t, err)
+
+	checkBalanceFewTimes(t, recipient, recipient.ref, recipientBalance+100)
+}
+
+func TestEscrowReclaimAfterDeadline(t *testing.T) {
+	sender := createMember(t, "Sender")
+	recipient := createMember(t, "Recipient")
+	senderBalance := getBalanceNoErr(t, sender, sender.ref)
+
+	escrow := createEscrow(t, sender, recipient, 100, time.Now().Add(3*time.Second), "")
+
+	_, err := signedRequest(sender, "ReclaimEscrow", escrow)
+	require.Contains(t, err.Error(), "Allowance is not expired or is released")
+
+	time.Sleep(4 * time.Second)
+
+	_, err = signedRequest(recipient, "ReleaseEscrow", escrow)
+	require.Contains(t, err.Error(), "Allowance expiried")
+
+	_, err = signedRequest(sender, "ReclaimEscrow", escrow)
+	require.NoError(t, err)
+	require.Equal(t, senderBalance, getBalanceNoErr(t, sender, sender.ref))
+}
+
+func TestEscrowDeadlineInPast(t *testing.T) {
+	sender := createMember(t, "Sender")
+	recipient := createMember(t, "Recipient")
+
+	_, err := signedRequest(sender, "CreateEscrow", 100, recipient.ref, time.Now().Add(-time.Minute).Unix(), "")
+	require.Contains(t, err.Error(), "Deadline must be in the future")
+}
@@ -0,0 +1,89 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type historyTransaction struct {
+	Type         string `json:"type"`
+	Counterparty string `json:"counterparty"`
+	Allowance    string `json:"allowance"`
+	Amount       int    `json:"amount"`
+	PulseNumber  uint32 `json:"pulseNumber"`
+	Request      string `json:"request"`
+}
+
+type walletHistory struct {
+	Total        int                  `json:"total"`
+	Transactions []historyTransaction `json:"transactions"`
+}
+
+func getHistory(t *testing.T, caller *user, offset int, limit int) walletHistory {
+	res, err := signedRequest(caller, "GetHistory", offset, limit)
+	require.NoError(t, err)
+	data, err := json.Marshal(res)
+	require.NoError(t, err)
+	history := walletHistory{}
+	err = json.Unmarshal(data, &history)
+	require.NoError(t, err)
+	return history
+}
+
+func TestGetHistoryEmpty(t *testing.T) {
+	member := createMember(t, "Member")
+	history := getHistory(t, member, 0, 0)
+	require.Equal(t, 0, history.Total)
+	require.Empty(t, history.Transactions)
+}
+
+func TestGetHistoryTransfers(t *testing.T) {
+	sender := createMember(t, "Sender")
+	recipient := createMember(t, "Recipient")
+	recipientBalance := getBalanceNoErr(t, recipient, recipient.ref)
+
+	_, err := signedRequest(sender, "Transfer", 10, recipient.ref)
+	require.NoError(t, err)
+	_, err = signedRequest(sender, "Transfer", 20, recipient.ref)
+	require.NoError(t, err)
+	checkBalanceFewTimes(t, recipient, recipient.ref, recipientBalance+30)
+
+	history := getHistory(t, sender, 0, 0)
+	require.Equal(t, 2, history.Total)
+	require.Len(t, history.Transactions, 2)
+	require.Equal(t, "transfer_out", history.Transactions[0].Type)
+	require.Equal(t, 20, history.Transactions[0].Amount)
+	require.Equal(t, recipient.ref, history.Transactions[0].Counterparty)
+	require.NotEmpty(t, history.Transactions[0].Request)
+	require.NotZero(t, history.Transactions[0].PulseNumber)
+
+	page := getHistory(t, sender, 1, 1)
+	require.Equal(t, 2, page.Total)
+	require.Len(t, page.Transactions, 1)
+	require.Equal(t, 10, page.Transactions[0].Amount)
+
+	history = getHistory(t, recipient, 0, 0)
+	require.Equal(t, 2, history.Total)
+	require.Equal(t, "transfer_in", history.Transactions[0].Type)
+	require.Equal(t, sender.ref, history.Transactions[0].Counterparty)
+}
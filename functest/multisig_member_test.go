@@ -0,0 +1,118 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/stretchr/testify/require"
+)
+
+func createMultiSigMember(t *testing.T, keysCount int, threshold uint) (string, []*user) {
+	var signers []*user
+	var keys []string
+	for i := 0; i < keysCount; i++ {
+		signer, err := newUserWithKeys()
+		require.NoError(t, err)
+		signers = append(signers, signer)
+		keys = append(keys, signer.pubKey)
+	}
+	result, err := signedRequest(&root, "CreateMultiSigMember", "MultiSigMember", keys, threshold)
+	require.NoError(t, err)
+	ref, ok := result.(string)
+	require.True(t, ok)
+	return ref, signers
+}
+
+func multiSigRequest(ref string, signers []*user, method string, params ...interface{}) (interface{}, error) {
+	var keys []string
+	for _, s := range signers {
+		keys = append(keys, s.privKey)
+	}
+	cfg, err := requester.CreateMultiSigUserConfig(ref, keys)
+	if err != nil {
+		return nil, err
+	}
+	return sendSignedRequest(cfg, method, params...)
+}
+
+func TestCreateMultiSigMemberWrongThreshold(t *testing.T) {
+	member, err := newUserWithKeys()
+	require.NoError(t, err)
+	_, err = signedRequest(&root, "CreateMultiSigMember", "MultiSigMember", []string{member.pubKey}, 2)
+	require.Contains(t, err.Error(), "Threshold must be between 1 and 1")
+}
+
+func TestMultiSigMemberTransfer(t *testing.T) {
+	ref, signers := createMultiSigMember(t, 3, 2)
+	member := createMember(t, "Member")
+	oldBalance := getBalanceNoErr(t, member, member.ref)
+
+	_, err := multiSigRequest(ref, signers[1:], "Transfer", 111, member.ref)
+	require.NoError(t, err)
+
+	newBalance := getBalanceNoErr(t, member, member.ref)
+	require.Equal(t, oldBalance+111, newBalance)
+}
+
+func TestMultiSigMemberNotEnoughSignatures(t *testing.T) {
+	ref, signers := createMultiSigMember(t, 3, 2)
+	member := createMember(t, "Member")
+
+	_, err := multiSigRequest(ref, signers[:1], "Transfer", 111, member.ref)
+	require.Contains(t, err.Error(), "Not enough signatures: 1 of 2")
+
+	_, err = multiSigRequest(ref, []*user{signers[0], signers[0]}, "Transfer", 111, member.ref)
+	require.Contains(t, err.Error(), "Not enough signatures: 1 of 2")
+}
+
+func TestMultiSigMemberRotateKey(t *testing.T) {
+	ref, signers := createMultiSigMember(t, 2, 2)
+	member := createMember(t, "Member")
+	newSigner, err := newUserWithKeys()
+	require.NoError(t, err)
+
+	_, err = multiSigRequest(ref, signers, "RotateKey", signers[1].pubKey, newSigner.pubKey)
+	require.NoError(t, err)
+
+	_, err = multiSigRequest(ref, signers, "Transfer", 111, member.ref)
+	require.Contains(t, err.Error(), "Not enough signatures: 1 of 2")
+
+	_, err = multiSigRequest(ref, []*user{signers[0], newSigner}, "Transfer", 111, member.ref)
+	require.NoError(t, err)
+}
+
+func TestMultiSigMemberAddKey(t *testing.T) {
+	ref, signers := createMultiSigMember(t, 1, 1)
+	newSigner, err := newUserWithKeys()
+	require.NoError(t, err)
+
+	_, err = multiSigRequest(ref, []*user{newSigner}, "AddKey", newSigner.pubKey)
+	require.Contains(t, err.Error(), "Not enough signatures: 0 of 1")
+
+	_, err = multiSigRequest(ref, signers, "AddKey", newSigner.pubKey)
+	require.NoError(t, err)
+
+	_, err = multiSigRequest(ref, signers, "AddKey", newSigner.pubKey)
+	require.Contains(t, err.Error(), "Key already exists")
+
+	_, err = multiSigRequest(ref, []*user{newSigner}, "GetMyBalance")
+	require.NoError(t, err)
+}
@@ -0,0 +1,86 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateMemberKey(t *testing.T) {
+	member := createMember(t, "Member")
+	newKeys, err := newUserWithKeys()
+	require.NoError(t, err)
+
+	_, err = signedRequest(member, "RotateKey", member.pubKey, newKeys.pubKey)
+	require.NoError(t, err)
+
+	_, err = getBalance(member, member.ref)
+	require.Contains(t, err.Error(), "Incorrect signature")
+
+	rotated := &user{ref: member.ref, privKey: newKeys.privKey, pubKey: newKeys.pubKey}
+	_, err = getBalance(rotated, member.ref)
+	require.NoError(t, err)
+
+	_, err = signedRequest(rotated, "RotateKey", rotated.pubKey, member.pubKey)
+	require.Contains(t, err.Error(), "Key was revoked")
+}
+
+func TestRotateMemberKeyWrongOldKey(t *testing.T) {
+	member := createMember(t, "Member")
+	newKeys, err := newUserWithKeys()
+	require.NoError(t, err)
+
+	_, err = signedRequest(member, "RotateKey", newKeys.pubKey, newKeys.pubKey)
+	require.Contains(t, err.Error(), "[ rotateKeyCall ] Key not found")
+}
+
+func TestRotateNodeKey(t *testing.T) {
+	const testRole = "virtual"
+	oldKeys, err := newUserWithKeys()
+	require.NoError(t, err)
+	newKeys, err := newUserWithKeys()
+	require.NoError(t, err)
+
+	ref, err := registerNodeSignedCall(oldKeys.pubKey, testRole)
+	require.NoError(t, err)
+
+	_, err = signedRequest(&root, "RotateNodeKey", oldKeys.pubKey, newKeys.pubKey)
+	require.NoError(t, err)
+
+	nodeRef, err := getNodeRefSignedCall(newKeys.pubKey)
+	require.NoError(t, err)
+	require.Equal(t, ref, nodeRef)
+
+	_, err = getNodeRefSignedCall(oldKeys.pubKey)
+	require.Contains(t, err.Error(), "[ GetNodeRefByPK ] PK was revoked: ")
+}
+
+func TestRotateNodeKeyByNoRoot(t *testing.T) {
+	const testRole = "virtual"
+	oldKeys, err := newUserWithKeys()
+	require.NoError(t, err)
+	_, err = registerNodeSignedCall(oldKeys.pubKey, testRole)
+	require.NoError(t, err)
+
+	member := createMember(t, "Member")
+	_, err = signedRequest(member, "RotateNodeKey", oldKeys.pubKey, member.pubKey)
+	require.Contains(t, err.Error(), "[ RotateNodeKey ] Only Root member can rotate node key")
+}
@@ -0,0 +1,131 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/insolar/insolar/api/requester"
+	"github.com/stretchr/testify/require"
+)
+
+type callStatusResponse struct {
+	RPCResponse
+	Result struct {
+		Status  string `json:"Status"`
+		TraceID string `json:"TraceID"`
+	} `json:"result"`
+}
+
+type callResultResponse struct {
+	RPCResponse
+	Result struct {
+		Result  interface{} `json:"Result"`
+		Error   string      `json:"Error"`
+		TraceID string      `json:"TraceID"`
+	} `json:"result"`
+}
+
+func signedCallArgs(t *testing.T, cfg *requester.UserConfigJSON, method string, reference string) map[string]interface{} {
+	seed, err := requester.GetSeed(TestAPIURL)
+	require.NoError(t, err)
+	args, err := requester.SignCallArgs(context.TODO(), cfg, method, reference, seed)
+	require.NoError(t, err)
+	return args
+}
+
+func getCallStatus(t *testing.T, cfg *requester.UserConfigJSON, reference string) string {
+	body := getRPSResponseBody(t, postParams{
+		"jsonrpc": "2.0",
+		"method":  "call.Status",
+		"id":      "",
+		"params":  signedCallArgs(t, cfg, "call.Status", reference),
+	})
+	resp := &callStatusResponse{}
+	unmarshalRPCResponse(t, body, resp)
+	return resp.Result.Status
+}
+
+func TestSubmitCall(t *testing.T) {
+	member := createMember(t, "Member")
+
+	cfg, err := requester.CreateUserConfig(member.ref, member.privKey)
+	require.NoError(t, err)
+
+	res, err := requester.Submit(context.TODO(), TestAPIURL, cfg, &requester.RequestConfigJSON{
+		Method: "GetBalance",
+		Params: []interface{}{member.ref},
+	})
+	require.NoError(t, err)
+
+	submitted := response{}
+	unmarshalCallResponse(t, res, &submitted)
+	require.Empty(t, submitted.Error)
+	reference, ok := submitted.Result.(string)
+	require.True(t, ok)
+
+	status := getCallStatus(t, cfg, reference)
+	for i := 0; i < sendRetryCount && status != "done"; i++ {
+		time.Sleep(time.Second)
+		status = getCallStatus(t, cfg, reference)
+	}
+	require.Equal(t, "done", status)
+
+	body := getRPSResponseBody(t, postParams{
+		"jsonrpc": "2.0",
+		"method":  "call.Result",
+		"id":      "",
+		"params":  signedCallArgs(t, cfg, "call.Result", reference),
+	})
+	resp := &callResultResponse{}
+	unmarshalRPCResponse(t, body, resp)
+	require.Empty(t, resp.Result.Error)
+	require.Equal(t, float64(getBalanceNoErr(t, member, member.ref)), resp.Result.Result)
+
+	// Result is not available to other members.
+	stranger := createMember(t, "Stranger")
+	strangerCfg, err := requester.CreateUserConfig(stranger.ref, stranger.privKey)
+	require.NoError(t, err)
+	body = getRPSResponseBody(t, postParams{
+		"jsonrpc": "2.0",
+		"method":  "call.Result",
+		"id":      "",
+		"params":  signedCallArgs(t, strangerCfg, "call.Result", reference),
+	})
+	resp = &callResultResponse{}
+	err = json.Unmarshal(body, resp)
+	require.NoError(t, err)
+	require.NotNil(t, resp.Error)
+}
+
+func TestCallStatusBadReference(t *testing.T) {
+	body := getRPSResponseBody(t, postParams{
+		"jsonrpc": "2.0",
+		"method":  "call.Status",
+		"id":      "",
+		"params":  map[string]string{"Reference": "not a reference"},
+	})
+	resp := &callStatusResponse{}
+	err := json.Unmarshal(body, resp)
+	require.NoError(t, err)
+	require.NotNil(t, resp.Error)
+}
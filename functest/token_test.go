@@ -0,0 +1,99 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// +build functest
+
+package functest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func issueToken(t *testing.T, issuer *user, supply int, mintable bool, burnable bool) string {
+	res, err := signedRequest(issuer, "IssueToken", "Token", 2, supply, mintable, burnable)
+	require.NoError(t, err)
+	token, ok := res.(string)
+	require.True(t, ok)
+	return token
+}
+
+func getTokenBalance(t *testing.T, caller *user, token string) int {
+	res, err := signedRequest(caller, "GetTokenBalance", token)
+	require.NoError(t, err)
+	amount, ok := res.(float64)
+	require.True(t, ok)
+	return int(amount)
+}
+
+func TestIssueAndTransferToken(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	recipient := createMember(t, "Recipient")
+	token := issueToken(t, issuer, 1000, false, false)
+
+	require.Equal(t, 1000, getTokenBalance(t, issuer, token))
+	require.Equal(t, 0, getTokenBalance(t, recipient, token))
+
+	_, err := signedRequest(issuer, "TransferToken", token, 300, recipient.ref)
+	require.NoError(t, err)
+
+	require.Equal(t, 700, getTokenBalance(t, issuer, token))
+	require.Equal(t, 300, getTokenBalance(t, recipient, token))
+
+	_, err = signedRequest(recipient, "TransferToken", token, 301, issuer.ref)
+	require.Contains(t, err.Error(), "Not enough balance for transfer")
+}
+
+func TestTokensAreSeparate(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	first := issueToken(t, issuer, 100, false, false)
+	second := issueToken(t, issuer, 200, false, false)
+
+	require.Equal(t, 100, getTokenBalance(t, issuer, first))
+	require.Equal(t, 200, getTokenBalance(t, issuer, second))
+}
+
+func TestMintToken(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	other := createMember(t, "Other")
+	fixed := issueToken(t, issuer, 100, false, false)
+	mintable := issueToken(t, issuer, 100, true, false)
+
+	_, err := signedRequest(issuer, "MintToken", fixed, 10)
+	require.Contains(t, err.Error(), "Token is not mintable")
+
+	_, err = signedRequest(other, "MintToken", mintable, 10)
+	require.Contains(t, err.Error(), "Only issuer can mint tokens")
+
+	_, err = signedRequest(issuer, "MintToken", mintable, 10)
+	require.NoError(t, err)
+	require.Equal(t, 110, getTokenBalance(t, issuer, mintable))
+}
+
+func TestBurnToken(t *testing.T) {
+	issuer := createMember(t, "Issuer")
+	notBurnable := issueToken(t, issuer, 100, false, false)
+	burnable := issueToken(t, issuer, 100, false, true)
+
+	_, err := signedRequest(issuer, "BurnToken", notBurnable, 10)
+	require.Contains(t, err.Error(), "Token is not burnable")
+	require.Equal(t, 100, getTokenBalance(t, issuer, notBurnable))
+
+	_, err = signedRequest(issuer, "BurnToken", burnable, 10)
+	require.NoError(t, err)
+	require.Equal(t, 90, getTokenBalance(t, issuer, burnable))
+}
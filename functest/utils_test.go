@@ -186,11 +186,15 @@ type response struct {
 }
 
 func signedRequest(user *user, method string, params ...interface{}) (interface{}, error) {
-	ctx := context.TODO()
 	rootCfg, err := requester.CreateUserConfig(user.ref, user.privKey)
 	if err != nil {
 		return nil, err
 	}
+	return sendSignedRequest(rootCfg, method, params...)
+}
+
+func sendSignedRequest(rootCfg *requester.UserConfigJSON, method string, params ...interface{}) (interface{}, error) {
+	ctx := context.TODO()
 	var resp response
 	currentInterNum := 1
 	for ; currentInterNum <= sendRetryCount; currentInterNum++ {
@@ -29,8 +29,30 @@ var (
 	ErrHotDataTimeout = errors.New("requests were abandoned due to hot-data timeout")
 	// ErrNoPendingRequest is returned when there are no pending requests on current LME
 	ErrNoPendingRequest = errors.New("no pending requests are available")
+	// ErrNoResult is returned when there is no result for a request on LME
+	ErrNoResult = errors.New("no result for request is available")
 	// ErrNotFound is returned when something not found
 	ErrNotFound = errors.New("not found")
 	// ErrTooManyPendingRequests is returned when a limit of pending requests has been reached on a current LME
 	ErrTooManyPendingRequests = errors.New("the limit of pending requests count has been reached")
 )
+
+// Kinds of execution limits.
+const (
+	LimitTime          = "time"
+	LimitOutgoingCalls = "outgoing calls"
+	LimitStateSize     = "state size"
+	LimitEvents        = "events"
+	LimitEventSize     = "event size"
+)
+
+// ExecutionLimitError is returned when contract call exceeds its resource limits. It is registered as a result of the
+// request, so a runaway contract completes its request with the error instead of stalling the executor.
+type ExecutionLimitError struct {
+	Limit string
+}
+
+// Error implements error interface.
+func (e *ExecutionLimitError) Error() string {
+	return "execution limit is exceeded: " + e.Limit
+}
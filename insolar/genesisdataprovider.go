@@ -18,6 +18,8 @@ package insolar
 
 import "context"
 
+//go:generate minimock -i github.com/insolar/insolar/insolar.GenesisDataProvider -o ../testutils -s _mock.go
+
 // GenesisDataProvider is the global genesis data provider handler. Other system parts communicate with genesis data provider through it.
 type GenesisDataProvider interface {
 	GetRootDomain(ctx context.Context) *Reference
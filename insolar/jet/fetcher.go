@@ -156,11 +156,22 @@ func (tu *fetcher) Fetch(
 }
 
 // Release unlocks all the queses on the branch for provided jet. I.e. all the jets that are higher in the tree on the
-// current branch get released and "fall through" until they hit provided jet or branch out.
+// current branch get released and "fall through" until they hit provided jet or branch out. Queues of branches of
+// provided jet are released too, they wait for merged jet.
 func (tu *fetcher) Release(ctx context.Context, jetID insolar.JetID, pulse insolar.PulseNumber) {
 	tu.seqMutex.Lock()
 	defer tu.seqMutex.Unlock()
 
+	for key, v := range tu.sequencer {
+		if key.pulse != pulse || !isBranch(jetID, key.jet) {
+			continue
+		}
+		v.once.Do(func() {
+			close(v.ch)
+		})
+		delete(tu.sequencer, key)
+	}
+
 	depth := jetID.Depth()
 	for {
 		key := seqKey{pulse, jetID}
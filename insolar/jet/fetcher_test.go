@@ -332,3 +332,32 @@ func TestJetTreeUpdater_Concurrency(t *testing.T) {
 		wg.Wait()
 	}
 }
+
+func TestJetTreeUpdater_ReleaseMerged(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	jtu := &fetcher{sequencer: map[seqKey]*seqEntry{}}
+
+	pn := gen.PulseNumber()
+	parent := NewIDFromString("1")
+	left, right := NewIDFromString("10"), NewIDFromString("11")
+	other := NewIDFromString("0")
+	for _, id := range []insolar.JetID{parent, left, right, other} {
+		jtu.sequencer[seqKey{pn, id}] = &seqEntry{ch: make(chan struct{})}
+	}
+	waiters := map[insolar.JetID]*seqEntry{}
+	for key, entry := range jtu.sequencer {
+		waiters[key.jet] = entry
+	}
+
+	jtu.Release(ctx, parent, pn)
+
+	for _, id := range []insolar.JetID{parent, left, right} {
+		select {
+		case <-waiters[id].ch:
+		default:
+			t.Fatalf("queue of jet %s is not released", id.DebugString())
+		}
+	}
+	require.Len(t, jtu.sequencer, 1)
+	require.Contains(t, jtu.sequencer, seqKey{pn, other})
+}
@@ -17,6 +17,7 @@
 package jet
 
 import (
+	"bytes"
 	"context"
 	"strconv"
 	"strings"
@@ -38,6 +39,7 @@ type Accessor interface {
 type Modifier interface {
 	Update(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID)
 	Split(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, insolar.JetID, error)
+	Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) error
 	Clone(ctx context.Context, from, to insolar.PulseNumber)
 	DeleteForPN(ctx context.Context, pulse insolar.PulseNumber)
 }
@@ -98,6 +100,28 @@ func Parent(id insolar.JetID) insolar.JetID {
 	return *insolar.NewJetID(depth-1, resetBits(prefix, depth-1))
 }
 
+// Siblings returns both branches of the jet's parent, the left one goes first.
+// If depth of provided JetID is zero, the jet itself is returned twice.
+func Siblings(id insolar.JetID) (insolar.JetID, insolar.JetID) {
+	depth, prefix := id.Depth(), id.Prefix()
+	if depth == 0 {
+		return id, id
+	}
+
+	leftPrefix := resetBits(prefix, depth-1)
+	rightPrefix := resetBits(prefix, depth-1)
+	setBit(rightPrefix, depth-1)
+	return *insolar.NewJetID(depth, leftPrefix), *insolar.NewJetID(depth, rightPrefix)
+}
+
+// isBranch returns true if jet 'id' is 'parent' or one of its branches.
+func isBranch(parent, id insolar.JetID) bool {
+	if id.Depth() < parent.Depth() {
+		return false
+	}
+	return bytes.Equal(resetBits(id.Prefix(), parent.Depth()), parent.Prefix())
+}
+
 // resetBits returns a new byte slice with all bits in 'value' reset,
 // starting from 'start' number of bit.
 //
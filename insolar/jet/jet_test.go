@@ -38,6 +38,21 @@ func TestJet_Parent(t *testing.T) {
 	require.Equal(t, emptyChild, emptyParent, "for empty jet ID, got the same parent")
 }
 
+func TestJet_Siblings(t *testing.T) {
+	left, right := Siblings(NewIDFromString("01011"))
+	require.Equal(t, NewIDFromString("01010"), left, "got proper left sibling")
+	require.Equal(t, NewIDFromString("01011"), right, "got proper right sibling")
+
+	left, right = Siblings(NewIDFromString("1"))
+	require.Equal(t, NewIDFromString("0"), left, "got proper left sibling")
+	require.Equal(t, NewIDFromString("1"), right, "got proper right sibling")
+
+	emptyJet := *insolar.NewJetID(0, nil)
+	left, right = Siblings(emptyJet)
+	require.Equal(t, emptyJet, left, "for empty jet ID, got the same jet")
+	require.Equal(t, emptyJet, right, "for empty jet ID, got the same jet")
+}
+
 func TestJet_ResetBits(t *testing.T) {
 	orig := []byte{0xFF}
 	got := resetBits(orig, 5)
@@ -30,6 +30,11 @@ type ModifierMock struct {
 	DeleteForPNPreCounter uint64
 	DeleteForPNMock       mModifierMockDeleteForPN
 
+	MergeFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r error)
+	MergeCounter    uint64
+	MergePreCounter uint64
+	MergeMock       mModifierMockMerge
+
 	SplitFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 insolar.JetID, r2 error)
 	SplitCounter    uint64
 	SplitPreCounter uint64
@@ -51,6 +56,7 @@ func NewModifierMock(t minimock.Tester) *ModifierMock {
 
 	m.CloneMock = mModifierMockClone{mock: m}
 	m.DeleteForPNMock = mModifierMockDeleteForPN{mock: m}
+	m.MergeMock = mModifierMockMerge{mock: m}
 	m.SplitMock = mModifierMockSplit{mock: m}
 	m.UpdateMock = mModifierMockUpdate{mock: m}
 
@@ -306,6 +312,155 @@ func (m *ModifierMock) DeleteForPNFinished() bool {
 	return true
 }
 
+type mModifierMockMerge struct {
+	mock              *ModifierMock
+	mainExpectation   *ModifierMockMergeExpectation
+	expectationSeries []*ModifierMockMergeExpectation
+}
+
+type ModifierMockMergeExpectation struct {
+	input  *ModifierMockMergeInput
+	result *ModifierMockMergeResult
+}
+
+type ModifierMockMergeInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 insolar.JetID
+}
+
+type ModifierMockMergeResult struct {
+	r error
+}
+
+//Expect specifies that invocation of Modifier.Merge is expected from 1 to Infinity times
+func (m *mModifierMockMerge) Expect(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) *mModifierMockMerge {
+	m.mock.MergeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ModifierMockMergeExpectation{}
+	}
+	m.mainExpectation.input = &ModifierMockMergeInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Modifier.Merge
+func (m *mModifierMockMerge) Return(r error) *ModifierMock {
+	m.mock.MergeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ModifierMockMergeExpectation{}
+	}
+	m.mainExpectation.result = &ModifierMockMergeResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Modifier.Merge is expected once
+func (m *mModifierMockMerge) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) *ModifierMockMergeExpectation {
+	m.mock.MergeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ModifierMockMergeExpectation{}
+	expectation.input = &ModifierMockMergeInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ModifierMockMergeExpectation) Return(r error) {
+	e.result = &ModifierMockMergeResult{r}
+}
+
+//Set uses given function f as a mock of Modifier.Merge method
+func (m *mModifierMockMerge) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r error)) *ModifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.MergeFunc = f
+	return m.mock
+}
+
+//Merge implements github.com/insolar/insolar/insolar/jet.Modifier interface
+func (m *ModifierMock) Merge(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r error) {
+	counter := atomic.AddUint64(&m.MergePreCounter, 1)
+	defer atomic.AddUint64(&m.MergeCounter, 1)
+
+	if len(m.MergeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.MergeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ModifierMock.Merge. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.MergeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ModifierMockMergeInput{p, p1, p2}, "Modifier.Merge got unexpected parameters")
+
+		result := m.MergeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ModifierMock.Merge")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.MergeMock.mainExpectation != nil {
+
+		input := m.MergeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ModifierMockMergeInput{p, p1, p2}, "Modifier.Merge got unexpected parameters")
+		}
+
+		result := m.MergeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ModifierMock.Merge")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.MergeFunc == nil {
+		m.t.Fatalf("Unexpected call to ModifierMock.Merge. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.MergeFunc(p, p1, p2)
+}
+
+//MergeMinimockCounter returns a count of ModifierMock.MergeFunc invocations
+func (m *ModifierMock) MergeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.MergeCounter)
+}
+
+//MergeMinimockPreCounter returns the value of ModifierMock.Merge invocations
+func (m *ModifierMock) MergeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.MergePreCounter)
+}
+
+//MergeFinished returns true if mock invocations count is ok
+func (m *ModifierMock) MergeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.MergeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.MergeCounter) == uint64(len(m.MergeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.MergeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.MergeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.MergeFunc != nil {
+		return atomic.LoadUint64(&m.MergeCounter) > 0
+	}
+
+	return true
+}
+
 type mModifierMockSplit struct {
 	mock              *ModifierMock
 	mainExpectation   *ModifierMockSplitExpectation
@@ -599,6 +754,10 @@ func (m *ModifierMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ModifierMock.DeleteForPN")
 	}
 
+	if !m.MergeFinished() {
+		m.t.Fatal("Expected call to ModifierMock.Merge")
+	}
+
 	if !m.SplitFinished() {
 		m.t.Fatal("Expected call to ModifierMock.Split")
 	}
@@ -632,6 +791,10 @@ func (m *ModifierMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ModifierMock.DeleteForPN")
 	}
 
+	if !m.MergeFinished() {
+		m.t.Fatal("Expected call to ModifierMock.Merge")
+	}
+
 	if !m.SplitFinished() {
 		m.t.Fatal("Expected call to ModifierMock.Split")
 	}
@@ -656,6 +819,7 @@ func (m *ModifierMock) MinimockWait(timeout time.Duration) {
 		ok := true
 		ok = ok && m.CloneFinished()
 		ok = ok && m.DeleteForPNFinished()
+		ok = ok && m.MergeFinished()
 		ok = ok && m.SplitFinished()
 		ok = ok && m.UpdateFinished()
 
@@ -674,6 +838,10 @@ func (m *ModifierMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ModifierMock.DeleteForPN")
 			}
 
+			if !m.MergeFinished() {
+				m.t.Error("Expected call to ModifierMock.Merge")
+			}
+
 			if !m.SplitFinished() {
 				m.t.Error("Expected call to ModifierMock.Split")
 			}
@@ -702,6 +870,10 @@ func (m *ModifierMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.MergeFinished() {
+		return false
+	}
+
 	if !m.SplitFinished() {
 		return false
 	}
@@ -63,6 +63,12 @@ func (lt *lockedTree) split(id insolar.JetID) (insolar.JetID, insolar.JetID, err
 	return lt.t.Split(id)
 }
 
+func (lt *lockedTree) merge(id insolar.JetID) error {
+	lt.Lock()
+	defer lt.Unlock()
+	return lt.t.Merge(id)
+}
+
 // Store stores jet trees per pulse.
 // It provides methods for querying and modification this trees.
 type Store struct {
@@ -113,6 +119,11 @@ func (s *Store) Split(
 	return left, right, nil
 }
 
+// Merge removes branches of provided jet, so two sibling jets become their parent again.
+func (s *Store) Merge(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) error {
+	return s.ltreeForPulse(pulse).merge(id)
+}
+
 // Clone copies tree from one pulse to another. Use it to copy past tree into new pulse.
 func (s *Store) Clone(
 	ctx context.Context, from, to insolar.PulseNumber,
@@ -70,6 +70,20 @@ func TestJetStorage_SplitJetTree(t *testing.T) {
 	require.Equal(t, "root (level=0 actual=false)\n 0 (level=1 actual=false)\n 1 (level=1 actual=false)\n", tree.String())
 }
 
+func TestJetStorage_MergeJetTree(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	s := NewStore()
+
+	_, _, err := s.Split(ctx, 100, *insolar.NewJetID(0, nil))
+	require.NoError(t, err)
+
+	err = s.Merge(ctx, 100, *insolar.NewJetID(0, nil))
+	require.NoError(t, err)
+
+	tree, _ := treeForPulse(s, 100)
+	require.Equal(t, "root (level=0 actual=false)\n", tree.String())
+}
+
 func TestJetStorage_CloneJetTree(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	s := NewStore()
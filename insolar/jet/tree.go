@@ -48,11 +48,31 @@ func (j *jet) Find(val []byte, depth uint8) (*jet, uint8) {
 	return j, depth
 }
 
+// Get returns jet on provided depth for provided prefix or nil if the tree is not deep enough.
+func (j *jet) Get(prefix []byte, depth, maxDepth uint8) *jet {
+	if j == nil || depth == maxDepth {
+		return j
+	}
+
+	if getBit(prefix, depth) {
+		return j.Right.Get(prefix, depth+1, maxDepth)
+	}
+	return j.Left.Get(prefix, depth+1, maxDepth)
+}
+
+// IsLeaf returns true if jet has no branches.
+func (j *jet) IsLeaf() bool {
+	return j.Left == nil && j.Right == nil
+}
+
 // Update add missing tree branches for provided prefix.
 func (j *jet) Update(prefix []byte, setActual bool, maxDepth, depth uint8) {
 	if depth == maxDepth {
 		if setActual {
 			j.Actual = true
+			// Actual jet is a leaf. Its branches are left from a previous pulse, the jet was merged since then.
+			j.Left = nil
+			j.Right = nil
 		}
 		return
 	}
@@ -139,7 +159,8 @@ func (t *Tree) Find(recordID insolar.ID) (insolar.JetID, bool) {
 }
 
 // Update add missing tree branches for provided prefix.
-// If 'setActual' is set, all encountered nodes will be marked as actual.
+// If 'setActual' is set, the jet will be marked as actual and its branches will be removed,
+// so merge of jets is propagated by updating the tree with the merged jet.
 func (t *Tree) Update(id insolar.JetID, setActual bool) {
 	t.Head.Update(id.Prefix(), setActual, id.Depth(), 0)
 }
@@ -165,6 +186,26 @@ func (t *Tree) Split(id insolar.JetID) (insolar.JetID, insolar.JetID, error) {
 	return *left, *right, nil
 }
 
+// Merge looks for provided jet and removes its branches, so the jet becomes a leaf again.
+// If provided jet is not found or its branches are not leaves, an error will be returned.
+// Merging of a leaf jet does nothing.
+func (t *Tree) Merge(id insolar.JetID) error {
+	j := t.Head.Get(id.Prefix(), 0, id.Depth())
+	if j == nil {
+		return errors.New("failed to merge: incorrect jet provided")
+	}
+	if j.IsLeaf() {
+		return nil
+	}
+	if j.Left == nil || !j.Left.IsLeaf() || j.Right == nil || !j.Right.IsLeaf() {
+		return errors.New("failed to merge: jet branches are not leaves")
+	}
+
+	j.Left = nil
+	j.Right = nil
+	return nil
+}
+
 func (t *Tree) LeafIDs() []insolar.JetID {
 	var ids []insolar.JetID
 	t.Head.ExtractLeafIDs(&ids, make([]byte, insolar.RecordHashSize), 0)
@@ -134,6 +134,88 @@ func TestTree_Split(t *testing.T) {
 	})
 }
 
+func TestTree_Merge(t *testing.T) {
+	tree := Tree{
+		Head: &jet{
+			Left: &jet{},
+			Right: &jet{
+				Left: &jet{},
+				Right: &jet{
+					Left:  &jet{},
+					Right: &jet{},
+				},
+			},
+		},
+	}
+
+	t.Run("not existing jet returns error", func(t *testing.T) {
+		err := tree.Merge(NewIDFromString("0101"))
+		assert.Error(t, err)
+	})
+
+	t.Run("jet with deep branches returns error", func(t *testing.T) {
+		err := tree.Merge(NewIDFromString("1"))
+		assert.Error(t, err)
+	})
+
+	t.Run("leaf jet is not changed", func(t *testing.T) {
+		err := tree.Merge(NewIDFromString("0"))
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.JetID{
+			NewIDFromString("0"),
+			NewIDFromString("10"),
+			NewIDFromString("110"),
+			NewIDFromString("111"),
+		}, tree.LeafIDs())
+	})
+
+	t.Run("merges jet", func(t *testing.T) {
+		err := tree.Merge(NewIDFromString("11"))
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.JetID{
+			NewIDFromString("0"),
+			NewIDFromString("10"),
+			NewIDFromString("11"),
+		}, tree.LeafIDs())
+
+		err = tree.Merge(NewIDFromString("1"))
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.JetID{
+			NewIDFromString("0"),
+			NewIDFromString("1"),
+		}, tree.LeafIDs())
+	})
+}
+
+func TestTree_UpdateMerged(t *testing.T) {
+	tree := Tree{
+		Head: &jet{
+			Left: &jet{},
+			Right: &jet{
+				Left:  &jet{},
+				Right: &jet{},
+			},
+		},
+	}
+
+	tree.Update(NewIDFromString("1"), false)
+	assert.Equal(t, []insolar.JetID{
+		NewIDFromString("0"),
+		NewIDFromString("10"),
+		NewIDFromString("11"),
+	}, tree.LeafIDs(), "not actual jet keeps branches")
+
+	tree.Update(NewIDFromString("1"), true)
+	assert.Equal(t, []insolar.JetID{
+		NewIDFromString("0"),
+		NewIDFromString("1"),
+	}, tree.LeafIDs(), "actual jet has no branches")
+
+	id, actual := tree.Find(*insolar.NewID(0, []byte{0xD5})) // 11010101
+	assert.Equal(t, NewIDFromString("1"), id)
+	assert.True(t, actual)
+}
+
 func TestTree_String(t *testing.T) {
 	tree := Tree{
 		Head: &jet{
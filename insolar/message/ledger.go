@@ -319,6 +319,10 @@ type HotData struct {
 	HotIndexes      []HotIndex
 	PendingRequests map[insolar.ID]recentstorage.PendingObjectContext
 	PulseNumber     insolar.PulseNumber
+
+	// SiblingDrop is set when Jet was merged from two sibling jets.
+	// It holds the drop of the right sibling while Drop holds the drop of the left one.
+	SiblingDrop *drop.Drop
 }
 
 // AllowedSenderObjectAndRole implements interface method
@@ -457,6 +461,63 @@ func (m *GetRequest) DefaultTarget() *insolar.Reference {
 	return insolar.NewReference(insolar.DomainID, m.Request)
 }
 
+// GetResult fetches result record of a request from LME.
+type GetResult struct {
+	ledgerMessage
+
+	Request insolar.ID
+}
+
+// Type implementation of Message interface.
+func (*GetResult) Type() insolar.MessageType {
+	return insolar.TypeGetResult
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetResult) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, insolar.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetResult) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleLightExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetResult) DefaultTarget() *insolar.Reference {
+	return insolar.NewReference(insolar.DomainID, m.Request)
+}
+
+// GetEvents fetches contract events of an object from heavy.
+type GetEvents struct {
+	ledgerMessage
+
+	Object insolar.ID
+	Name   string
+	From   insolar.PulseNumber
+	Limit  int
+}
+
+// Type implementation of Message interface.
+func (*GetEvents) Type() insolar.MessageType {
+	return insolar.TypeGetEvents
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetEvents) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, insolar.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetEvents) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleHeavyExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetEvents) DefaultTarget() *insolar.Reference {
+	return insolar.NewReference(insolar.DomainID, m.Object)
+}
+
 // GetPendingRequestID fetches a pending request id for an object from current LME
 type GetPendingRequestID struct {
 	ledgerMessage
@@ -77,6 +77,10 @@ func getEmptyMessage(mt insolar.MessageType) (insolar.Message, error) {
 		return &GetPendingRequestID{}, nil
 	case insolar.TypeGetRequest:
 		return &GetRequest{}, nil
+	case insolar.TypeGetResult:
+		return &GetResult{}, nil
+	case insolar.TypeGetEvents:
+		return &GetEvents{}, nil
 
 	// heavy sync
 	case insolar.TypeHeavyPayload:
@@ -214,6 +218,8 @@ func init() {
 	gob.Register(&HotData{})
 	gob.Register(&GetPendingRequestID{})
 	gob.Register(&GetRequest{})
+	gob.Register(&GetResult{})
+	gob.Register(&GetEvents{})
 
 	// heavy
 	gob.Register(&HeavyPayload{})
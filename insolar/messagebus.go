@@ -243,6 +243,11 @@ const (
 
 	// TypeNodeSignRequest used to request sign for new node
 	TypeNodeSignRequest
+
+	// TypeGetResult fetches result of a request from ledger.
+	TypeGetResult
+	// TypeGetEvents fetches contract events of an object from ledger.
+	TypeGetEvents
 )
 
 // DelegationTokenType is an enum type of delegation token
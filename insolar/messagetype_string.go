@@ -35,11 +35,13 @@ func _() {
 	_ = x[TypeHeavyPayload-24]
 	_ = x[TypeGenesisRequest-25]
 	_ = x[TypeNodeSignRequest-26]
+	_ = x[TypeGetResult-27]
+	_ = x[TypeGetEvents-28]
 }
 
-const _MessageType_name = "TypeCallMethodTypeReturnResultsTypeExecutorResultsTypeValidateCaseBindTypeValidationResultsTypePendingFinishedTypeStillExecutingTypeGetCodeTypeGetObjectTypeGetDelegateTypeGetChildrenTypeUpdateObjectTypeRegisterChildTypeSetRecordTypeValidateRecordTypeSetBlobTypeGetObjectIndexTypeGetPendingRequestsTypeHotRecordsTypeGetJetTypeAbandonedRequestsNotificationTypeGetRequestTypeGetPendingRequestIDTypeHeavyStartStopTypeHeavyPayloadTypeGenesisRequestTypeNodeSignRequest"
+const _MessageType_name = "TypeCallMethodTypeReturnResultsTypeExecutorResultsTypeValidateCaseBindTypeValidationResultsTypePendingFinishedTypeStillExecutingTypeGetCodeTypeGetObjectTypeGetDelegateTypeGetChildrenTypeUpdateObjectTypeRegisterChildTypeSetRecordTypeValidateRecordTypeSetBlobTypeGetObjectIndexTypeGetPendingRequestsTypeHotRecordsTypeGetJetTypeAbandonedRequestsNotificationTypeGetRequestTypeGetPendingRequestIDTypeHeavyStartStopTypeHeavyPayloadTypeGenesisRequestTypeNodeSignRequestTypeGetResultTypeGetEvents"
 
-var _MessageType_index = [...]uint16{0, 14, 31, 50, 70, 91, 110, 128, 139, 152, 167, 182, 198, 215, 228, 246, 257, 275, 297, 311, 321, 354, 368, 391, 409, 425, 443, 462}
+var _MessageType_index = [...]uint16{0, 14, 31, 50, 70, 91, 110, 128, 139, 152, 167, 182, 198, 215, 228, 246, 257, 275, 297, 311, 321, 354, 368, 391, 409, 425, 443, 462, 475, 488}
 
 func (i MessageType) String() string {
 	if i >= MessageType(len(_MessageType_index)-1) {
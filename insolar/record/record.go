@@ -48,6 +48,8 @@ type State interface {
 	GetMemory() *insolar.ID
 	// PrevStateID returns previous state id.
 	PrevStateID() *insolar.ID
+	// GetRequest returns reference to the request, that produced the state.
+	GetRequest() *insolar.Reference
 }
 
 func (Activate) ID() StateID {
@@ -70,6 +72,10 @@ func (Activate) PrevStateID() *insolar.ID {
 	return nil
 }
 
+func (p Activate) GetRequest() *insolar.Reference {
+	return &p.Request
+}
+
 func (Amend) ID() StateID {
 	return StateAmend
 }
@@ -90,6 +96,10 @@ func (p Amend) PrevStateID() *insolar.ID {
 	return &p.PrevState
 }
 
+func (p Amend) GetRequest() *insolar.Reference {
+	return &p.Request
+}
+
 func (Deactivate) ID() StateID {
 	return StateDeactivation
 }
@@ -110,6 +120,10 @@ func (p Deactivate) PrevStateID() *insolar.ID {
 	return &p.PrevState
 }
 
+func (p Deactivate) GetRequest() *insolar.Reference {
+	return &p.Request
+}
+
 func (Genesis) PrevStateID() *insolar.ID {
 	return nil
 }
@@ -129,3 +143,7 @@ func (Genesis) GetImage() *insolar.Reference {
 func (Genesis) GetIsPrototype() bool {
 	return false
 }
+
+func (Genesis) GetRequest() *insolar.Reference {
+	return nil
+}
@@ -242,6 +242,7 @@ type Result struct {
 	Object    github_com_insolar_insolar_insolar.ID        `protobuf:"bytes,20,opt,name=Object,proto3,customtype=github.com/insolar/insolar/insolar.ID" json:"Object"`
 	Request   github_com_insolar_insolar_insolar.Reference `protobuf:"bytes,21,opt,name=Request,proto3,customtype=github.com/insolar/insolar/insolar.Reference" json:"Request"`
 	Payload   []byte                                       `protobuf:"bytes,22,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	Events    []byte                                       `protobuf:"bytes,23,opt,name=Events,proto3" json:"Events,omitempty"`
 }
 
 func (m *Result) Reset()      { *m = Result{} }
@@ -939,75 +940,74 @@ func init() {
 func init() { proto.RegisterFile("insolar/record/record.proto", fileDescriptor_0c86cc3f6f53fe45) }
 
 var fileDescriptor_0c86cc3f6f53fe45 = []byte{
-	// 1076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xde, 0x89, 0x7f, 0xc4, 0x79, 0x49, 0x5a, 0x33, 0x0a, 0x61, 0x9a, 0xb6, 0x1b, 0xcb, 0x52,
-	0x24, 0x57, 0xa5, 0x4e, 0x15, 0x2a, 0x84, 0xb8, 0x39, 0x76, 0x8b, 0x1d, 0xea, 0x10, 0x4d, 0x2c,
-	0xe0, 0x84, 0x34, 0xb1, 0x27, 0xf6, 0x96, 0xf5, 0x6e, 0xd8, 0x9d, 0x8d, 0x94, 0x1b, 0x7f, 0x02,
-	0x17, 0x4e, 0x5c, 0x38, 0xf6, 0x6f, 0xe0, 0xc4, 0x05, 0x29, 0xc7, 0x1c, 0x2b, 0x24, 0x2a, 0xe2,
-	0x08, 0x09, 0x6e, 0x11, 0x7f, 0x01, 0x9a, 0x1f, 0xbb, 0xeb, 0x44, 0xa8, 0x4e, 0x1d, 0x84, 0x54,
-	0xc4, 0xc9, 0x3b, 0x6f, 0xbf, 0xf9, 0xbc, 0xdf, 0x37, 0xf3, 0xde, 0xbc, 0x81, 0xdb, 0x8e, 0x17,
-	0xfa, 0x2e, 0x0b, 0xd6, 0x03, 0xde, 0xf5, 0x83, 0x9e, 0xf9, 0xa9, 0x1e, 0x04, 0xbe, 0xf0, 0x71,
-	0x5e, 0x8f, 0x56, 0x1e, 0xf4, 0x1d, 0x31, 0x88, 0xf6, 0xaa, 0x5d, 0x7f, 0xb8, 0xde, 0xf7, 0xfb,
-	0xfe, 0xba, 0x7a, 0xbd, 0x17, 0xed, 0xab, 0x91, 0x1a, 0xa8, 0x27, 0x3d, 0xad, 0x5c, 0x83, 0xd9,
-	0x8f, 0xb8, 0xc7, 0x43, 0x27, 0xc4, 0x77, 0x60, 0xee, 0xc0, 0x77, 0x8f, 0x86, 0x7e, 0x70, 0x30,
-	0x20, 0xc5, 0x12, 0xaa, 0xe4, 0x68, 0x1a, 0xc0, 0x18, 0xb2, 0x4d, 0x16, 0x0e, 0xc8, 0x52, 0x09,
-	0x55, 0x16, 0xa8, 0x7a, 0xfe, 0x30, 0xfb, 0xfc, 0xfb, 0x55, 0x54, 0xfe, 0x11, 0x41, 0xae, 0x3e,
-	0x70, 0xdc, 0xde, 0x04, 0x86, 0x8f, 0x61, 0x6e, 0x27, 0xe0, 0x87, 0x0a, 0xaa, 0x69, 0x36, 0x1f,
-	0x1c, 0xbf, 0x5c, 0xb5, 0x7e, 0x7e, 0xb9, 0xba, 0x36, 0xf6, 0xd1, 0xb1, 0xc8, 0x4b, 0xbf, 0xd5,
-	0x56, 0x83, 0xa6, 0xf3, 0xf1, 0x13, 0xc8, 0x50, 0xbe, 0x4f, 0xde, 0x56, 0x34, 0x8f, 0x0c, 0xcd,
-	0xbb, 0x57, 0xa0, 0xa1, 0x7c, 0x9f, 0x07, 0xdc, 0xeb, 0x72, 0x2a, 0x09, 0x8c, 0x84, 0x7b, 0x90,
-	0xd9, 0xe2, 0xe2, 0xd5, 0xdf, 0x6f, 0xa0, 0xdf, 0xe5, 0x61, 0x96, 0xf2, 0xaf, 0x22, 0x1e, 0x4e,
-	0xc0, 0xe3, 0x2a, 0x14, 0xea, 0xcc, 0x75, 0x3b, 0x47, 0x07, 0x5c, 0xc9, 0xbd, 0xb1, 0x81, 0xab,
-	0x66, 0xc9, 0x0c, 0x41, 0xb5, 0xde, 0xa1, 0x09, 0x06, 0x3f, 0x85, 0xbc, 0x7c, 0xe6, 0xc1, 0xb5,
-	0x54, 0x19, 0x0e, 0xfc, 0x05, 0xdc, 0xd4, 0x4f, 0x3b, 0x72, 0x9d, 0x85, 0xfc, 0x88, 0xe5, 0x6b,
-	0xd0, 0x5e, 0x26, 0xc3, 0x4b, 0x90, 0xdb, 0xf6, 0xbd, 0x2e, 0x27, 0xef, 0x94, 0x50, 0x25, 0x4b,
-	0xf5, 0x00, 0xaf, 0x40, 0x61, 0x57, 0x6a, 0x93, 0x2f, 0x88, 0x7a, 0x91, 0x8c, 0xf1, 0x06, 0x00,
-	0xe5, 0x22, 0x0a, 0xbc, 0xb6, 0xdf, 0xe3, 0xe4, 0xd6, 0xdf, 0x3b, 0x42, 0xdb, 0x74, 0x0c, 0x25,
-	0x1d, 0x6e, 0x0d, 0x87, 0x91, 0x60, 0x7b, 0x2e, 0x27, 0x2b, 0x25, 0x54, 0x29, 0xd0, 0x34, 0x80,
-	0x1b, 0x90, 0xdd, 0x64, 0x21, 0x27, 0xb7, 0x95, 0xb0, 0x87, 0xaf, 0x2d, 0x4a, 0xcd, 0xc6, 0x4d,
-	0xc8, 0x7f, 0xb2, 0xf7, 0x8c, 0x77, 0x05, 0xb9, 0x33, 0x25, 0x8f, 0x99, 0x8f, 0xb7, 0xe5, 0x0e,
-	0x8f, 0xdd, 0xbe, 0x3b, 0x25, 0x59, 0x4a, 0x81, 0x97, 0x21, 0xdf, 0xe6, 0x62, 0xe0, 0xf7, 0x88,
-	0x5d, 0x42, 0x95, 0x39, 0x6a, 0x46, 0xd2, 0x95, 0x5a, 0xd0, 0x8f, 0x86, 0xdc, 0x13, 0x21, 0x59,
-	0x55, 0x09, 0x99, 0x06, 0xca, 0x5b, 0x30, 0x53, 0xef, 0xe0, 0x05, 0x28, 0xd4, 0x3b, 0x1a, 0x5f,
-	0xb4, 0xf0, 0x5b, 0xb0, 0x58, 0xef, 0xec, 0xb2, 0x43, 0x5e, 0x0b, 0x55, 0xfe, 0x14, 0x11, 0x5e,
-	0x82, 0x62, 0x1c, 0x6a, 0x70, 0x97, 0xf7, 0x99, 0xe0, 0xc5, 0x19, 0xbc, 0x08, 0x73, 0xf5, 0x8e,
-	0xa9, 0x08, 0xc5, 0x4c, 0xb9, 0x02, 0x33, 0xb4, 0x8d, 0x8b, 0xb0, 0xa0, 0xd7, 0x84, 0xf2, 0x30,
-	0x72, 0x45, 0xd1, 0x4a, 0x23, 0xdb, 0xfe, 0x67, 0xcc, 0x11, 0x45, 0x64, 0xb2, 0xe3, 0x17, 0x04,
-	0x79, 0x0d, 0x9a, 0x90, 0x1c, 0x8f, 0x13, 0xd3, 0xa7, 0xaa, 0x04, 0xa9, 0xe3, 0x71, 0x32, 0x5e,
-	0x2b, 0x69, 0x92, 0x8c, 0x26, 0x30, 0xbb, 0xc3, 0x8e, 0x5c, 0x9f, 0xf5, 0x74, 0xb6, 0xd0, 0x78,
-	0x68, 0xf4, 0xfd, 0x89, 0x20, 0xab, 0x92, 0xf5, 0xd5, 0xea, 0x9e, 0x42, 0xbe, 0xe1, 0x0f, 0x99,
-	0xe3, 0x19, 0x75, 0x53, 0xa6, 0xb2, 0xe6, 0xf8, 0xc7, 0x45, 0x56, 0xe0, 0xa6, 0xd4, 0xd0, 0xe0,
-	0x5d, 0x97, 0x05, 0x4c, 0x38, 0xbe, 0x67, 0xc4, 0x5e, 0x0e, 0x1b, 0xd1, 0xbf, 0xcd, 0x40, 0xb6,
-	0x6e, 0xb2, 0xf1, 0x8d, 0x15, 0x5d, 0xd3, 0x1a, 0x4c, 0x11, 0x7c, 0xcd, 0xed, 0xa6, 0xe5, 0x7f,
-	0x0e, 0xf3, 0x6d, 0xd6, 0x1d, 0x38, 0x1e, 0x57, 0x35, 0x5d, 0x16, 0xbe, 0xc5, 0xcd, 0xf7, 0x0d,
-	0x53, 0xf5, 0x0a, 0x4c, 0x63, 0xb3, 0xe9, 0x38, 0x95, 0xf1, 0xf9, 0x8f, 0x0c, 0x14, 0x6a, 0x5d,
-	0xe1, 0x1c, 0x32, 0xf1, 0x66, 0x7b, 0xfd, 0x58, 0xd6, 0xad, 0xa1, 0x1f, 0x1c, 0x4d, 0xe7, 0xb6,
-	0x99, 0x8c, 0xb7, 0x20, 0xd7, 0x1a, 0xb2, 0xbe, 0x76, 0x7a, 0xda, 0x8f, 0xd2, 0x14, 0xb8, 0x04,
-	0xf3, 0xad, 0x30, 0x2d, 0xce, 0x44, 0x1d, 0x25, 0xe3, 0x21, 0x69, 0xe9, 0x0e, 0x0b, 0xb8, 0x27,
-	0xd4, 0xd1, 0x34, 0xb5, 0xa5, 0x9a, 0x03, 0xdb, 0x00, 0xad, 0xa4, 0xae, 0x9a, 0x93, 0x6b, 0x2c,
-	0x52, 0xfe, 0x29, 0x03, 0xb9, 0xda, 0x90, 0x7b, 0xbd, 0xff, 0x17, 0xfa, 0xdf, 0x5e, 0x68, 0xd3,
-	0x87, 0xee, 0x0a, 0xb9, 0x32, 0xb7, 0xa6, 0xee, 0x43, 0xd5, 0xfc, 0xf2, 0xb7, 0x33, 0x00, 0x0d,
-	0xce, 0xfe, 0x0b, 0x59, 0x7b, 0xc1, 0x97, 0xe5, 0x6b, 0xfa, 0x72, 0x9e, 0x81, 0xd9, 0x4f, 0x9d,
-	0x40, 0x44, 0xcc, 0x9d, 0x60, 0xca, 0xfd, 0xe4, 0x06, 0x42, 0x78, 0x09, 0x55, 0xe6, 0x37, 0x6e,
-	0xc6, 0x3d, 0xa1, 0x09, 0x37, 0x2d, 0x9a, 0xdc, 0x51, 0xd6, 0xcc, 0x55, 0x83, 0xec, 0x2b, 0xe8,
-	0x62, 0x0c, 0x55, 0xc1, 0xa6, 0x45, 0xcd, 0x45, 0x64, 0x55, 0xf5, 0xf3, 0xa4, 0xaf, 0x40, 0xf3,
-	0x31, 0x68, 0x8b, 0x8b, 0xa6, 0x45, 0x55, 0xa7, 0x7f, 0x3f, 0xf5, 0x6e, 0x70, 0xf1, 0x4f, 0x4d,
-	0x58, 0xfe, 0x69, 0x7a, 0x5e, 0x9a, 0x9e, 0x86, 0x38, 0x0a, 0x7b, 0x23, 0xc5, 0xca, 0x68, 0xd3,
-	0xa2, 0x71, 0xcf, 0x53, 0xd6, 0xdd, 0x01, 0x79, 0xa6, 0x70, 0x0b, 0x31, 0x4e, 0xc6, 0x9a, 0x16,
-	0xd5, 0x9d, 0x43, 0xd9, 0x1c, 0x44, 0x5f, 0x5e, 0xc4, 0xc8, 0x98, 0xc4, 0xa8, 0x93, 0xa6, 0x9a,
-	0x1e, 0x04, 0xc4, 0x55, 0xb8, 0x62, 0x8c, 0x8b, 0xe3, 0x4d, 0x8b, 0xa6, 0x87, 0xc5, 0x9a, 0x29,
-	0x26, 0x64, 0x78, 0xd1, 0x16, 0x15, 0x94, 0xb6, 0xe8, 0x52, 0xf3, 0x68, 0x7c, 0xaf, 0x12, 0x4f,
-	0x61, 0x93, 0x0e, 0x3c, 0x7d, 0xd3, 0xb4, 0xe8, 0xf8, 0x9e, 0xbe, 0x0b, 0x73, 0xbb, 0x4e, 0xdf,
-	0x63, 0x22, 0x0a, 0x38, 0x39, 0x46, 0xba, 0xdd, 0x4c, 0x22, 0x9b, 0xb3, 0x90, 0x8b, 0x3c, 0xc7,
-	0xf7, 0xca, 0x3f, 0x20, 0x28, 0xb4, 0x99, 0xe0, 0x81, 0x33, 0x71, 0xcd, 0xef, 0x25, 0x9b, 0x43,
-	0x65, 0xc2, 0x98, 0xfd, 0x26, 0x4c, 0x93, 0xcd, 0xf3, 0x04, 0x72, 0x5b, 0x5c, 0xb4, 0x1a, 0x66,
-	0x8f, 0x3f, 0x34, 0x3b, 0xb2, 0x72, 0x85, 0x1d, 0xa9, 0xe6, 0x51, 0x3d, 0x7d, 0x92, 0x8a, 0x0f,
-	0x8e, 0x4f, 0x6d, 0xeb, 0xe4, 0xd4, 0xb6, 0x5e, 0x9c, 0xda, 0xd6, 0xf9, 0xa9, 0x8d, 0xbe, 0x1e,
-	0xd9, 0xe8, 0xf9, 0xc8, 0x46, 0xc7, 0x23, 0x1b, 0x9d, 0x8c, 0x6c, 0xf4, 0xeb, 0xc8, 0x46, 0xbf,
-	0x8f, 0x6c, 0xeb, 0x7c, 0x64, 0xa3, 0x6f, 0xce, 0x6c, 0xeb, 0xe4, 0xcc, 0xb6, 0x5e, 0x9c, 0xd9,
-	0xd6, 0x5e, 0x5e, 0x5d, 0xa4, 0xdf, 0xfb, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x75, 0x52, 0x0c, 0x3f,
-	0x9e, 0x0f, 0x00, 0x00,
+	// 1062 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xfa, 0xdb, 0x2f, 0x49, 0xb3, 0x8c, 0x4a, 0x3a, 0x4d, 0x5b, 0xbb, 0xb2, 0x14, 0x29,
+	0x55, 0xa9, 0x53, 0x85, 0x0a, 0x21, 0x6e, 0x8e, 0x9d, 0x12, 0x07, 0x1c, 0xa2, 0x89, 0x45, 0x39,
+	0x21, 0xad, 0xed, 0x89, 0xbd, 0x65, 0xbd, 0x1b, 0xf6, 0x23, 0x52, 0x6e, 0xfc, 0x09, 0x5c, 0x38,
+	0x71, 0xe1, 0xd8, 0xbf, 0x81, 0x13, 0x17, 0xa4, 0x1c, 0x7b, 0xac, 0x38, 0x54, 0xb4, 0x08, 0x09,
+	0x6e, 0x15, 0x12, 0x77, 0xde, 0x7c, 0xec, 0xae, 0x13, 0x55, 0x75, 0xea, 0x20, 0xa4, 0x56, 0x1c,
+	0x46, 0xbb, 0xf3, 0xe6, 0xf7, 0x7e, 0xbb, 0xef, 0xcd, 0x7b, 0x6f, 0xde, 0xc0, 0x35, 0xdb, 0x0d,
+	0x3c, 0xc7, 0xf2, 0xd7, 0x7d, 0xde, 0xf7, 0xfc, 0x81, 0x7e, 0xd4, 0x0f, 0x7d, 0x2f, 0xf4, 0x48,
+	0x41, 0xcd, 0x56, 0xee, 0x0c, 0xed, 0x70, 0x14, 0xf5, 0xea, 0x7d, 0x6f, 0xbc, 0x3e, 0xf4, 0x86,
+	0xde, 0xba, 0x5c, 0xee, 0x45, 0x07, 0x72, 0x26, 0x27, 0xf2, 0x4d, 0xa9, 0xd5, 0x1a, 0x50, 0xfc,
+	0x98, 0xbb, 0x3c, 0xb0, 0x03, 0x72, 0x1d, 0xca, 0x87, 0x9e, 0x73, 0x3c, 0xf6, 0xfc, 0xc3, 0x11,
+	0x35, 0x6f, 0x1a, 0x6b, 0x79, 0x96, 0x0a, 0x08, 0x81, 0xdc, 0xb6, 0x15, 0x8c, 0xe8, 0x65, 0x5c,
+	0x58, 0x60, 0xf2, 0xfd, 0xa3, 0xdc, 0xa3, 0x1f, 0xaa, 0x46, 0xed, 0x27, 0x03, 0xf2, 0xcd, 0x91,
+	0xed, 0x0c, 0xa6, 0x30, 0x7c, 0x02, 0xe5, 0x3d, 0x9f, 0x1f, 0x49, 0xa8, 0xa2, 0xd9, 0xbc, 0x73,
+	0xf2, 0xb4, 0x3a, 0xf7, 0xcb, 0xd3, 0xea, 0xea, 0xc4, 0x4f, 0xc7, 0x46, 0x9e, 0x79, 0xd6, 0xdb,
+	0x2d, 0x96, 0xea, 0x93, 0xfb, 0x90, 0x65, 0xfc, 0x80, 0xbe, 0x2b, 0x69, 0xee, 0x69, 0x9a, 0xf7,
+	0xce, 0x41, 0x83, 0x5a, 0xdc, 0xe7, 0x6e, 0x9f, 0x33, 0x41, 0xa0, 0x4d, 0xb8, 0x05, 0xd9, 0x1d,
+	0x1e, 0xbe, 0xfa, 0xff, 0x35, 0xf4, 0xfb, 0x02, 0x14, 0x19, 0xff, 0x3a, 0xe2, 0xc1, 0x14, 0x3c,
+	0xa9, 0x43, 0xa9, 0x69, 0x39, 0x4e, 0xf7, 0xf8, 0x90, 0x4b, 0x73, 0x2f, 0x6d, 0x90, 0xba, 0xde,
+	0x32, 0x4d, 0x50, 0x6f, 0x76, 0x59, 0x82, 0x21, 0x9f, 0x42, 0x41, 0xbc, 0x73, 0xff, 0x42, 0x56,
+	0x69, 0x0e, 0xf2, 0x25, 0x2c, 0xa9, 0xb7, 0x3d, 0xb1, 0xcf, 0xa1, 0xf8, 0x89, 0xe5, 0x0b, 0xd0,
+	0x9e, 0x25, 0x23, 0x97, 0x21, 0xbf, 0xeb, 0xe1, 0x0a, 0xbd, 0x82, 0xac, 0x39, 0xa6, 0x26, 0x64,
+	0x05, 0x4a, 0xfb, 0xc2, 0x36, 0xb1, 0x40, 0xe5, 0x42, 0x32, 0x27, 0x1b, 0x00, 0x8c, 0x87, 0x91,
+	0xef, 0x76, 0xbc, 0x01, 0xa7, 0x57, 0x5f, 0xee, 0x11, 0xd6, 0x61, 0x13, 0x28, 0xe1, 0xe1, 0xf6,
+	0x78, 0x1c, 0x85, 0x56, 0xcf, 0xe1, 0x74, 0x05, 0x55, 0x4a, 0x2c, 0x15, 0x90, 0x16, 0xe4, 0x36,
+	0xad, 0x80, 0xd3, 0x6b, 0xd2, 0xb0, 0xbb, 0xaf, 0x6d, 0x94, 0xd4, 0x26, 0xdb, 0x50, 0xf8, 0xac,
+	0xf7, 0x90, 0xf7, 0x43, 0x7a, 0x7d, 0x46, 0x1e, 0xad, 0x4f, 0x76, 0x45, 0x84, 0xc7, 0xde, 0xbe,
+	0x31, 0x23, 0x59, 0x4a, 0x41, 0x96, 0xa1, 0xd0, 0xe1, 0xe1, 0xc8, 0x1b, 0xd0, 0x0a, 0x92, 0x95,
+	0x99, 0x9e, 0x09, 0xaf, 0x34, 0xfc, 0x61, 0x34, 0xe6, 0x6e, 0x18, 0xd0, 0xaa, 0x4c, 0xc8, 0x54,
+	0x50, 0xdb, 0x81, 0x4c, 0xb3, 0x4b, 0x16, 0x30, 0xfa, 0xba, 0x0a, 0x6f, 0xce, 0x91, 0x77, 0x60,
+	0xb1, 0xd9, 0xdd, 0xb7, 0x8e, 0x78, 0x23, 0x90, 0xf9, 0x63, 0x1a, 0xb8, 0x81, 0x66, 0x2c, 0x6a,
+	0x71, 0x87, 0x0f, 0xad, 0x90, 0x9b, 0x19, 0xb2, 0x08, 0xe5, 0x66, 0x57, 0x57, 0x04, 0x33, 0x5b,
+	0x5b, 0x83, 0x0c, 0xeb, 0x10, 0x13, 0x16, 0xd4, 0x9e, 0x30, 0x1e, 0x44, 0x4e, 0x88, 0x7c, 0x89,
+	0x64, 0xd7, 0x7b, 0x60, 0xd9, 0xa1, 0x69, 0xe8, 0xec, 0xf8, 0xdb, 0x80, 0x82, 0x02, 0x4d, 0x49,
+	0x8e, 0xad, 0xc4, 0xe9, 0x33, 0x55, 0x82, 0xd4, 0xe3, 0x71, 0x32, 0x5e, 0x28, 0x69, 0x92, 0x8c,
+	0xa6, 0x50, 0xdc, 0xb3, 0x8e, 0x1d, 0xcf, 0x1a, 0xa8, 0x6c, 0x61, 0xf1, 0x54, 0xec, 0xc5, 0xd6,
+	0x91, 0x74, 0xf8, 0x15, 0xb9, 0xa0, 0x67, 0xda, 0xee, 0xbf, 0x0c, 0xc8, 0xc9, 0x24, 0x7e, 0xb5,
+	0xd5, 0x98, 0xe2, 0x2d, 0x6f, 0x6c, 0xd9, 0xae, 0xb6, 0x7a, 0xc6, 0x14, 0x57, 0x1c, 0xff, 0xba,
+	0xf1, 0x6b, 0xb0, 0x24, 0x6c, 0x68, 0xf1, 0x3e, 0x02, 0xac, 0xd0, 0xf6, 0x5c, 0xed, 0x84, 0xb3,
+	0x62, 0x6d, 0xf4, 0xef, 0x19, 0xc8, 0x35, 0x75, 0x96, 0xbe, 0xb1, 0x46, 0x37, 0x94, 0x0d, 0xba,
+	0x38, 0xbe, 0x66, 0x18, 0x2a, 0xf3, 0xbf, 0x80, 0xf9, 0x8e, 0xd5, 0x1f, 0xd9, 0x2e, 0x97, 0xb5,
+	0x5e, 0xc4, 0xc7, 0xe2, 0xe6, 0x07, 0x9a, 0xa9, 0x7e, 0x0e, 0xa6, 0x09, 0x6d, 0x36, 0x49, 0xa5,
+	0xfd, 0xfc, 0x67, 0x16, 0x4a, 0x8d, 0x7e, 0x68, 0x1f, 0x61, 0x8a, 0xbe, 0xd1, 0xbe, 0xde, 0x12,
+	0xf5, 0x0c, 0x7f, 0xf4, 0x78, 0x36, 0x6f, 0x6b, 0x65, 0xb2, 0x03, 0xf9, 0xf6, 0xd8, 0x1a, 0x2a,
+	0x4f, 0xcf, 0xfa, 0x53, 0x8a, 0x82, 0xdc, 0x84, 0xf9, 0x76, 0x90, 0x16, 0x6d, 0x2a, 0x8f, 0x98,
+	0x49, 0x91, 0x70, 0xe9, 0x9e, 0x85, 0x3a, 0xa1, 0x3c, 0xb2, 0x66, 0x76, 0xa9, 0xe2, 0x20, 0x15,
+	0x80, 0x76, 0x52, 0x6f, 0xf5, 0x89, 0x36, 0x21, 0xa9, 0xfd, 0x9c, 0x85, 0x7c, 0x03, 0xeb, 0xf8,
+	0xe0, 0xff, 0x8d, 0xfe, 0xaf, 0x37, 0x5a, 0xf7, 0xa7, 0xfb, 0xa1, 0xd8, 0x99, 0xab, 0x33, 0xf7,
+	0xa7, 0x52, 0xbf, 0xf6, 0x5d, 0x06, 0xa0, 0xc5, 0xad, 0xb7, 0x21, 0x6b, 0x4f, 0xf9, 0x65, 0xf9,
+	0x82, 0x7e, 0x79, 0x91, 0x85, 0xe2, 0xe7, 0xb6, 0x1f, 0x46, 0x96, 0x33, 0xc5, 0x29, 0xb7, 0x93,
+	0x9b, 0x09, 0xe5, 0xb8, 0x36, 0xbf, 0xb1, 0x14, 0xf7, 0x8a, 0x5a, 0xbc, 0x3d, 0xc7, 0x92, 0xbb,
+	0xcb, 0xaa, 0xbe, 0x82, 0xd0, 0x03, 0x09, 0x5d, 0x8c, 0xa1, 0x52, 0x88, 0x40, 0x7d, 0x41, 0xa9,
+	0xca, 0x3e, 0x9f, 0x0e, 0x25, 0x68, 0x3e, 0x06, 0xa1, 0x08, 0x21, 0xf2, 0x06, 0x70, 0x3b, 0xf5,
+	0xdd, 0xe8, 0xf4, 0x47, 0xb5, 0x58, 0x7c, 0x34, 0x3d, 0x2f, 0x75, 0xaf, 0x43, 0x6d, 0x89, 0xbd,
+	0x94, 0x62, 0x85, 0x14, 0xa1, 0x71, 0x2f, 0x54, 0x53, 0xdd, 0x01, 0x7d, 0x28, 0x71, 0x0b, 0x31,
+	0x4e, 0xc8, 0x10, 0xa5, 0x3a, 0x87, 0x9a, 0x3e, 0x88, 0xbe, 0x3a, 0x8d, 0x11, 0x32, 0x81, 0x91,
+	0x27, 0x4d, 0x3d, 0x3d, 0x08, 0xa8, 0x23, 0x71, 0x66, 0x8c, 0x8b, 0xe5, 0x88, 0x4d, 0x0f, 0x8b,
+	0x55, 0x5d, 0x4c, 0xe8, 0xf8, 0xb4, 0x5b, 0xa4, 0x50, 0xb8, 0x45, 0x95, 0x9a, 0x7b, 0x93, 0xb1,
+	0x4a, 0x5d, 0x89, 0x4d, 0x3a, 0xf3, 0x74, 0x05, 0x15, 0x26, 0x63, 0xfa, 0x06, 0x94, 0xf7, 0xed,
+	0xa1, 0x6b, 0x61, 0x1b, 0xc8, 0xe9, 0x89, 0xa1, 0xda, 0xd0, 0x44, 0xb2, 0x59, 0x84, 0x7c, 0xe4,
+	0x62, 0xb3, 0x50, 0xfb, 0xd1, 0x80, 0x52, 0x07, 0x15, 0x7c, 0x7b, 0xea, 0x9e, 0xdf, 0x4a, 0x82,
+	0x43, 0x66, 0xc2, 0x84, 0xfb, 0xb5, 0x98, 0x25, 0xc1, 0x73, 0x1f, 0xf2, 0xb8, 0x61, 0xed, 0x96,
+	0x8e, 0xf1, 0xbb, 0x3a, 0x22, 0xd7, 0xce, 0x11, 0x91, 0x52, 0x8f, 0x29, 0xf5, 0x69, 0x56, 0x7c,
+	0x78, 0xf2, 0xac, 0x32, 0xf7, 0x18, 0xc7, 0x13, 0x1c, 0x2f, 0x9e, 0x55, 0x8c, 0x6f, 0x9e, 0x57,
+	0x8c, 0x47, 0x38, 0x4e, 0x70, 0x3c, 0xc6, 0xf1, 0x2b, 0x8e, 0x3f, 0x9e, 0xe3, 0x1a, 0x3e, 0xbf,
+	0xfd, 0x0d, 0xb1, 0x38, 0x9e, 0xe0, 0xe8, 0x15, 0xe4, 0x05, 0xfb, 0xfd, 0x7f, 0x00, 0xfd, 0x72,
+	0x7b, 0x43, 0xb6, 0x0f, 0x00, 0x00,
 }
 
 func (x Request_CT) String() string {
@@ -1208,6 +1208,9 @@ func (this *Result) Equal(that interface{}) bool {
 	if !bytes.Equal(this.Payload, that1.Payload) {
 		return false
 	}
+	if !bytes.Equal(this.Events, that1.Events) {
+		return false
+	}
 	return true
 }
 func (this *Type) Equal(that interface{}) bool {
@@ -1897,6 +1900,7 @@ type ResultFace interface {
 	GetObject() github_com_insolar_insolar_insolar.ID
 	GetRequest() github_com_insolar_insolar_insolar.Reference
 	GetPayload() []byte
+	GetEvents() []byte
 }
 
 func (this *Result) Proto() github_com_gogo_protobuf_proto.Message {
@@ -1923,12 +1927,17 @@ func (this *Result) GetPayload() []byte {
 	return this.Payload
 }
 
+func (this *Result) GetEvents() []byte {
+	return this.Events
+}
+
 func NewResultFromFace(that ResultFace) *Result {
 	this := &Result{}
 	this.Polymorph = that.GetPolymorph()
 	this.Object = that.GetObject()
 	this.Request = that.GetRequest()
 	this.Payload = that.GetPayload()
+	this.Events = that.GetEvents()
 	return this
 }
 
@@ -2079,12 +2088,13 @@ func (this *Result) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&record.Result{")
 	s = append(s, "Polymorph: "+fmt.Sprintf("%#v", this.Polymorph)+",\n")
 	s = append(s, "Object: "+fmt.Sprintf("%#v", this.Object)+",\n")
 	s = append(s, "Request: "+fmt.Sprintf("%#v", this.Request)+",\n")
 	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "Events: "+fmt.Sprintf("%#v", this.Events)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2568,6 +2578,14 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRecord(dAtA, i, uint64(len(m.Payload)))
 		i += copy(dAtA[i:], m.Payload)
 	}
+	if len(m.Events) > 0 {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRecord(dAtA, i, uint64(len(m.Events)))
+		i += copy(dAtA[i:], m.Events)
+	}
 	return i, nil
 }
 
@@ -3303,6 +3321,10 @@ func (m *Result) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovRecord(uint64(l))
 	}
+	l = len(m.Events)
+	if l > 0 {
+		n += 2 + l + sovRecord(uint64(l))
+	}
 	return n
 }
 
@@ -3656,6 +3678,7 @@ func (this *Result) String() string {
 		`Object:` + fmt.Sprintf("%v", this.Object) + `,`,
 		`Request:` + fmt.Sprintf("%v", this.Request) + `,`,
 		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4735,6 +4758,40 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRecord
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events[:0], dAtA[iNdEx:postIndex]...)
+			if m.Events == nil {
+				m.Events = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRecord(dAtA[iNdEx:])
@@ -78,6 +78,10 @@ const (
 	TypeHeavyError
 
 	TypeNodeSign
+	// TypeResult contains result of a request.
+	TypeResult
+	// TypeEvents contains contract events of an object.
+	TypeEvents
 )
 
 // ErrType is used to determine and compare reply errors.
@@ -94,6 +98,8 @@ const (
 	ErrNoPendingRequests
 	// ErrTooManyPendingRequests is returned when a limit of pending requests has been reached
 	ErrTooManyPendingRequests
+	// ErrNoResult is returned when there is no result for a request on current LME
+	ErrNoResult
 )
 
 func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
@@ -137,6 +143,10 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 
 	case TypeNodeSign:
 		return &NodeSign{}, nil
+	case TypeResult:
+		return &Result{}, nil
+	case TypeEvents:
+		return &Events{}, nil
 
 	default:
 		return nil, errors.Errorf("unimplemented reply type: '%d'", t)
@@ -201,4 +211,6 @@ func init() {
 	gob.Register(&NodeSign{})
 	gob.Register(&HasPendingRequests{})
 	gob.Register(&Request{})
+	gob.Register(&Result{})
+	gob.Register(&Events{})
 }
@@ -59,6 +59,8 @@ func (e *Error) Error() error {
 		return insolar.ErrNoPendingRequest
 	case ErrTooManyPendingRequests:
 		return insolar.ErrTooManyPendingRequests
+	case ErrNoResult:
+		return insolar.ErrNoResult
 	}
 
 	return insolar.ErrUnknown
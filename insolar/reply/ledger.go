@@ -40,6 +40,8 @@ type Object struct {
 	ChildPointer *insolar.ID
 	Memory       []byte
 	Parent       insolar.Reference
+	PrevState    *insolar.ID
+	Request      *insolar.Reference
 }
 
 // Type implementation of Reply interface.
@@ -130,3 +132,34 @@ type Request struct {
 func (r *Request) Type() insolar.ReplyType {
 	return TypeRequest
 }
+
+// Result contains serialized result record of a request.
+type Result struct {
+	ID     insolar.ID
+	Record []byte
+}
+
+// Type implementation of Reply interface.
+func (r *Result) Type() insolar.ReplyType {
+	return TypeResult
+}
+
+// Event is a contract event from the event index.
+type Event struct {
+	Request  insolar.Reference
+	Result   insolar.ID
+	Position uint32
+	Data     []byte
+}
+
+// Events contains contract events of an object with the same name.
+type Events struct {
+	Events []Event
+	// Next is a pulse to continue from, it's zero when there are no more events.
+	Next insolar.PulseNumber
+}
+
+// Type implementation of Reply interface.
+func (r *Events) Type() insolar.ReplyType {
+	return TypeEvents
+}
@@ -67,9 +67,38 @@ type LogicRunner interface {
 	OnPulse(context.Context, Pulse) error
 }
 
+//go:generate minimock -i github.com/insolar/insolar/insolar.QueryExecutor -o ../testutils -s _mock.go
+
+// QueryExecutor executes read-only queries to contracts
+type QueryExecutor interface {
+	// Query calls method of the object against its latest state without registering request on ledger.
+	Query(ctx context.Context, object Reference, method string, args Arguments) (*QueryResult, error)
+}
+
+// QueryResult is a result of read-only query to a contract
+type QueryResult struct {
+	Result Arguments
+	State  ID          // state of the object, that the query has read
+	Pulse  PulseNumber // pulse, the query was executed in
+}
+
+// QueryMode is a mode of execution of read-only queries. Only methods marked immutable (INSATTR_<Method>_Immutable
+// attribute generated for "//ins:immutable" methods) are executed in it.
+const QueryMode = "query"
+
+// AnonymousCaller is a caller of queries made through API. It's not a reference of any object, so checks of the
+// caller (e.g. that the caller is the parent of the object) never pass for such queries.
+var AnonymousCaller = func() Reference {
+	var ref Reference
+	for i := range ref {
+		ref[i] = 0xff
+	}
+	return ref
+}()
+
 // LogicCallContext is a context of contract execution
 type LogicCallContext struct {
-	Mode            string     // either "execution" or "validation"
+	Mode            string     // "execution", "validation" or "query"
 	Callee          *Reference // Contract that was called
 	Request         *Reference // ref of request
 	Prototype       *Reference // Image of the callee
@@ -82,3 +111,13 @@ type LogicCallContext struct {
 	Immutable       bool
 	TraceID         string
 }
+
+// MaxContractEventNameLength is a maximum length of a contract event name in bytes.
+const MaxContractEventNameLength = 255
+
+// ContractEvent is a named event, that contract emits during execution of a request. Events are saved together with
+// the result of the request.
+type ContractEvent struct {
+	Name string
+	Data []byte // serialized payload of the event
+}
@@ -0,0 +1,68 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	// BackendBadger is the name of badger-based DB backend.
+	BackendBadger = "badger"
+	// BackendBolt is the name of bbolt-based DB backend.
+	BackendBolt = "bolt"
+)
+
+// NewDB creates a DB with provided backend in provided working dir. Empty backend name means badger.
+func NewDB(backend string, dir string) (DB, error) {
+	var (
+		db  DB
+		err error
+	)
+	switch backend {
+	case "", BackendBadger:
+		db, err = NewBadgerDB(dir)
+	case BackendBolt:
+		db, err = NewBoltDB(dir)
+	default:
+		return nil, errors.Errorf("unknown storage backend %q", backend)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s storage", backend)
+	}
+	return db, nil
+}
+
+// NewReadOnlyDB opens existing DB with provided backend in provided working dir in read-only mode.
+func NewReadOnlyDB(backend string, dir string) (DB, error) {
+	var (
+		db  DB
+		err error
+	)
+	switch backend {
+	case "", BackendBadger:
+		db, err = NewBadgerDBReadOnly(dir)
+	case BackendBolt:
+		db, err = NewBoltDBReadOnly(dir)
+	default:
+		return nil, errors.Errorf("unknown storage backend %q", backend)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open %s storage", backend)
+	}
+	return db, nil
+}
@@ -17,6 +17,7 @@
 package store
 
 import (
+	"bytes"
 	"context"
 	"path/filepath"
 
@@ -32,6 +33,15 @@ type BadgerDB struct {
 // NewBadgerDB creates new BadgerDB instance.
 // Creates new badger.DB instance with provided working dir and use it as backend for BadgerDB.
 func NewBadgerDB(dir string) (*BadgerDB, error) {
+	return openBadgerDB(dir, false)
+}
+
+// NewBadgerDBReadOnly opens existing badger.DB in provided working dir in read-only mode. All writes will fail.
+func NewBadgerDBReadOnly(dir string) (*BadgerDB, error) {
+	return openBadgerDB(dir, true)
+}
+
+func openBadgerDB(dir string, readOnly bool) (*BadgerDB, error) {
 	dir, err := filepath.Abs(dir)
 	if err != nil {
 		return nil, err
@@ -40,6 +50,7 @@ func NewBadgerDB(dir string) (*BadgerDB, error) {
 	ops := badger.DefaultOptions
 	ops.ValueDir = dir
 	ops.Dir = dir
+	ops.ReadOnly = readOnly
 	bdb, err := badger.Open(ops)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to open badger")
@@ -94,7 +105,116 @@ func (b *BadgerDB) Set(key Key, value []byte) error {
 	return nil
 }
 
+// Delete removes value for a key.
+func (b *BadgerDB) Delete(key Key) error {
+	return b.backend.Update(func(txn *badger.Txn) error {
+		return txn.Delete(fullKey(key))
+	})
+}
+
+// Write atomically applies all operations of the batch in a single transaction.
+func (b *BadgerDB) Write(batch *Batch) error {
+	return b.backend.Update(func(txn *badger.Txn) error {
+		for _, op := range batch.ops {
+			var err error
+			if op.delete {
+				err = txn.Delete(op.key)
+			} else {
+				err = txn.Set(op.key, op.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// NewIterator creates an iterator over keys of the scope with provided prefix. Iterator holds a read-only transaction
+// and sees a snapshot of the db, so it should be closed as soon as possible.
+func (b *BadgerDB) NewIterator(scope Scope, prefix []byte) Iterator {
+	return newBadgerIterator(b.backend.NewTransaction(false), true, scope, prefix)
+}
+
+// NewSnapshot takes a snapshot of the db. Snapshot holds a single read-only transaction, all its iterators see the same
+// state of the db.
+func (b *BadgerDB) NewSnapshot() Snapshot {
+	return &badgerSnapshot{txn: b.backend.NewTransaction(false)}
+}
+
 // Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
 func (b *BadgerDB) Stop(ctx context.Context) error {
 	return b.backend.Close()
 }
+
+type badgerSnapshot struct {
+	txn *badger.Txn
+}
+
+// NewIterator creates an iterator over keys of the scope with provided prefix in the snapshot's transaction.
+func (s *badgerSnapshot) NewIterator(scope Scope, prefix []byte) Iterator {
+	return newBadgerIterator(s.txn, false, scope, prefix)
+}
+
+// Close discards the snapshot's transaction.
+func (s *badgerSnapshot) Close() {
+	s.txn.Discard()
+}
+
+type badgerIterator struct {
+	txn     *badger.Txn
+	ownTxn  bool
+	it      *badger.Iterator
+	scope   []byte
+	prefix  []byte
+	started bool
+}
+
+func newBadgerIterator(txn *badger.Txn, ownTxn bool, scope Scope, prefix []byte) *badgerIterator {
+	bi := &badgerIterator{
+		txn:    txn,
+		ownTxn: ownTxn,
+		it:     txn.NewIterator(badger.DefaultIteratorOptions),
+		scope:  scope.Bytes(),
+		prefix: append(scope.Bytes(), prefix...),
+	}
+	bi.it.Seek(bi.prefix)
+	return bi
+}
+
+// Next moves iterator to the next key.
+func (i *badgerIterator) Next() bool {
+	if i.started {
+		i.it.Next()
+	}
+	i.started = true
+	return i.it.ValidForPrefix(i.prefix)
+}
+
+// Seek moves iterator to the first key, that is greater or equal to provided id.
+func (i *badgerIterator) Seek(id []byte) {
+	target := append(i.scope, id...)
+	if bytes.Compare(target, i.prefix) < 0 {
+		target = i.prefix
+	}
+	i.it.Seek(target)
+	i.started = false
+}
+
+// Key returns a copy of the current key without a scope.
+func (i *badgerIterator) Key() []byte {
+	return append([]byte{}, i.it.Item().Key()[len(i.scope):]...)
+}
+
+// Value returns a copy of the current value.
+func (i *badgerIterator) Value() ([]byte, error) {
+	return i.it.Item().ValueCopy(nil)
+}
+
+// Close releases the iterator and its transaction, if the transaction isn't shared with a snapshot.
+func (i *badgerIterator) Close() {
+	i.it.Close()
+	if i.ownTxn {
+		i.txn.Discard()
+	}
+}
@@ -97,3 +97,33 @@ func TestBadgerDB_Set(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedValue, value)
 }
+
+func TestBadgerDB_Delete(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	assert.NoError(t, err)
+
+	db, err := NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+
+	var (
+		key   testBadgerKey
+		value []byte
+	)
+	f := fuzz.New().NilChance(0)
+	f.Fuzz(&key)
+	f.Fuzz(&value)
+	err = db.Set(key, value)
+	require.NoError(t, err)
+
+	err = db.Delete(key)
+	assert.NoError(t, err)
+
+	err = db.backend.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(append(key.Scope().Bytes(), key.ID()...))
+		return err
+	})
+	assert.Equal(t, badger.ErrKeyNotFound, err)
+}
@@ -0,0 +1,283 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/stats"
+)
+
+const (
+	boltFileName = "data.bolt"
+	// boltMmapSize is an initial size of the memory map. Writes wait for open read transactions (iterators, snapshots)
+	// to remap the file, when it grows over the map, so the map is reserved ahead. It takes address space only.
+	boltMmapSize = 1 << 30
+)
+
+// boltBucket is the only bucket of the DB. Keys are stored with their scopes, like in other DB implementations.
+var boltBucket = []byte("data")
+
+// BoltDB is a bbolt DB implementation.
+//
+// bbolt is an embedded B+tree storage in a single memory-mapped file. Every write is a transaction, that is synced to
+// disk on commit, so the file is always consistent after a crash.
+type BoltDB struct {
+	backend *bolt.DB
+}
+
+// NewBoltDB creates new BoltDB instance. Opens or creates bbolt file in provided working dir.
+func NewBoltDB(dir string) (*BoltDB, error) {
+	return openBoltDB(dir, false)
+}
+
+// NewBoltDBReadOnly opens existing bbolt file in provided working dir in read-only mode. All writes will return
+// ErrReadOnly.
+func NewBoltDBReadOnly(dir string) (*BoltDB, error) {
+	return openBoltDB(dir, true)
+}
+
+func openBoltDB(dir string, readOnly bool) (*BoltDB, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !readOnly {
+		err = os.MkdirAll(dir, 0700)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create data directory")
+		}
+	}
+
+	bdb, err := bolt.Open(filepath.Join(dir, boltFileName), 0600, &bolt.Options{
+		Timeout:         time.Second,
+		ReadOnly:        readOnly,
+		InitialMmapSize: boltMmapSize,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open bbolt")
+	}
+
+	if !readOnly {
+		err = bdb.Update(func(tx *bolt.Tx) error {
+			_, err := tx.CreateBucketIfNotExists(boltBucket)
+			return err
+		})
+		if err != nil {
+			_ = bdb.Close()
+			return nil, errors.Wrap(err, "failed to create bbolt bucket")
+		}
+	}
+
+	return &BoltDB{backend: bdb}, nil
+}
+
+// Get returns value for specified key or an error. A copy of a value will be returned.
+func (b *BoltDB) Get(key Key) (value []byte, err error) {
+	err = b.backend.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		if bucket == nil {
+			return ErrNotFound
+		}
+		val := bucket.Get(fullKey(key))
+		if val == nil {
+			return ErrNotFound
+		}
+		value = append([]byte{}, val...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// Set stores value for a key.
+func (b *BoltDB) Set(key Key, value []byte) error {
+	batch := NewBatch()
+	batch.Set(key, value)
+	return b.Write(batch)
+}
+
+// Delete removes value for a key.
+func (b *BoltDB) Delete(key Key) error {
+	batch := NewBatch()
+	batch.Delete(key)
+	return b.Write(batch)
+}
+
+// Write atomically applies all operations of the batch in a single transaction.
+func (b *BoltDB) Write(batch *Batch) error {
+	if b.backend.IsReadOnly() {
+		return ErrReadOnly
+	}
+
+	var (
+		userBytes int64
+		txStats   bolt.TxStats
+	)
+	err := b.backend.Update(func(tx *bolt.Tx) error {
+		tx.OnCommit(func() {
+			txStats = tx.Stats()
+		})
+
+		bucket := tx.Bucket(boltBucket)
+		for _, op := range batch.ops {
+			var err error
+			if op.delete {
+				err = bucket.Delete(op.key)
+			} else {
+				err = bucket.Put(op.key, op.value)
+			}
+			if err != nil {
+				return err
+			}
+			userBytes += int64(len(op.key) + len(op.value))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Dirty pages are allocated anew on every commit, the meta page is written in place.
+	stats.Record(context.Background(),
+		statStoreUserBytes.M(userBytes),
+		statStoreWrittenBytes.M(int64(txStats.PageAlloc+b.backend.Info().PageSize)),
+	)
+	return nil
+}
+
+// NewIterator creates an iterator over keys of the scope with provided prefix. Iterator holds a read-only transaction
+// and sees a snapshot of the db. Writes wait for open read transactions, if the file grows over its memory map, so the
+// iterator should be closed as soon as possible.
+func (b *BoltDB) NewIterator(scope Scope, prefix []byte) Iterator {
+	tx, err := b.backend.Begin(false)
+	if err != nil {
+		return &boltIterator{err: err}
+	}
+	return newBoltIterator(tx, true, scope, prefix)
+}
+
+// NewSnapshot takes a snapshot of the db. Snapshot holds a single read-only transaction, all its iterators see the same
+// state of the db.
+func (b *BoltDB) NewSnapshot() Snapshot {
+	tx, err := b.backend.Begin(false)
+	return &boltSnapshot{tx: tx, err: err}
+}
+
+// Stop closes the bbolt file. After calling this, it's safe to kill the process without losing data.
+func (b *BoltDB) Stop(ctx context.Context) error {
+	return b.backend.Close()
+}
+
+type boltSnapshot struct {
+	tx  *bolt.Tx
+	err error
+}
+
+// NewIterator creates an iterator over keys of the scope with provided prefix in the snapshot's transaction.
+func (s *boltSnapshot) NewIterator(scope Scope, prefix []byte) Iterator {
+	if s.err != nil {
+		return &boltIterator{err: s.err}
+	}
+	return newBoltIterator(s.tx, false, scope, prefix)
+}
+
+// Close rolls back the snapshot's read-only transaction.
+func (s *boltSnapshot) Close() {
+	if s.tx != nil {
+		_ = s.tx.Rollback()
+	}
+}
+
+type boltIterator struct {
+	tx     *bolt.Tx
+	ownTx  bool
+	cursor *bolt.Cursor
+	scope  []byte
+	prefix []byte
+
+	// seek is a key the next call of Next moves to, nil if Next moves to the next key of the cursor.
+	seek  []byte
+	key   []byte
+	value []byte
+	err   error
+}
+
+func newBoltIterator(tx *bolt.Tx, ownTx bool, scope Scope, prefix []byte) *boltIterator {
+	bi := &boltIterator{
+		tx:     tx,
+		ownTx:  ownTx,
+		scope:  scope.Bytes(),
+		prefix: append(scope.Bytes(), prefix...),
+	}
+	bi.seek = bi.prefix
+	if bucket := tx.Bucket(boltBucket); bucket != nil {
+		bi.cursor = bucket.Cursor()
+	}
+	return bi
+}
+
+// Next moves iterator to the next key.
+func (i *boltIterator) Next() bool {
+	if i.cursor == nil {
+		return false
+	}
+	if i.seek != nil {
+		i.key, i.value = i.cursor.Seek(i.seek)
+		i.seek = nil
+	} else {
+		i.key, i.value = i.cursor.Next()
+	}
+	return i.key != nil && bytes.HasPrefix(i.key, i.prefix)
+}
+
+// Seek moves iterator to the first key, that is greater or equal to provided id.
+func (i *boltIterator) Seek(id []byte) {
+	target := append(append([]byte{}, i.scope...), id...)
+	if bytes.Compare(target, i.prefix) < 0 {
+		target = i.prefix
+	}
+	i.seek = target
+}
+
+// Key returns a copy of the current key without a scope.
+func (i *boltIterator) Key() []byte {
+	return append([]byte{}, i.key[len(i.scope):]...)
+}
+
+// Value returns a copy of the current value.
+func (i *boltIterator) Value() ([]byte, error) {
+	if i.err != nil {
+		return nil, i.err
+	}
+	return append([]byte{}, i.value...), nil
+}
+
+// Close releases the iterator and its transaction, if the transaction isn't shared with a snapshot.
+func (i *boltIterator) Close() {
+	if i.ownTx && i.tx != nil {
+		_ = i.tx.Rollback()
+	}
+}
@@ -0,0 +1,58 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBoltDB_Reopen(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bolt-test-")
+	defer os.RemoveAll(tmpdir)
+	require.NoError(t, err)
+
+	db, err := NewBoltDB(tmpdir)
+	require.NoError(t, err)
+
+	kept := testKey{scope: 1, id: []byte{1}}
+	removed := testKey{scope: 1, id: []byte{2}}
+	require.NoError(t, db.Set(kept, []byte{1}))
+	require.NoError(t, db.Set(kept, []byte{2}))
+	require.NoError(t, db.Set(removed, []byte{3}))
+	require.NoError(t, db.Delete(removed))
+	require.NoError(t, db.Stop(context.Background()))
+
+	db, err = NewBoltDBReadOnly(tmpdir)
+	require.NoError(t, err)
+	defer db.Stop(context.Background())
+
+	val, err := db.Get(kept)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{2}, val)
+	_, err = db.Get(removed)
+	assert.Equal(t, ErrNotFound, err)
+
+	assert.Equal(t, ErrReadOnly, db.Set(removed, []byte{3}))
+}
@@ -22,6 +22,78 @@ package store
 type DB interface {
 	Get(key Key) (value []byte, err error)
 	Set(key Key, value []byte) error
+	// Delete removes a value for a key. Deleting a missing key is not an error.
+	Delete(key Key) error
+	// NewIterator creates an iterator over keys of the scope, that start with provided prefix. Keys are iterated
+	// in ascending order. Iterator should be closed after usage.
+	NewIterator(scope Scope, prefix []byte) Iterator
+	// Write atomically applies all operations of the batch.
+	Write(batch *Batch) error
+}
+
+// Snapshot is a read-only view of the DB at the moment it was taken. Writes, that were made after that, are not visible
+// through it. Snapshot should be closed after usage.
+type Snapshot interface {
+	// NewIterator creates an iterator over keys of the scope, that start with provided prefix.
+	NewIterator(scope Scope, prefix []byte) Iterator
+	// Close releases resources of the snapshot.
+	Close()
+}
+
+// Snapshotter is implemented by DBs, that can provide a consistent view of all their data.
+type Snapshotter interface {
+	// NewSnapshot takes a snapshot of the DB.
+	NewSnapshot() Snapshot
+}
+
+//go:generate minimock -i github.com/insolar/insolar/internal/ledger/store.Iterator -o ./ -s _mock.go
+
+// Iterator provides an interface for walking through the keys of a scope.
+type Iterator interface {
+	// Next moves iterator to the next key. It should be called before the first access to the key.
+	// Returns false if there are no more keys.
+	Next() bool
+	// Seek moves iterator to the first key, that is greater or equal to provided id. Next should be called after
+	// Seek before the access to the key.
+	Seek(id []byte)
+	// Key returns a copy of the current key without a scope.
+	Key() []byte
+	// Value returns a copy of the current value.
+	Value() ([]byte, error)
+	// Close releases resources of the iterator.
+	Close()
+}
+
+// Batch holds a set of write operations, that should be applied atomically. Operations are applied in the order they
+// were added.
+type Batch struct {
+	ops []batchOp
+}
+
+type batchOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// NewBatch creates new empty batch.
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// Set adds a set operation to the batch.
+func (b *Batch) Set(key Key, value []byte) {
+	b.ops = append(b.ops, batchOp{key: fullKey(key), value: append([]byte{}, value...)})
+}
+
+// Delete adds a delete operation to the batch.
+func (b *Batch) Delete(key Key) {
+	b.ops = append(b.ops, batchOp{key: fullKey(key), delete: true})
+}
+
+// Len returns count of operations in the batch.
+func (b *Batch) Len() int {
+	return len(b.ops)
 }
 
 // Key represents a key for the key-value store. Scope is required to separate different DB clients and should be
@@ -41,6 +113,10 @@ func (s Scope) Bytes() []byte {
 	return []byte{byte(s)}
 }
 
+func fullKey(key Key) []byte {
+	return append(key.Scope().Bytes(), key.ID()...)
+}
+
 const (
 	// ScopePulse is the scope for pulse storage.
 	ScopePulse Scope = 1
@@ -58,4 +134,16 @@ const (
 
 	// ScopeGenesis is the scope for a genesis records.
 	ScopeGenesis Scope = 8
+
+	// ScopeExportJournal is the scope for a journal of data stored on heavy per pulse.
+	ScopeExportJournal Scope = 9
+
+	// ScopeReplicationJournal is the scope for a journal of data replicated from light to heavy.
+	ScopeReplicationJournal Scope = 10
+
+	// ScopeResult is the scope for an index of results by requests.
+	ScopeResult Scope = 11
+
+	// ScopeEvent is the scope for an index of contract events by objects and event names.
+	ScopeEvent Scope = 12
 )
@@ -41,16 +41,34 @@ func (k testKey) ID() []byte {
 	return k.id
 }
 
-func TestDB_Components(t *testing.T) {
-	t.Parallel()
+// componentDBs creates all DB implementations, that should behave the same way.
+func componentDBs(t *testing.T) (map[string]DB, func()) {
+	badgerDir, err := ioutil.TempDir("", "bdb-test-")
+	require.NoError(t, err)
+	boltDir, err := ioutil.TempDir("", "bolt-test-")
+	require.NoError(t, err)
+	cleanup := func() {
+		os.RemoveAll(badgerDir)
+		os.RemoveAll(boltDir)
+	}
 
-	tmpdir, err := ioutil.TempDir("", "bdb-test-")
-	defer os.RemoveAll(tmpdir)
-	assert.NoError(t, err)
-	badger, err := NewBadgerDB(tmpdir)
+	badger, err := NewBadgerDB(badgerDir)
 	require.NoError(t, err)
+	bolt, err := NewBoltDB(boltDir)
+	require.NoError(t, err)
+
+	return map[string]DB{
+		"badger": badger,
+		"bolt":   bolt,
+		"mock":   NewMemoryMockDB(),
+	}, cleanup
+}
+
+func TestDB_Components(t *testing.T) {
+	t.Parallel()
 
-	mock := NewMemoryMockDB()
+	dbs, cleanup := componentDBs(t)
+	defer cleanup()
 
 	type data struct {
 		key   testKey
@@ -71,26 +89,118 @@ func TestDB_Components(t *testing.T) {
 	})
 	f.Fuzz(&datas)
 
-	for _, d := range datas {
-		{
-			err := badger.Set(d.key, d.value)
-			assert.NoError(t, err)
+	for name, db := range dbs {
+		for _, d := range datas {
+			err := db.Set(d.key, d.value)
+			assert.NoError(t, err, name)
 		}
-		{
-			err := mock.Set(d.key, d.value)
-			assert.NoError(t, err)
+	}
+	for name, db := range dbs {
+		for _, d := range datas {
+			val, err := db.Get(d.key)
+			assert.NoError(t, err, name)
+			assert.Equal(t, d.value, val, name)
 		}
 	}
-	for _, d := range datas {
-		{
-			val, err := badger.Get(d.key)
-			assert.NoError(t, err)
-			assert.Equal(t, d.value, val)
+}
+
+func TestDB_Components_Iterator(t *testing.T) {
+	t.Parallel()
+
+	dbs, cleanup := componentDBs(t)
+	defer cleanup()
+
+	scope := Scope(rand.Int31())
+	prefix := []byte{1, 2}
+	var expected [][]byte
+	for i := 0; i < 10; i++ {
+		inPrefix := testKey{scope: scope, id: append([]byte{1, 2}, byte(i))}
+		otherPrefix := testKey{scope: scope, id: []byte{1, 3, byte(i)}}
+		otherScope := testKey{scope: scope + 1, id: append([]byte{1, 2}, byte(i))}
+		for _, k := range []testKey{inPrefix, otherPrefix, otherScope} {
+			for name, db := range dbs {
+				require.NoError(t, db.Set(k, []byte{byte(i)}), name)
+			}
 		}
-		{
-			val, err := mock.Get(d.key)
-			assert.NoError(t, err)
-			assert.Equal(t, d.value, val)
+		expected = append(expected, inPrefix.id)
+	}
+
+	collect := func(it Iterator) [][]byte {
+		defer it.Close()
+		var keys [][]byte
+		for it.Next() {
+			keys = append(keys, it.Key())
+			val, err := it.Value()
+			require.NoError(t, err)
+			assert.Equal(t, []byte{it.Key()[2]}, val)
 		}
+		return keys
+	}
+
+	for name, db := range dbs {
+		assert.Equal(t, expected, collect(db.NewIterator(scope, prefix)), name)
+
+		it := db.NewIterator(scope, prefix)
+		it.Seek([]byte{1, 2, 5})
+		assert.Equal(t, expected[5:], collect(it), name)
+	}
+}
+
+func TestDB_Components_Batch(t *testing.T) {
+	t.Parallel()
+
+	dbs, cleanup := componentDBs(t)
+	defer cleanup()
+
+	removed := testKey{scope: Scope(rand.Int31()), id: []byte{1}}
+	added := testKey{scope: removed.scope, id: []byte{2}}
+	for name, db := range dbs {
+		require.NoError(t, db.Set(removed, []byte{1}), name)
+
+		batch := NewBatch()
+		batch.Set(added, []byte{2})
+		batch.Delete(removed)
+		assert.Equal(t, 2, batch.Len())
+		require.NoError(t, db.Write(batch), name)
+
+		_, err := db.Get(removed)
+		assert.Equal(t, ErrNotFound, err, name)
+		val, err := db.Get(added)
+		require.NoError(t, err, name)
+		assert.Equal(t, []byte{2}, val, name)
+
+		require.NoError(t, db.Delete(added), name)
+		_, err = db.Get(added)
+		assert.Equal(t, ErrNotFound, err, name)
+	}
+}
+
+func TestDB_Components_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	dbs, cleanup := componentDBs(t)
+	defer cleanup()
+
+	first := testKey{scope: Scope(rand.Int31()), id: []byte{1}}
+	second := testKey{scope: first.scope, id: []byte{2}}
+	for name, db := range dbs {
+		require.NoError(t, db.Set(first, []byte{1}), name)
+
+		snapshotter, ok := db.(Snapshotter)
+		require.True(t, ok, name)
+		snap := snapshotter.NewSnapshot()
+
+		require.NoError(t, db.Set(first, []byte{3}), name)
+		require.NoError(t, db.Set(second, []byte{2}), name)
+
+		it := snap.NewIterator(first.scope, nil)
+		require.True(t, it.Next(), name)
+		assert.Equal(t, first.id, it.Key(), name)
+		val, err := it.Value()
+		require.NoError(t, err, name)
+		assert.Equal(t, []byte{1}, val, name)
+		assert.False(t, it.Next(), name)
+		it.Close()
+		snap.Close()
 	}
 }
@@ -17,15 +17,30 @@ import (
 type DBMock struct {
 	t minimock.Tester
 
+	DeleteFunc       func(p Key) (r error)
+	DeleteCounter    uint64
+	DeletePreCounter uint64
+	DeleteMock       mDBMockDelete
+
 	GetFunc       func(p Key) (r []byte, r1 error)
 	GetCounter    uint64
 	GetPreCounter uint64
 	GetMock       mDBMockGet
 
+	NewIteratorFunc       func(p Scope, p1 []byte) (r Iterator)
+	NewIteratorCounter    uint64
+	NewIteratorPreCounter uint64
+	NewIteratorMock       mDBMockNewIterator
+
 	SetFunc       func(p Key, p1 []byte) (r error)
 	SetCounter    uint64
 	SetPreCounter uint64
 	SetMock       mDBMockSet
+
+	WriteFunc       func(p *Batch) (r error)
+	WriteCounter    uint64
+	WritePreCounter uint64
+	WriteMock       mDBMockWrite
 }
 
 //NewDBMock returns a mock for github.com/insolar/insolar/internal/ledger/store.DB
@@ -36,12 +51,162 @@ func NewDBMock(t minimock.Tester) *DBMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.DeleteMock = mDBMockDelete{mock: m}
 	m.GetMock = mDBMockGet{mock: m}
+	m.NewIteratorMock = mDBMockNewIterator{mock: m}
 	m.SetMock = mDBMockSet{mock: m}
+	m.WriteMock = mDBMockWrite{mock: m}
+
+	return m
+}
+
+type mDBMockDelete struct {
+	mock              *DBMock
+	mainExpectation   *DBMockDeleteExpectation
+	expectationSeries []*DBMockDeleteExpectation
+}
+
+type DBMockDeleteExpectation struct {
+	input  *DBMockDeleteInput
+	result *DBMockDeleteResult
+}
+
+type DBMockDeleteInput struct {
+	p Key
+}
+
+type DBMockDeleteResult struct {
+	r error
+}
+
+//Expect specifies that invocation of DB.Delete is expected from 1 to Infinity times
+func (m *mDBMockDelete) Expect(p Key) *mDBMockDelete {
+	m.mock.DeleteFunc = nil
+	m.expectationSeries = nil
 
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockDeleteExpectation{}
+	}
+	m.mainExpectation.input = &DBMockDeleteInput{p}
 	return m
 }
 
+//Return specifies results of invocation of DB.Delete
+func (m *mDBMockDelete) Return(r error) *DBMock {
+	m.mock.DeleteFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockDeleteExpectation{}
+	}
+	m.mainExpectation.result = &DBMockDeleteResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.Delete is expected once
+func (m *mDBMockDelete) ExpectOnce(p Key) *DBMockDeleteExpectation {
+	m.mock.DeleteFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockDeleteExpectation{}
+	expectation.input = &DBMockDeleteInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockDeleteExpectation) Return(r error) {
+	e.result = &DBMockDeleteResult{r}
+}
+
+//Set uses given function f as a mock of DB.Delete method
+func (m *mDBMockDelete) Set(f func(p Key) (r error)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.DeleteFunc = f
+	return m.mock
+}
+
+//Delete implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) Delete(p Key) (r error) {
+	counter := atomic.AddUint64(&m.DeletePreCounter, 1)
+	defer atomic.AddUint64(&m.DeleteCounter, 1)
+
+	if len(m.DeleteMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.DeleteMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.Delete. %v", p)
+			return
+		}
+
+		input := m.DeleteMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockDeleteInput{p}, "DB.Delete got unexpected parameters")
+
+		result := m.DeleteMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Delete")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.DeleteMock.mainExpectation != nil {
+
+		input := m.DeleteMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockDeleteInput{p}, "DB.Delete got unexpected parameters")
+		}
+
+		result := m.DeleteMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Delete")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.DeleteFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.Delete. %v", p)
+		return
+	}
+
+	return m.DeleteFunc(p)
+}
+
+//DeleteMinimockCounter returns a count of DBMock.DeleteFunc invocations
+func (m *DBMock) DeleteMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.DeleteCounter)
+}
+
+//DeleteMinimockPreCounter returns the value of DBMock.Delete invocations
+func (m *DBMock) DeleteMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.DeletePreCounter)
+}
+
+//DeleteFinished returns true if mock invocations count is ok
+func (m *DBMock) DeleteFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.DeleteMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.DeleteCounter) == uint64(len(m.DeleteMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.DeleteCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.DeleteFunc != nil {
+		return atomic.LoadUint64(&m.DeleteCounter) > 0
+	}
+
+	return true
+}
+
 type mDBMockGet struct {
 	mock              *DBMock
 	mainExpectation   *DBMockGetExpectation
@@ -192,6 +357,154 @@ func (m *DBMock) GetFinished() bool {
 	return true
 }
 
+type mDBMockNewIterator struct {
+	mock              *DBMock
+	mainExpectation   *DBMockNewIteratorExpectation
+	expectationSeries []*DBMockNewIteratorExpectation
+}
+
+type DBMockNewIteratorExpectation struct {
+	input  *DBMockNewIteratorInput
+	result *DBMockNewIteratorResult
+}
+
+type DBMockNewIteratorInput struct {
+	p  Scope
+	p1 []byte
+}
+
+type DBMockNewIteratorResult struct {
+	r Iterator
+}
+
+//Expect specifies that invocation of DB.NewIterator is expected from 1 to Infinity times
+func (m *mDBMockNewIterator) Expect(p Scope, p1 []byte) *mDBMockNewIterator {
+	m.mock.NewIteratorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockNewIteratorExpectation{}
+	}
+	m.mainExpectation.input = &DBMockNewIteratorInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of DB.NewIterator
+func (m *mDBMockNewIterator) Return(r Iterator) *DBMock {
+	m.mock.NewIteratorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockNewIteratorExpectation{}
+	}
+	m.mainExpectation.result = &DBMockNewIteratorResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.NewIterator is expected once
+func (m *mDBMockNewIterator) ExpectOnce(p Scope, p1 []byte) *DBMockNewIteratorExpectation {
+	m.mock.NewIteratorFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockNewIteratorExpectation{}
+	expectation.input = &DBMockNewIteratorInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockNewIteratorExpectation) Return(r Iterator) {
+	e.result = &DBMockNewIteratorResult{r}
+}
+
+//Set uses given function f as a mock of DB.NewIterator method
+func (m *mDBMockNewIterator) Set(f func(p Scope, p1 []byte) (r Iterator)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NewIteratorFunc = f
+	return m.mock
+}
+
+//NewIterator implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) NewIterator(p Scope, p1 []byte) (r Iterator) {
+	counter := atomic.AddUint64(&m.NewIteratorPreCounter, 1)
+	defer atomic.AddUint64(&m.NewIteratorCounter, 1)
+
+	if len(m.NewIteratorMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NewIteratorMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.NewIterator. %v %v", p, p1)
+			return
+		}
+
+		input := m.NewIteratorMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockNewIteratorInput{p, p1}, "DB.NewIterator got unexpected parameters")
+
+		result := m.NewIteratorMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.NewIterator")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.NewIteratorMock.mainExpectation != nil {
+
+		input := m.NewIteratorMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockNewIteratorInput{p, p1}, "DB.NewIterator got unexpected parameters")
+		}
+
+		result := m.NewIteratorMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.NewIterator")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.NewIteratorFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.NewIterator. %v %v", p, p1)
+		return
+	}
+
+	return m.NewIteratorFunc(p, p1)
+}
+
+//NewIteratorMinimockCounter returns a count of DBMock.NewIteratorFunc invocations
+func (m *DBMock) NewIteratorMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NewIteratorCounter)
+}
+
+//NewIteratorMinimockPreCounter returns the value of DBMock.NewIterator invocations
+func (m *DBMock) NewIteratorMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NewIteratorPreCounter)
+}
+
+//NewIteratorFinished returns true if mock invocations count is ok
+func (m *DBMock) NewIteratorFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NewIteratorMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NewIteratorCounter) == uint64(len(m.NewIteratorMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NewIteratorMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NewIteratorCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NewIteratorFunc != nil {
+		return atomic.LoadUint64(&m.NewIteratorCounter) > 0
+	}
+
+	return true
+}
+
 type mDBMockSet struct {
 	mock              *DBMock
 	mainExpectation   *DBMockSetExpectation
@@ -340,18 +653,177 @@ func (m *DBMock) SetFinished() bool {
 	return true
 }
 
+type mDBMockWrite struct {
+	mock              *DBMock
+	mainExpectation   *DBMockWriteExpectation
+	expectationSeries []*DBMockWriteExpectation
+}
+
+type DBMockWriteExpectation struct {
+	input  *DBMockWriteInput
+	result *DBMockWriteResult
+}
+
+type DBMockWriteInput struct {
+	p *Batch
+}
+
+type DBMockWriteResult struct {
+	r error
+}
+
+//Expect specifies that invocation of DB.Write is expected from 1 to Infinity times
+func (m *mDBMockWrite) Expect(p *Batch) *mDBMockWrite {
+	m.mock.WriteFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockWriteExpectation{}
+	}
+	m.mainExpectation.input = &DBMockWriteInput{p}
+	return m
+}
+
+//Return specifies results of invocation of DB.Write
+func (m *mDBMockWrite) Return(r error) *DBMock {
+	m.mock.WriteFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &DBMockWriteExpectation{}
+	}
+	m.mainExpectation.result = &DBMockWriteResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of DB.Write is expected once
+func (m *mDBMockWrite) ExpectOnce(p *Batch) *DBMockWriteExpectation {
+	m.mock.WriteFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &DBMockWriteExpectation{}
+	expectation.input = &DBMockWriteInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *DBMockWriteExpectation) Return(r error) {
+	e.result = &DBMockWriteResult{r}
+}
+
+//Set uses given function f as a mock of DB.Write method
+func (m *mDBMockWrite) Set(f func(p *Batch) (r error)) *DBMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.WriteFunc = f
+	return m.mock
+}
+
+//Write implements github.com/insolar/insolar/internal/ledger/store.DB interface
+func (m *DBMock) Write(p *Batch) (r error) {
+	counter := atomic.AddUint64(&m.WritePreCounter, 1)
+	defer atomic.AddUint64(&m.WriteCounter, 1)
+
+	if len(m.WriteMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.WriteMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to DBMock.Write. %v", p)
+			return
+		}
+
+		input := m.WriteMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, DBMockWriteInput{p}, "DB.Write got unexpected parameters")
+
+		result := m.WriteMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Write")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.WriteMock.mainExpectation != nil {
+
+		input := m.WriteMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, DBMockWriteInput{p}, "DB.Write got unexpected parameters")
+		}
+
+		result := m.WriteMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the DBMock.Write")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.WriteFunc == nil {
+		m.t.Fatalf("Unexpected call to DBMock.Write. %v", p)
+		return
+	}
+
+	return m.WriteFunc(p)
+}
+
+//WriteMinimockCounter returns a count of DBMock.WriteFunc invocations
+func (m *DBMock) WriteMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.WriteCounter)
+}
+
+//WriteMinimockPreCounter returns the value of DBMock.Write invocations
+func (m *DBMock) WriteMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.WritePreCounter)
+}
+
+//WriteFinished returns true if mock invocations count is ok
+func (m *DBMock) WriteFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.WriteMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.WriteCounter) == uint64(len(m.WriteMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.WriteMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.WriteCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.WriteFunc != nil {
+		return atomic.LoadUint64(&m.WriteCounter) > 0
+	}
+
+	return true
+}
+
 //ValidateCallCounters checks that all mocked methods of the interface have been called at least once
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *DBMock) ValidateCallCounters() {
 
+	if !m.DeleteFinished() {
+		m.t.Fatal("Expected call to DBMock.Delete")
+	}
+
 	if !m.GetFinished() {
 		m.t.Fatal("Expected call to DBMock.Get")
 	}
 
+	if !m.NewIteratorFinished() {
+		m.t.Fatal("Expected call to DBMock.NewIterator")
+	}
+
 	if !m.SetFinished() {
 		m.t.Fatal("Expected call to DBMock.Set")
 	}
 
+	if !m.WriteFinished() {
+		m.t.Fatal("Expected call to DBMock.Write")
+	}
+
 }
 
 //CheckMocksCalled checks that all mocked methods of the interface have been called at least once
@@ -369,14 +841,26 @@ func (m *DBMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *DBMock) MinimockFinish() {
 
+	if !m.DeleteFinished() {
+		m.t.Fatal("Expected call to DBMock.Delete")
+	}
+
 	if !m.GetFinished() {
 		m.t.Fatal("Expected call to DBMock.Get")
 	}
 
+	if !m.NewIteratorFinished() {
+		m.t.Fatal("Expected call to DBMock.NewIterator")
+	}
+
 	if !m.SetFinished() {
 		m.t.Fatal("Expected call to DBMock.Set")
 	}
 
+	if !m.WriteFinished() {
+		m.t.Fatal("Expected call to DBMock.Write")
+	}
+
 }
 
 //Wait waits for all mocked methods to be called at least once
@@ -391,8 +875,11 @@ func (m *DBMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.DeleteFinished()
 		ok = ok && m.GetFinished()
+		ok = ok && m.NewIteratorFinished()
 		ok = ok && m.SetFinished()
+		ok = ok && m.WriteFinished()
 
 		if ok {
 			return
@@ -401,14 +888,26 @@ func (m *DBMock) MinimockWait(timeout time.Duration) {
 		select {
 		case <-timeoutCh:
 
+			if !m.DeleteFinished() {
+				m.t.Error("Expected call to DBMock.Delete")
+			}
+
 			if !m.GetFinished() {
 				m.t.Error("Expected call to DBMock.Get")
 			}
 
+			if !m.NewIteratorFinished() {
+				m.t.Error("Expected call to DBMock.NewIterator")
+			}
+
 			if !m.SetFinished() {
 				m.t.Error("Expected call to DBMock.Set")
 			}
 
+			if !m.WriteFinished() {
+				m.t.Error("Expected call to DBMock.Write")
+			}
+
 			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
 			return
 		default:
@@ -421,13 +920,25 @@ func (m *DBMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *DBMock) AllMocksCalled() bool {
 
+	if !m.DeleteFinished() {
+		return false
+	}
+
 	if !m.GetFinished() {
 		return false
 	}
 
+	if !m.NewIteratorFinished() {
+		return false
+	}
+
 	if !m.SetFinished() {
 		return false
 	}
 
+	if !m.WriteFinished() {
+		return false
+	}
+
 	return true
 }
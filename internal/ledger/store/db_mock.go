@@ -17,6 +17,9 @@
 package store
 
 import (
+	"bytes"
+	"sort"
+	"strings"
 	"sync"
 )
 
@@ -55,3 +58,109 @@ func (b *MockDB) Set(key Key, value []byte) error {
 	b.backend[string(fullKey)] = append([]byte{}, value...)
 	return nil
 }
+
+// Delete removes a value for a key from memory storage.
+func (b *MockDB) Delete(key Key) error {
+	fullKey := append(key.Scope().Bytes(), key.ID()...)
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.backend, string(fullKey))
+	return nil
+}
+
+// Write applies all operations of the batch under a single lock.
+func (b *MockDB) Write(batch *Batch) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, op := range batch.ops {
+		if op.delete {
+			delete(b.backend, string(op.key))
+			continue
+		}
+		b.backend[string(op.key)] = append([]byte{}, op.value...)
+	}
+	return nil
+}
+
+// NewIterator creates an iterator over a snapshot of keys of the scope with provided prefix.
+func (b *MockDB) NewIterator(scope Scope, prefix []byte) Iterator {
+	fullPrefix := string(append(scope.Bytes(), prefix...))
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	it := &mockIterator{scope: scope.Bytes(), pos: -1}
+	for k, v := range b.backend {
+		if !strings.HasPrefix(k, fullPrefix) {
+			continue
+		}
+		it.keys = append(it.keys, []byte(k))
+		it.values = append(it.values, append([]byte{}, v...))
+	}
+	sort.Sort(it)
+	return it
+}
+
+// NewSnapshot takes a copy of all data in memory.
+func (b *MockDB) NewSnapshot() Snapshot {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	snap := NewMemoryMockDB()
+	for k, v := range b.backend {
+		snap.backend[k] = v
+	}
+	return mockSnapshot{MockDB: snap}
+}
+
+type mockSnapshot struct {
+	*MockDB
+}
+
+// Close does nothing for memory snapshot.
+func (mockSnapshot) Close() {}
+
+type mockIterator struct {
+	scope  []byte
+	keys   [][]byte
+	values [][]byte
+	pos    int
+}
+
+func (i *mockIterator) Len() int {
+	return len(i.keys)
+}
+
+func (i *mockIterator) Less(a, b int) bool {
+	return bytes.Compare(i.keys[a], i.keys[b]) < 0
+}
+
+func (i *mockIterator) Swap(a, b int) {
+	i.keys[a], i.keys[b] = i.keys[b], i.keys[a]
+	i.values[a], i.values[b] = i.values[b], i.values[a]
+}
+
+// Next moves iterator to the next key.
+func (i *mockIterator) Next() bool {
+	i.pos++
+	return i.pos < len(i.keys)
+}
+
+// Seek moves iterator to the first key, that is greater or equal to provided id.
+func (i *mockIterator) Seek(id []byte) {
+	target := append(append([]byte{}, i.scope...), id...)
+	i.pos = sort.Search(len(i.keys), func(n int) bool {
+		return bytes.Compare(i.keys[n], target) >= 0
+	}) - 1
+}
+
+// Key returns a copy of the current key without a scope.
+func (i *mockIterator) Key() []byte {
+	return append([]byte{}, i.keys[i.pos][len(i.scope):]...)
+}
+
+// Value returns a copy of the current value.
+func (i *mockIterator) Value() ([]byte, error) {
+	return append([]byte{}, i.values[i.pos]...), nil
+}
+
+// Close does nothing for memory iterator.
+func (i *mockIterator) Close() {}
@@ -87,3 +87,41 @@ func TestMockDB_Set(t *testing.T) {
 	value := db.backend[string(append(key.Scope().Bytes(), key.ID()...))]
 	assert.Equal(t, expectedValue, value)
 }
+
+func TestMockDB_Delete(t *testing.T) {
+	t.Parallel()
+
+	db := NewMemoryMockDB()
+
+	var (
+		key   testMockKey
+		value []byte
+	)
+	f := fuzz.New().NilChance(0)
+	f.Fuzz(&key)
+	f.Fuzz(&value)
+	db.backend[string(append(key.Scope().Bytes(), key.ID()...))] = value
+
+	err := db.Delete(key)
+	assert.NoError(t, err)
+	_, ok := db.backend[string(append(key.Scope().Bytes(), key.ID()...))]
+	assert.False(t, ok)
+}
+
+func TestMockDB_NewIterator_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	db := NewMemoryMockDB()
+	key := testMockKey{id: []byte{1}, scope: 1}
+	err := db.Set(key, []byte{1})
+	assert.NoError(t, err)
+
+	it := db.NewIterator(key.Scope(), nil)
+	defer it.Close()
+	err = db.Set(testMockKey{id: []byte{2}, scope: 1}, []byte{2})
+	assert.NoError(t, err)
+
+	assert.True(t, it.Next())
+	assert.Equal(t, []byte{1}, it.Key())
+	assert.False(t, it.Next())
+}
@@ -23,4 +23,6 @@ import (
 var (
 	// ErrNotFound is returned when value was not found.
 	ErrNotFound = errors.New("value not found")
+	// ErrReadOnly is returned on write to a db opened in read-only mode.
+	ErrReadOnly = errors.New("db is opened in read-only mode")
 )
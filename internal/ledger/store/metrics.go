@@ -0,0 +1,55 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+)
+
+var (
+	statStoreUserBytes = stats.Int64(
+		"store/bolt/user/bytes",
+		"Size of keys and values passed to bolt db writes",
+		stats.UnitBytes,
+	)
+	statStoreWrittenBytes = stats.Int64(
+		"store/bolt/written/bytes",
+		"Size of pages written to bolt db file",
+		stats.UnitBytes,
+	)
+)
+
+func init() {
+	err := view.Register(
+		&view.View{
+			Name:        statStoreUserBytes.Name(),
+			Description: statStoreUserBytes.Description(),
+			Measure:     statStoreUserBytes,
+			Aggregation: view.Sum(),
+		},
+		&view.View{
+			Name:        statStoreWrittenBytes.Name(),
+			Description: statStoreWrittenBytes.Description(),
+			Measure:     statStoreWrittenBytes,
+			Aggregation: view.Sum(),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
@@ -19,6 +19,7 @@ package drop
 import (
 	"bytes"
 	"context"
+	"sort"
 
 	"github.com/ugorji/go/codec"
 
@@ -76,6 +77,22 @@ func MustEncode(drop *Drop) []byte {
 	return buf.Bytes()
 }
 
+// CalculateHash returns hash of a drop. It covers hash of the previous drop and ids of records, that were saved for
+// the jet during the pulse, in ascending order.
+func CalculateHash(hasher insolar.Hasher, prevHash []byte, records []insolar.ID) []byte {
+	ids := make([]insolar.ID, len(records))
+	copy(ids, records)
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Compare(ids[j]) < 0
+	})
+
+	_, _ = hasher.Write(prevHash)
+	for _, id := range ids {
+		_, _ = hasher.Write(id.Bytes())
+	}
+	return hasher.Sum(nil)
+}
+
 // Decode deserializes jet drop.
 func Decode(buf []byte) (*Drop, error) {
 	dec := codec.NewDecoder(bytes.NewReader(buf), &codec.CborHandle{})
@@ -0,0 +1,25 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package exporter provides access to finalized pulses stored on heavy.
+//
+// Heavy appends every record, blob and index bucket it receives to a per-pulse journal. Once a pulse is older than
+// configured lag, its data is read back through the journal and returned to consumers page by page. Pages are
+// addressed by pulse number, so a consumer can resume from the last pulse it has seen.
+//
+// Genesis records and data stored before the journal was introduced bypass it, so heavy backfills the journal from
+// its db once on start.
+package exporter
@@ -0,0 +1,209 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+var (
+	// ErrNotFinalized is returned when requested pulse is not finalized yet.
+	ErrNotFinalized = errors.New("pulse is not finalized yet")
+	// ErrInvalidSize is returned when requested page size is not positive.
+	ErrInvalidSize = errors.New("page size should be positive")
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/heavy/exporter.Exporter -o ./ -s _mock.go
+
+// Exporter provides methods for fetching data of finalized pulses.
+type Exporter interface {
+	// Export returns up to size finalized pulses starting from a provided pulse number. Zero pulse number means the
+	// first pulse of the chain. Page.NextPulseNumber should be used as a cursor for the next call.
+	Export(ctx context.Context, from insolar.PulseNumber, size int) (*Page, error)
+}
+
+// Page is a portion of exported pulses.
+type Page struct {
+	Pulses []PulseData
+	// NextPulseNumber is the first pulse, that was not included to the page.
+	NextPulseNumber insolar.PulseNumber
+}
+
+// PulseData holds all data, that was stored on heavy for a pulse.
+type PulseData struct {
+	Pulse   insolar.Pulse
+	Records []Record
+	Blobs   []Blob
+	Indexes []object.IndexBucket
+	Drops   []drop.Drop
+}
+
+// Record is a material record with its id.
+type Record struct {
+	ID     insolar.ID
+	Record record.Material
+}
+
+// Blob is a blob with its id.
+type Blob struct {
+	ID   insolar.ID
+	Blob blob.Blob
+}
+
+type exporter struct {
+	exportLag uint32
+
+	pulses  pulse.Accessor
+	calc    pulse.Calculator
+	journal JournalAccessor
+	records object.RecordAccessor
+	blobs   blob.Accessor
+	indexes object.IndexBucketObjAccessor
+	drops   drop.Accessor
+}
+
+// NewExporter creates a new exporter. Pulse is considered finalized when it is older than exportLag seconds.
+func NewExporter(
+	exportLag uint32,
+	pulses pulse.Accessor,
+	calc pulse.Calculator,
+	journal JournalAccessor,
+	records object.RecordAccessor,
+	blobs blob.Accessor,
+	indexes object.IndexBucketObjAccessor,
+	drops drop.Accessor,
+) Exporter {
+	return &exporter{
+		exportLag: exportLag,
+		pulses:    pulses,
+		calc:      calc,
+		journal:   journal,
+		records:   records,
+		blobs:     blobs,
+		indexes:   indexes,
+		drops:     drops,
+	}
+}
+
+// Export returns up to size finalized pulses starting from a provided pulse number.
+func (e *exporter) Export(ctx context.Context, from insolar.PulseNumber, size int) (*Page, error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	if from == 0 {
+		from = insolar.GenesisPulse.PulseNumber
+	}
+
+	latest, err := e.pulses.Latest(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch latest pulse")
+	}
+	current, err := e.pulses.ForPulseNumber(ctx, from)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch pulse %v", from)
+	}
+
+	page := &Page{NextPulseNumber: current.PulseNumber}
+	for len(page.Pulses) < size && e.isFinalized(current.PulseNumber, latest.PulseNumber) {
+		data, err := e.pulseData(ctx, current)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to export pulse %v", current.PulseNumber)
+		}
+		page.Pulses = append(page.Pulses, data)
+
+		// Latest pulse is never finalized, so the next pulse always exists here.
+		current, err = e.calc.Forwards(ctx, current.PulseNumber, 1)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to calculate next pulse")
+		}
+		page.NextPulseNumber = current.PulseNumber
+	}
+
+	return page, nil
+}
+
+func (e *exporter) isFinalized(pn, latest insolar.PulseNumber) bool {
+	return uint64(pn)+uint64(e.exportLag) < uint64(latest)
+}
+
+func (e *exporter) pulseData(ctx context.Context, p insolar.Pulse) (PulseData, error) {
+	journal, err := e.journal.ForPulse(ctx, p.PulseNumber)
+	if err != nil {
+		return PulseData{}, err
+	}
+
+	data := PulseData{Pulse: p}
+
+	for _, id := range sortedIDs(journal.Records) {
+		rec, err := e.records.ForID(ctx, id)
+		if err != nil {
+			return PulseData{}, errors.Wrapf(err, "failed to fetch record %v", id.DebugString())
+		}
+		data.Records = append(data.Records, Record{ID: id, Record: rec})
+	}
+
+	for _, id := range sortedIDs(journal.Blobs) {
+		b, err := e.blobs.ForID(ctx, id)
+		if err != nil {
+			return PulseData{}, errors.Wrapf(err, "failed to fetch blob %v", id.DebugString())
+		}
+		data.Blobs = append(data.Blobs, Blob{ID: id, Blob: b})
+	}
+
+	for _, id := range sortedIDs(journal.Indexes) {
+		buck, err := e.indexes.ForPNAndObjID(ctx, p.PulseNumber, id)
+		if err != nil {
+			return PulseData{}, errors.Wrapf(err, "failed to fetch index for %v", id.DebugString())
+		}
+		data.Indexes = append(data.Indexes, buck)
+	}
+
+	jets := make([]insolar.JetID, len(journal.Jets))
+	copy(jets, journal.Jets)
+	sort.Slice(jets, func(i, j int) bool {
+		return bytes.Compare(jets[i][:], jets[j][:]) < 0
+	})
+	for _, jetID := range jets {
+		dr, err := e.drops.ForPulse(ctx, jetID, p.PulseNumber)
+		if err != nil {
+			return PulseData{}, errors.Wrapf(err, "failed to fetch drop for jet %v", jetID.DebugString())
+		}
+		data.Drops = append(data.Drops, dr)
+	}
+
+	return data, nil
+}
+
+func sortedIDs(ids []insolar.ID) []insolar.ID {
+	res := make([]insolar.ID, len(ids))
+	copy(res, ids)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Compare(res[j]) < 0
+	})
+	return res
+}
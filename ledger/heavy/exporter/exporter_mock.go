@@ -0,0 +1,267 @@
+package exporter
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Exporter" can be found in github.com/insolar/insolar/ledger/heavy/exporter
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//ExporterMock implements github.com/insolar/insolar/ledger/heavy/exporter.Exporter
+type ExporterMock struct {
+	t minimock.Tester
+
+	ExportFunc       func(p context.Context, p1 insolar.PulseNumber, p2 int) (r *Page, r1 error)
+	ExportCounter    uint64
+	ExportPreCounter uint64
+	ExportMock       mExporterMockExport
+}
+
+//NewExporterMock returns a mock for github.com/insolar/insolar/ledger/heavy/exporter.Exporter
+func NewExporterMock(t minimock.Tester) *ExporterMock {
+	m := &ExporterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ExportMock = mExporterMockExport{mock: m}
+
+	return m
+}
+
+type mExporterMockExport struct {
+	mock              *ExporterMock
+	mainExpectation   *ExporterMockExportExpectation
+	expectationSeries []*ExporterMockExportExpectation
+}
+
+type ExporterMockExportExpectation struct {
+	input  *ExporterMockExportInput
+	result *ExporterMockExportResult
+}
+
+type ExporterMockExportInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 int
+}
+
+type ExporterMockExportResult struct {
+	r  *Page
+	r1 error
+}
+
+//Expect specifies that invocation of Exporter.Export is expected from 1 to Infinity times
+func (m *mExporterMockExport) Expect(p context.Context, p1 insolar.PulseNumber, p2 int) *mExporterMockExport {
+	m.mock.ExportFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ExporterMockExportExpectation{}
+	}
+	m.mainExpectation.input = &ExporterMockExportInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Exporter.Export
+func (m *mExporterMockExport) Return(r *Page, r1 error) *ExporterMock {
+	m.mock.ExportFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ExporterMockExportExpectation{}
+	}
+	m.mainExpectation.result = &ExporterMockExportResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Exporter.Export is expected once
+func (m *mExporterMockExport) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 int) *ExporterMockExportExpectation {
+	m.mock.ExportFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ExporterMockExportExpectation{}
+	expectation.input = &ExporterMockExportInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ExporterMockExportExpectation) Return(r *Page, r1 error) {
+	e.result = &ExporterMockExportResult{r, r1}
+}
+
+//Set uses given function f as a mock of Exporter.Export method
+func (m *mExporterMockExport) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 int) (r *Page, r1 error)) *ExporterMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ExportFunc = f
+	return m.mock
+}
+
+//Export implements github.com/insolar/insolar/ledger/heavy/exporter.Exporter interface
+func (m *ExporterMock) Export(p context.Context, p1 insolar.PulseNumber, p2 int) (r *Page, r1 error) {
+	counter := atomic.AddUint64(&m.ExportPreCounter, 1)
+	defer atomic.AddUint64(&m.ExportCounter, 1)
+
+	if len(m.ExportMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ExportMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ExporterMock.Export. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.ExportMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ExporterMockExportInput{p, p1, p2}, "Exporter.Export got unexpected parameters")
+
+		result := m.ExportMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ExporterMock.Export")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ExportMock.mainExpectation != nil {
+
+		input := m.ExportMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ExporterMockExportInput{p, p1, p2}, "Exporter.Export got unexpected parameters")
+		}
+
+		result := m.ExportMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ExporterMock.Export")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ExportFunc == nil {
+		m.t.Fatalf("Unexpected call to ExporterMock.Export. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.ExportFunc(p, p1, p2)
+}
+
+//ExportMinimockCounter returns a count of ExporterMock.ExportFunc invocations
+func (m *ExporterMock) ExportMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ExportCounter)
+}
+
+//ExportMinimockPreCounter returns the value of ExporterMock.Export invocations
+func (m *ExporterMock) ExportMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ExportPreCounter)
+}
+
+//ExportFinished returns true if mock invocations count is ok
+func (m *ExporterMock) ExportFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ExportMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ExportCounter) == uint64(len(m.ExportMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ExportMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ExportCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ExportFunc != nil {
+		return atomic.LoadUint64(&m.ExportCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ExporterMock) ValidateCallCounters() {
+
+	if !m.ExportFinished() {
+		m.t.Fatal("Expected call to ExporterMock.Export")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ExporterMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *ExporterMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *ExporterMock) MinimockFinish() {
+
+	if !m.ExportFinished() {
+		m.t.Fatal("Expected call to ExporterMock.Export")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *ExporterMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *ExporterMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ExportFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ExportFinished() {
+				m.t.Error("Expected call to ExporterMock.Export")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *ExporterMock) AllMocksCalled() bool {
+
+	if !m.ExportFinished() {
+		return false
+	}
+
+	return true
+}
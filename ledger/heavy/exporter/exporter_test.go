@@ -0,0 +1,197 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+type exporterEnv struct {
+	pulses   *pulse.DB
+	journals *JournalDB
+	records  *object.RecordDB
+	blobs    *blob.DB
+	indexes  *object.IndexDB
+	drops    *drop.DB
+}
+
+func newExporterEnv() *exporterEnv {
+	db := store.NewMemoryMockDB()
+	return &exporterEnv{
+		pulses:   pulse.NewDB(db),
+		journals: NewJournalDB(db),
+		records:  object.NewRecordDB(db),
+		blobs:    blob.NewDB(db),
+		indexes:  object.NewIndexDB(db),
+		drops:    drop.NewDB(db),
+	}
+}
+
+func (e *exporterEnv) exporter(lag uint32) Exporter {
+	return NewExporter(lag, e.pulses, e.pulses, e.journals, e.records, e.blobs, e.indexes, e.drops)
+}
+
+// appendPulses appends count pulses starting from genesis pulse with step of ten seconds.
+func (e *exporterEnv) appendPulses(ctx context.Context, t *testing.T, count int) []insolar.PulseNumber {
+	var pns []insolar.PulseNumber
+	for i := 0; i < count; i++ {
+		pn := insolar.GenesisPulse.PulseNumber + insolar.PulseNumber(i*10)
+		err := e.pulses.Append(ctx, insolar.Pulse{PulseNumber: pn})
+		require.NoError(t, err)
+		pns = append(pns, pn)
+	}
+	return pns
+}
+
+func TestExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	t.Run("returns only finalized pulses", func(t *testing.T) {
+		t.Parallel()
+
+		env := newExporterEnv()
+		pns := env.appendPulses(ctx, t, 5)
+
+		// Latest pulse is 40 seconds newer than the first one, so only first two pulses are older than lag.
+		page, err := env.exporter(25).Export(ctx, 0, 10)
+		require.NoError(t, err)
+
+		require.Equal(t, 2, len(page.Pulses))
+		assert.Equal(t, pns[0], page.Pulses[0].Pulse.PulseNumber)
+		assert.Equal(t, pns[1], page.Pulses[1].Pulse.PulseNumber)
+		assert.Equal(t, pns[2], page.NextPulseNumber)
+	})
+
+	t.Run("resumes from cursor", func(t *testing.T) {
+		t.Parallel()
+
+		env := newExporterEnv()
+		pns := env.appendPulses(ctx, t, 6)
+		exp := env.exporter(0)
+
+		page, err := exp.Export(ctx, 0, 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(page.Pulses))
+		assert.Equal(t, pns[2], page.NextPulseNumber)
+
+		page, err = exp.Export(ctx, page.NextPulseNumber, 2)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(page.Pulses))
+		assert.Equal(t, pns[2], page.Pulses[0].Pulse.PulseNumber)
+		assert.Equal(t, pns[3], page.Pulses[1].Pulse.PulseNumber)
+		assert.Equal(t, pns[4], page.NextPulseNumber)
+
+		page, err = exp.Export(ctx, page.NextPulseNumber, 2)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(page.Pulses))
+		assert.Equal(t, pns[5], page.NextPulseNumber)
+
+		page, err = exp.Export(ctx, page.NextPulseNumber, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(page.Pulses))
+		assert.Equal(t, pns[5], page.NextPulseNumber)
+	})
+
+	t.Run("returns stored data in order", func(t *testing.T) {
+		t.Parallel()
+
+		env := newExporterEnv()
+		pns := env.appendPulses(ctx, t, 3)
+		pn := pns[1]
+
+		jetID := gen.JetID()
+		var recIDs []insolar.ID
+		for i := 0; i < 3; i++ {
+			virt := record.Wrap(record.Code{Code: gen.ID()})
+			id := gen.ID()
+			err := env.records.Set(ctx, id, record.Material{Virtual: &virt, JetID: jetID})
+			require.NoError(t, err)
+			recIDs = append(recIDs, id)
+		}
+		blobID := gen.ID()
+		err := env.blobs.Set(ctx, blobID, blob.Blob{Value: []byte{1, 2, 3}, JetID: jetID})
+		require.NoError(t, err)
+		objID := gen.ID()
+		err = env.indexes.SetBucket(ctx, pn, object.IndexBucket{ObjID: objID})
+		require.NoError(t, err)
+		err = env.drops.Set(ctx, drop.Drop{Pulse: pn, JetID: jetID, Size: 42})
+		require.NoError(t, err)
+
+		err = env.journals.Append(ctx, pn, Journal{
+			Jets:    []insolar.JetID{jetID},
+			Records: recIDs,
+			Blobs:   []insolar.ID{blobID},
+			Indexes: []insolar.ID{objID},
+		})
+		require.NoError(t, err)
+
+		page, err := env.exporter(0).Export(ctx, pn, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(page.Pulses))
+
+		data := page.Pulses[0]
+		assert.Equal(t, pn, data.Pulse.PulseNumber)
+		require.Equal(t, 3, len(data.Records))
+		for i := 1; i < len(data.Records); i++ {
+			assert.True(t, data.Records[i-1].ID.Compare(data.Records[i].ID) < 0)
+		}
+		assert.ElementsMatch(t, recIDs, []insolar.ID{data.Records[0].ID, data.Records[1].ID, data.Records[2].ID})
+		require.Equal(t, 1, len(data.Blobs))
+		assert.Equal(t, blobID, data.Blobs[0].ID)
+		assert.Equal(t, []byte{1, 2, 3}, data.Blobs[0].Blob.Value)
+		require.Equal(t, 1, len(data.Indexes))
+		assert.Equal(t, objID, data.Indexes[0].ObjID)
+		require.Equal(t, 1, len(data.Drops))
+		assert.Equal(t, uint64(42), data.Drops[0].Size)
+	})
+
+	t.Run("returns error for unknown pulse", func(t *testing.T) {
+		t.Parallel()
+
+		env := newExporterEnv()
+		pns := env.appendPulses(ctx, t, 3)
+
+		_, err := env.exporter(0).Export(ctx, pns[0]+1, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("returns error for invalid size", func(t *testing.T) {
+		t.Parallel()
+
+		env := newExporterEnv()
+		env.appendPulses(ctx, t, 3)
+
+		_, err := env.exporter(0).Export(ctx, 0, 0)
+		assert.Equal(t, ErrInvalidSize, err)
+	})
+}
@@ -0,0 +1,279 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/drop"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/heavy/exporter.JournalAccessor -o ./ -s _mock.go
+
+// JournalAccessor provides an interface for accessing journal of stored data.
+type JournalAccessor interface {
+	ForPulse(ctx context.Context, pn insolar.PulseNumber) (Journal, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/heavy/exporter.JournalModifier -o ./ -s _mock.go
+
+// JournalModifier provides an interface for appending data to journal.
+type JournalModifier interface {
+	Append(ctx context.Context, pn insolar.PulseNumber, journal Journal) error
+}
+
+// Journal holds ids of data, that was stored on heavy for a pulse.
+type Journal struct {
+	Jets    []insolar.JetID
+	Records []insolar.ID
+	Blobs   []insolar.ID
+	Indexes []insolar.ID
+}
+
+// JournalDB is a db-based journal storage.
+type JournalDB struct {
+	lock sync.Mutex
+	db   store.DB
+}
+
+type journalKey insolar.PulseNumber
+
+func (k journalKey) Scope() store.Scope {
+	return store.ScopeExportJournal
+}
+
+func (k journalKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
+// backfillKey marks that data stored before the journal was introduced is added to the journal. It's longer than
+// journal keys, so they never collide.
+type backfillKey struct{}
+
+func (k backfillKey) Scope() store.Scope {
+	return store.ScopeExportJournal
+}
+
+func (k backfillKey) ID() []byte {
+	return []byte("backfill")
+}
+
+// NewJournalDB creates a new journal storage, that holds data in a db.
+func NewJournalDB(db store.DB) *JournalDB {
+	return &JournalDB{db: db}
+}
+
+// ForPulse returns a journal for a provided pulse. Empty journal is returned if nothing was stored for the pulse.
+func (j *JournalDB) ForPulse(ctx context.Context, pn insolar.PulseNumber) (Journal, error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	return j.get(pn)
+}
+
+// Append adds ids from a provided journal to the journal of the pulse. Already known ids are skipped.
+func (j *JournalDB) Append(ctx context.Context, pn insolar.PulseNumber, journal Journal) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	return j.append(pn, journal)
+}
+
+// Backfill adds data, that was stored on heavy bypassing the journal, to the journal. It covers genesis records and
+// data stored before the journal was introduced. The whole db is scanned, so it's done once and remembered in the db.
+func (j *JournalDB) Backfill(ctx context.Context) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	_, err := j.db.Get(backfillKey{})
+	if err == nil {
+		return nil
+	}
+	if err != store.ErrNotFound {
+		return errors.Wrap(err, "failed to fetch backfill mark")
+	}
+
+	err = j.backfillIDs(store.ScopeRecord, func(journal *Journal, key []byte) {
+		journal.Records = append(journal.Records, idFromKey(key))
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to backfill records")
+	}
+	err = j.backfillIDs(store.ScopeBlob, func(journal *Journal, key []byte) {
+		journal.Blobs = append(journal.Blobs, idFromKey(key))
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to backfill blobs")
+	}
+	// Index keys are pulse number followed by object id.
+	err = j.backfillIDs(store.ScopeIndex, func(journal *Journal, key []byte) {
+		journal.Indexes = append(journal.Indexes, idFromKey(key[insolar.PulseNumberSize:]))
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to backfill indexes")
+	}
+	err = j.backfillDrops()
+	if err != nil {
+		return errors.Wrap(err, "failed to backfill drops")
+	}
+
+	return j.db.Set(backfillKey{}, []byte{1})
+}
+
+// backfillIDs walks through a scope, which keys start with pulse number, and appends ids to journals pulse by pulse.
+func (j *JournalDB) backfillIDs(scope store.Scope, add func(journal *Journal, key []byte)) error {
+	it := j.db.NewIterator(scope, nil)
+	defer it.Close()
+
+	var (
+		pn      insolar.PulseNumber
+		journal Journal
+	)
+	for it.Next() {
+		key := it.Key()
+		if len(key) < insolar.RecordIDSize {
+			continue
+		}
+		keyPN := insolar.NewPulseNumber(key)
+		if keyPN != pn {
+			if err := j.append(pn, journal); err != nil {
+				return err
+			}
+			pn, journal = keyPN, Journal{}
+		}
+		add(&journal, key)
+	}
+	return j.append(pn, journal)
+}
+
+// backfillDrops appends jets of stored drops to journals. Drop keys start with jet prefix, so drops are collected
+// by pulses first.
+func (j *JournalDB) backfillDrops() error {
+	it := j.db.NewIterator(store.ScopeJetDrop, nil)
+	defer it.Close()
+
+	jets := map[insolar.PulseNumber][]insolar.JetID{}
+	for it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			return err
+		}
+		dr, err := drop.Decode(buf)
+		if err != nil {
+			return err
+		}
+		jets[dr.Pulse] = append(jets[dr.Pulse], dr.JetID)
+	}
+	for pn, ids := range jets {
+		if err := j.append(pn, Journal{Jets: ids}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *JournalDB) append(pn insolar.PulseNumber, journal Journal) error {
+	if len(journal.Jets)+len(journal.Records)+len(journal.Blobs)+len(journal.Indexes) == 0 {
+		return nil
+	}
+
+	current, err := j.get(pn)
+	if err != nil {
+		return err
+	}
+
+	current.Jets = appendJets(current.Jets, journal.Jets)
+	current.Records = appendIDs(current.Records, journal.Records)
+	current.Blobs = appendIDs(current.Blobs, journal.Blobs)
+	current.Indexes = appendIDs(current.Indexes, journal.Indexes)
+
+	return j.db.Set(journalKey(pn), mustEncode(current))
+}
+
+func (j *JournalDB) get(pn insolar.PulseNumber) (Journal, error) {
+	buf, err := j.db.Get(journalKey(pn))
+	if err == store.ErrNotFound {
+		return Journal{}, nil
+	}
+	if err != nil {
+		return Journal{}, errors.Wrap(err, "failed to fetch journal")
+	}
+	return decode(buf)
+}
+
+func idFromKey(key []byte) insolar.ID {
+	var id insolar.ID
+	copy(id[:], key)
+	return id
+}
+
+func appendJets(to []insolar.JetID, from []insolar.JetID) []insolar.JetID {
+	known := make(map[insolar.JetID]struct{}, len(to))
+	for _, jetID := range to {
+		known[jetID] = struct{}{}
+	}
+	for _, jetID := range from {
+		if _, ok := known[jetID]; ok {
+			continue
+		}
+		known[jetID] = struct{}{}
+		to = append(to, jetID)
+	}
+	return to
+}
+
+func appendIDs(to []insolar.ID, from []insolar.ID) []insolar.ID {
+	known := make(map[insolar.ID]struct{}, len(to))
+	for _, id := range to {
+		known[id] = struct{}{}
+	}
+	for _, id := range from {
+		if _, ok := known[id]; ok {
+			continue
+		}
+		known[id] = struct{}{}
+		to = append(to, id)
+	}
+	return to
+}
+
+func mustEncode(journal Journal) []byte {
+	var buf bytes.Buffer
+	enc := codec.NewEncoder(&buf, &codec.CborHandle{})
+	err := enc.Encode(journal)
+	if err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func decode(buf []byte) (Journal, error) {
+	dec := codec.NewDecoder(bytes.NewReader(buf), &codec.CborHandle{})
+	var journal Journal
+	err := dec.Decode(&journal)
+	if err != nil {
+		return Journal{}, errors.Wrap(err, "failed to decode journal")
+	}
+	return journal, nil
+}
@@ -0,0 +1,266 @@
+package exporter
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "JournalAccessor" can be found in github.com/insolar/insolar/ledger/heavy/exporter
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//JournalAccessorMock implements github.com/insolar/insolar/ledger/heavy/exporter.JournalAccessor
+type JournalAccessorMock struct {
+	t minimock.Tester
+
+	ForPulseFunc       func(p context.Context, p1 insolar.PulseNumber) (r Journal, r1 error)
+	ForPulseCounter    uint64
+	ForPulsePreCounter uint64
+	ForPulseMock       mJournalAccessorMockForPulse
+}
+
+//NewJournalAccessorMock returns a mock for github.com/insolar/insolar/ledger/heavy/exporter.JournalAccessor
+func NewJournalAccessorMock(t minimock.Tester) *JournalAccessorMock {
+	m := &JournalAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForPulseMock = mJournalAccessorMockForPulse{mock: m}
+
+	return m
+}
+
+type mJournalAccessorMockForPulse struct {
+	mock              *JournalAccessorMock
+	mainExpectation   *JournalAccessorMockForPulseExpectation
+	expectationSeries []*JournalAccessorMockForPulseExpectation
+}
+
+type JournalAccessorMockForPulseExpectation struct {
+	input  *JournalAccessorMockForPulseInput
+	result *JournalAccessorMockForPulseResult
+}
+
+type JournalAccessorMockForPulseInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+}
+
+type JournalAccessorMockForPulseResult struct {
+	r  Journal
+	r1 error
+}
+
+//Expect specifies that invocation of JournalAccessor.ForPulse is expected from 1 to Infinity times
+func (m *mJournalAccessorMockForPulse) Expect(p context.Context, p1 insolar.PulseNumber) *mJournalAccessorMockForPulse {
+	m.mock.ForPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalAccessorMockForPulseExpectation{}
+	}
+	m.mainExpectation.input = &JournalAccessorMockForPulseInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of JournalAccessor.ForPulse
+func (m *mJournalAccessorMockForPulse) Return(r Journal, r1 error) *JournalAccessorMock {
+	m.mock.ForPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalAccessorMockForPulseExpectation{}
+	}
+	m.mainExpectation.result = &JournalAccessorMockForPulseResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of JournalAccessor.ForPulse is expected once
+func (m *mJournalAccessorMockForPulse) ExpectOnce(p context.Context, p1 insolar.PulseNumber) *JournalAccessorMockForPulseExpectation {
+	m.mock.ForPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JournalAccessorMockForPulseExpectation{}
+	expectation.input = &JournalAccessorMockForPulseInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *JournalAccessorMockForPulseExpectation) Return(r Journal, r1 error) {
+	e.result = &JournalAccessorMockForPulseResult{r, r1}
+}
+
+//Set uses given function f as a mock of JournalAccessor.ForPulse method
+func (m *mJournalAccessorMockForPulse) Set(f func(p context.Context, p1 insolar.PulseNumber) (r Journal, r1 error)) *JournalAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForPulseFunc = f
+	return m.mock
+}
+
+//ForPulse implements github.com/insolar/insolar/ledger/heavy/exporter.JournalAccessor interface
+func (m *JournalAccessorMock) ForPulse(p context.Context, p1 insolar.PulseNumber) (r Journal, r1 error) {
+	counter := atomic.AddUint64(&m.ForPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.ForPulseCounter, 1)
+
+	if len(m.ForPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JournalAccessorMock.ForPulse. %v %v", p, p1)
+			return
+		}
+
+		input := m.ForPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JournalAccessorMockForPulseInput{p, p1}, "JournalAccessor.ForPulse got unexpected parameters")
+
+		result := m.ForPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalAccessorMock.ForPulse")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForPulseMock.mainExpectation != nil {
+
+		input := m.ForPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JournalAccessorMockForPulseInput{p, p1}, "JournalAccessor.ForPulse got unexpected parameters")
+		}
+
+		result := m.ForPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalAccessorMock.ForPulse")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to JournalAccessorMock.ForPulse. %v %v", p, p1)
+		return
+	}
+
+	return m.ForPulseFunc(p, p1)
+}
+
+//ForPulseMinimockCounter returns a count of JournalAccessorMock.ForPulseFunc invocations
+func (m *JournalAccessorMock) ForPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForPulseCounter)
+}
+
+//ForPulseMinimockPreCounter returns the value of JournalAccessorMock.ForPulse invocations
+func (m *JournalAccessorMock) ForPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForPulsePreCounter)
+}
+
+//ForPulseFinished returns true if mock invocations count is ok
+func (m *JournalAccessorMock) ForPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForPulseCounter) == uint64(len(m.ForPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForPulseFunc != nil {
+		return atomic.LoadUint64(&m.ForPulseCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *JournalAccessorMock) ValidateCallCounters() {
+
+	if !m.ForPulseFinished() {
+		m.t.Fatal("Expected call to JournalAccessorMock.ForPulse")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *JournalAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *JournalAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *JournalAccessorMock) MinimockFinish() {
+
+	if !m.ForPulseFinished() {
+		m.t.Fatal("Expected call to JournalAccessorMock.ForPulse")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *JournalAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *JournalAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForPulseFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForPulseFinished() {
+				m.t.Error("Expected call to JournalAccessorMock.ForPulse")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *JournalAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForPulseFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,264 @@
+package exporter
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "JournalModifier" can be found in github.com/insolar/insolar/ledger/heavy/exporter
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//JournalModifierMock implements github.com/insolar/insolar/ledger/heavy/exporter.JournalModifier
+type JournalModifierMock struct {
+	t minimock.Tester
+
+	AppendFunc       func(p context.Context, p1 insolar.PulseNumber, p2 Journal) (r error)
+	AppendCounter    uint64
+	AppendPreCounter uint64
+	AppendMock       mJournalModifierMockAppend
+}
+
+//NewJournalModifierMock returns a mock for github.com/insolar/insolar/ledger/heavy/exporter.JournalModifier
+func NewJournalModifierMock(t minimock.Tester) *JournalModifierMock {
+	m := &JournalModifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AppendMock = mJournalModifierMockAppend{mock: m}
+
+	return m
+}
+
+type mJournalModifierMockAppend struct {
+	mock              *JournalModifierMock
+	mainExpectation   *JournalModifierMockAppendExpectation
+	expectationSeries []*JournalModifierMockAppendExpectation
+}
+
+type JournalModifierMockAppendExpectation struct {
+	input  *JournalModifierMockAppendInput
+	result *JournalModifierMockAppendResult
+}
+
+type JournalModifierMockAppendInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 Journal
+}
+
+type JournalModifierMockAppendResult struct {
+	r error
+}
+
+//Expect specifies that invocation of JournalModifier.Append is expected from 1 to Infinity times
+func (m *mJournalModifierMockAppend) Expect(p context.Context, p1 insolar.PulseNumber, p2 Journal) *mJournalModifierMockAppend {
+	m.mock.AppendFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalModifierMockAppendExpectation{}
+	}
+	m.mainExpectation.input = &JournalModifierMockAppendInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of JournalModifier.Append
+func (m *mJournalModifierMockAppend) Return(r error) *JournalModifierMock {
+	m.mock.AppendFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalModifierMockAppendExpectation{}
+	}
+	m.mainExpectation.result = &JournalModifierMockAppendResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of JournalModifier.Append is expected once
+func (m *mJournalModifierMockAppend) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 Journal) *JournalModifierMockAppendExpectation {
+	m.mock.AppendFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JournalModifierMockAppendExpectation{}
+	expectation.input = &JournalModifierMockAppendInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *JournalModifierMockAppendExpectation) Return(r error) {
+	e.result = &JournalModifierMockAppendResult{r}
+}
+
+//Set uses given function f as a mock of JournalModifier.Append method
+func (m *mJournalModifierMockAppend) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 Journal) (r error)) *JournalModifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AppendFunc = f
+	return m.mock
+}
+
+//Append implements github.com/insolar/insolar/ledger/heavy/exporter.JournalModifier interface
+func (m *JournalModifierMock) Append(p context.Context, p1 insolar.PulseNumber, p2 Journal) (r error) {
+	counter := atomic.AddUint64(&m.AppendPreCounter, 1)
+	defer atomic.AddUint64(&m.AppendCounter, 1)
+
+	if len(m.AppendMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AppendMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JournalModifierMock.Append. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.AppendMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JournalModifierMockAppendInput{p, p1, p2}, "JournalModifier.Append got unexpected parameters")
+
+		result := m.AppendMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalModifierMock.Append")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AppendMock.mainExpectation != nil {
+
+		input := m.AppendMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JournalModifierMockAppendInput{p, p1, p2}, "JournalModifier.Append got unexpected parameters")
+		}
+
+		result := m.AppendMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalModifierMock.Append")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AppendFunc == nil {
+		m.t.Fatalf("Unexpected call to JournalModifierMock.Append. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.AppendFunc(p, p1, p2)
+}
+
+//AppendMinimockCounter returns a count of JournalModifierMock.AppendFunc invocations
+func (m *JournalModifierMock) AppendMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AppendCounter)
+}
+
+//AppendMinimockPreCounter returns the value of JournalModifierMock.Append invocations
+func (m *JournalModifierMock) AppendMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AppendPreCounter)
+}
+
+//AppendFinished returns true if mock invocations count is ok
+func (m *JournalModifierMock) AppendFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AppendMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AppendCounter) == uint64(len(m.AppendMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AppendMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AppendCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AppendFunc != nil {
+		return atomic.LoadUint64(&m.AppendCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *JournalModifierMock) ValidateCallCounters() {
+
+	if !m.AppendFinished() {
+		m.t.Fatal("Expected call to JournalModifierMock.Append")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *JournalModifierMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *JournalModifierMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *JournalModifierMock) MinimockFinish() {
+
+	if !m.AppendFinished() {
+		m.t.Fatal("Expected call to JournalModifierMock.Append")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *JournalModifierMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *JournalModifierMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.AppendFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.AppendFinished() {
+				m.t.Error("Expected call to JournalModifierMock.Append")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *JournalModifierMock) AllMocksCalled() bool {
+
+	if !m.AppendFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,145 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+func TestJournalDB_ForPulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	t.Run("returns empty journal for unknown pulse", func(t *testing.T) {
+		t.Parallel()
+
+		journals := NewJournalDB(store.NewMemoryMockDB())
+
+		journal, err := journals.ForPulse(ctx, gen.PulseNumber())
+		require.NoError(t, err)
+		assert.Equal(t, Journal{}, journal)
+	})
+
+	t.Run("returns appended journal", func(t *testing.T) {
+		t.Parallel()
+
+		journals := NewJournalDB(store.NewMemoryMockDB())
+		pn := gen.PulseNumber()
+		expected := Journal{
+			Jets:    []insolar.JetID{gen.JetID()},
+			Records: []insolar.ID{gen.ID(), gen.ID()},
+			Blobs:   []insolar.ID{gen.ID()},
+			Indexes: []insolar.ID{gen.ID()},
+		}
+
+		err := journals.Append(ctx, pn, expected)
+		require.NoError(t, err)
+
+		journal, err := journals.ForPulse(ctx, pn)
+		require.NoError(t, err)
+		assert.Equal(t, expected, journal)
+	})
+}
+
+func TestJournalDB_Append(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	journals := NewJournalDB(store.NewMemoryMockDB())
+	pn := gen.PulseNumber()
+
+	firstJet, secondJet := gen.JetID(), gen.JetID()
+	gen.UniqueJetIDs(&firstJet, &secondJet)
+	firstRec, secondRec := gen.ID(), gen.ID()
+
+	err := journals.Append(ctx, pn, Journal{
+		Jets:    []insolar.JetID{firstJet},
+		Records: []insolar.ID{firstRec},
+	})
+	require.NoError(t, err)
+	err = journals.Append(ctx, pn, Journal{
+		Jets:    []insolar.JetID{firstJet, secondJet},
+		Records: []insolar.ID{firstRec, secondRec},
+	})
+	require.NoError(t, err)
+
+	journal, err := journals.ForPulse(ctx, pn)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.JetID{firstJet, secondJet}, journal.Jets)
+	assert.Equal(t, []insolar.ID{firstRec, secondRec}, journal.Records)
+}
+
+func TestJournalDB_Backfill(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	db := store.NewMemoryMockDB()
+	journals := NewJournalDB(db)
+
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	second := first + 10
+	firstRec, secondRec := *insolar.NewID(first, []byte{1}), *insolar.NewID(second, []byte{2})
+	blobID := *insolar.NewID(second, []byte{3})
+	objID := *insolar.NewID(first, []byte{4})
+	jetID := gen.JetID()
+
+	records := object.NewRecordDB(db)
+	require.NoError(t, records.Set(ctx, firstRec, record.Material{}))
+	require.NoError(t, records.Set(ctx, secondRec, record.Material{}))
+	require.NoError(t, blob.NewDB(db).Set(ctx, blobID, blob.Blob{Value: []byte{1}, JetID: jetID}))
+	require.NoError(t, object.NewIndexDB(db).SetBucket(ctx, first, object.IndexBucket{ObjID: objID}))
+	require.NoError(t, drop.NewDB(db).Set(ctx, drop.Drop{Pulse: first, JetID: jetID}))
+
+	// Data stored through the journal is kept.
+	journaled := *insolar.NewID(second, []byte{5})
+	require.NoError(t, journals.Append(ctx, second, Journal{Records: []insolar.ID{journaled}}))
+
+	require.NoError(t, journals.Backfill(ctx))
+
+	journal, err := journals.ForPulse(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, Journal{
+		Jets:    []insolar.JetID{jetID},
+		Records: []insolar.ID{firstRec},
+		Indexes: []insolar.ID{objID},
+	}, journal)
+
+	journal, err = journals.ForPulse(ctx, second)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []insolar.ID{journaled, secondRec}, journal.Records)
+	assert.Equal(t, []insolar.ID{blobID}, journal.Blobs)
+
+	// Backfill is done once.
+	require.NoError(t, records.Set(ctx, *insolar.NewID(first, []byte{6}), record.Material{}))
+	require.NoError(t, journals.Backfill(ctx))
+	journal, err = journals.ForPulse(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.ID{firstRec}, journal.Records)
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/ThreeDotsLabs/watermill"
 	watermillMsg "github.com/ThreeDotsLabs/watermill/message"
@@ -29,6 +30,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
 	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 
@@ -48,9 +50,16 @@ type Handler struct {
 	BlobModifier          blob.Modifier
 	RecordAccessor        object.RecordAccessor
 	RecordModifier        object.RecordModifier
+	RecordResultAccessor  object.RecordResultAccessor
+	RecordEventAccessor   object.RecordEventAccessor
 	IndexLifelineAccessor object.LifelineAccessor
 	IndexBucketModifier   object.IndexBucketModifier
 	DropModifier          drop.Modifier
+	JournalModifier       exporter.JournalModifier
+
+	// PayloadLock is read locked while a heavy payload is stored. Snapshots lock it, so a snapshot never has a part
+	// of a payload.
+	PayloadLock *sync.RWMutex
 
 	jetID insolar.JetID
 }
@@ -58,7 +67,8 @@ type Handler struct {
 // New creates a new handler.
 func New() *Handler {
 	return &Handler{
-		jetID: *insolar.NewJetID(0, nil),
+		PayloadLock: &sync.RWMutex{},
+		jetID:       *insolar.NewJetID(0, nil),
 	}
 }
 
@@ -108,6 +118,8 @@ func (h *Handler) Init(ctx context.Context) error {
 	h.Bus.MustRegister(insolar.TypeGetChildren, h.handleGetChildren)
 	h.Bus.MustRegister(insolar.TypeGetObjectIndex, h.handleGetObjectIndex)
 	h.Bus.MustRegister(insolar.TypeGetRequest, h.handleGetRequest)
+	h.Bus.MustRegister(insolar.TypeGetResult, h.handleGetResult)
+	h.Bus.MustRegister(insolar.TypeGetEvents, h.handleGetEvents)
 	return nil
 }
 
@@ -181,6 +193,8 @@ func (h *Handler) handleGetObject(
 		IsPrototype:  state.GetIsPrototype(),
 		ChildPointer: childPointer,
 		Parent:       idx.Parent,
+		PrevState:    state.PrevStateID(),
+		Request:      state.GetRequest(),
 	}
 
 	if state.GetMemory() != nil && state.GetMemory().NotEmpty() {
@@ -317,6 +331,51 @@ func (h *Handler) handleGetRequest(ctx context.Context, parcel insolar.Parcel) (
 	return &rep, nil
 }
 
+func (h *Handler) handleGetResult(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetResult)
+
+	id, err := h.RecordResultAccessor.ResultForRequest(ctx, msg.Request)
+	if err == object.ErrNotFound {
+		return &reply.Error{ErrType: reply.ErrNoResult}, nil
+	}
+	if err != nil {
+		return nil, errors.New("failed to fetch result id")
+	}
+
+	rec, err := h.RecordAccessor.ForID(ctx, id)
+	if err != nil {
+		return nil, errors.New("failed to fetch result")
+	}
+
+	data, err := rec.Virtual.Marshal()
+	if err != nil {
+		return nil, errors.New("failed to serialize result")
+	}
+
+	return &reply.Result{ID: id, Record: data}, nil
+}
+
+func (h *Handler) handleGetEvents(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetEvents)
+
+	events, next, err := h.RecordEventAccessor.EventsForObject(ctx, msg.Object, msg.Name, msg.From, msg.Limit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch events")
+	}
+
+	rep := reply.Events{Next: next}
+	for _, e := range events {
+		rep.Events = append(rep.Events, reply.Event{
+			Request:  e.Request,
+			Result:   e.Result,
+			Position: e.Position,
+			Data:     e.Data,
+		})
+	}
+
+	return &rep, nil
+}
+
 func (h *Handler) handleGetObjectIndex(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg := parcel.Message().(*message.GetObjectIndex)
 
@@ -349,14 +408,36 @@ func (h *Handler) getCode(ctx context.Context, id *insolar.ID) (record.Code, err
 func (h *Handler) handleHeavyPayload(ctx context.Context, genericMsg insolar.Parcel) (insolar.Reply, error) {
 	msg := genericMsg.Message().(*message.HeavyPayload)
 
-	storeRecords(ctx, h.RecordModifier, h.PCS, msg.PulseNum, msg.Records)
-	if err := storeIndexBuckets(ctx, h.IndexBucketModifier, msg.IndexBuckets, msg.PulseNum); err != nil {
+	h.PayloadLock.RLock()
+	defer h.PayloadLock.RUnlock()
+
+	// Light acknowledges the pulse only on OK reply, so every item should be persisted before it. Drop is stored
+	// last, failed payload is re-sent by light and already stored items are skipped as overrides.
+	records, err := storeRecords(ctx, h.RecordModifier, h.PCS, msg.PulseNum, msg.Records)
+	if err != nil {
+		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
+	}
+	blobs, err := storeBlobs(ctx, h.BlobModifier, h.PCS, msg.PulseNum, msg.Blobs)
+	if err != nil {
+		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
+	}
+	indexes, err := storeIndexBuckets(ctx, h.IndexBucketModifier, msg.IndexBuckets, msg.PulseNum)
+	if err != nil {
 		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
 	}
 	if err := storeDrop(ctx, h.DropModifier, msg.Drop); err != nil {
 		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
 	}
-	storeBlobs(ctx, h.BlobModifier, h.PCS, msg.PulseNum, msg.Blobs)
+
+	err = h.JournalModifier.Append(ctx, msg.PulseNum, exporter.Journal{
+		Jets:    []insolar.JetID{msg.JetID},
+		Records: records,
+		Blobs:   blobs,
+		Indexes: indexes,
+	})
+	if err != nil {
+		return &reply.HeavyError{Message: err.Error(), JetID: msg.JetID, PulseNum: msg.PulseNum}, nil
+	}
 
 	stats.Record(ctx,
 		statReceivedHeavyPayloadCount.M(1),
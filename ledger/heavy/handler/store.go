@@ -33,22 +33,23 @@ func storeIndexBuckets(
 	indexes object.IndexBucketModifier,
 	rawBuckets [][]byte,
 	pn insolar.PulseNumber,
-) error {
+) ([]insolar.ID, error) {
+	var stored []insolar.ID
 	for _, rwb := range rawBuckets {
 		buck := object.IndexBucket{}
 		err := buck.Unmarshal(rwb)
 		if err != nil {
-			inslogger.FromContext(ctx).Error(err)
-			continue
+			return nil, errors.Wrapf(err, "heavyserver: deserialize index failed")
 		}
 
 		err = indexes.SetBucket(ctx, pn, buck)
 		if err != nil {
-			return errors.Wrapf(err, "heavyserver: index storing failed")
+			return nil, errors.Wrapf(err, "heavyserver: index storing failed")
 		}
+		stored = append(stored, buck.ObjID)
 	}
 
-	return nil
+	return stored, nil
 }
 
 func storeDrop(
@@ -62,6 +63,10 @@ func storeDrop(
 		return err
 	}
 	err = drops.Set(ctx, *d)
+	if err == drop.ErrOverride {
+		// Payload is re-sent by light, the drop is already stored.
+		return nil
+	}
 	if err != nil {
 		return errors.Wrapf(err, "heavyserver: drop storing failed")
 	}
@@ -75,23 +80,23 @@ func storeBlobs(
 	pcs insolar.PlatformCryptographyScheme,
 	pn insolar.PulseNumber,
 	rawBlobs [][]byte,
-) {
-	inslog := inslogger.FromContext(ctx)
-
+) ([]insolar.ID, error) {
+	var stored []insolar.ID
 	for _, rwb := range rawBlobs {
 		b, err := blob.Decode(rwb)
 		if err != nil {
-			inslog.Error(err, "heavyserver: deserialize blob failed")
-			continue
+			return nil, errors.Wrapf(err, "heavyserver: deserialize blob failed")
 		}
 
 		blobID := object.CalculateIDForBlob(pcs, pn, b.Value)
 		err = blobs.Set(ctx, *blobID, *b)
-		if err != nil {
-			inslog.Error(err, "heavyserver: blob storing failed")
-			continue
+		if err != nil && err != blob.ErrOverride {
+			return nil, errors.Wrapf(err, "heavyserver: blob storing failed")
 		}
+		stored = append(stored, *blobID)
 	}
+
+	return stored, nil
 }
 
 func storeRecords(
@@ -100,24 +105,24 @@ func storeRecords(
 	pcs insolar.PlatformCryptographyScheme,
 	pn insolar.PulseNumber,
 	rawRecords [][]byte,
-) {
-	inslog := inslogger.FromContext(ctx)
-
+) ([]insolar.ID, error) {
+	var stored []insolar.ID
 	for _, rawRec := range rawRecords {
 		rec := record.Material{}
 		err := rec.Unmarshal(rawRec)
 		if err != nil {
-			inslog.Error(err, "heavyserver: deserialize record failed")
-			continue
+			return nil, errors.Wrapf(err, "heavyserver: deserialize record failed")
 		}
 
 		virtRec := *rec.Virtual
 		hash := record.HashVirtual(pcs.ReferenceHasher(), virtRec)
 		id := insolar.NewID(pn, hash)
 		err = records.Set(ctx, *id, rec)
-		if err != nil {
-			inslog.Error(err, "heavyserver: store record failed")
-			continue
+		if err != nil && err != object.ErrOverride {
+			return nil, errors.Wrapf(err, "heavyserver: store record failed")
 		}
+		stored = append(stored, *id)
 	}
+
+	return stored, nil
 }
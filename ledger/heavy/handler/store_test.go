@@ -0,0 +1,56 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package handler
+
+import (
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestStoreRecords(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	virtual := record.Wrap(record.Request{Method: "test"})
+	raw, err := (&record.Material{Virtual: &virtual}).Marshal()
+	require.NoError(t, err)
+
+	records := object.NewRecordModifierMock(mc)
+	records.SetMock.Return(object.ErrOverride)
+	stored, err := storeRecords(ctx, records, pcs, insolar.FirstPulseNumber, [][]byte{raw})
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+
+	// Payload with a record, that is not persisted, is not acknowledged.
+	records.SetMock.Return(errors.New("disk is full"))
+	_, err = storeRecords(ctx, records, pcs, insolar.FirstPulseNumber, [][]byte{raw})
+	require.Error(t, err)
+
+	_, err = storeRecords(ctx, records, pcs, insolar.FirstPulseNumber, [][]byte{{0xff}})
+	require.Error(t, err)
+}
@@ -18,6 +18,7 @@ package pulsemanager
 
 import (
 	"context"
+	"io"
 	"sync"
 
 	"github.com/insolar/insolar/insolar"
@@ -25,6 +26,8 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/heavy/snapshot"
 	"github.com/pkg/errors"
 	"go.opencensus.io/trace"
 )
@@ -43,6 +46,12 @@ type PulseManager struct {
 	NodeSetter        node.Modifier             `inject:""`
 	Nodes             node.Accessor             `inject:""`
 	PulseAppender     pulse.Appender            `inject:""`
+	PulseAccessor     pulse.Accessor            `inject:""`
+
+	// DB is a storage of the node. It is used for taking snapshots.
+	DB store.DB
+	// PayloadLock is read locked by heavy payload writes. It is locked while a snapshot view is taken.
+	PayloadLock *sync.RWMutex
 
 	currentPulse insolar.Pulse
 
@@ -56,6 +65,7 @@ type PulseManager struct {
 func NewPulseManager() *PulseManager {
 	pm := &PulseManager{
 		currentPulse: *insolar.GenesisPulse,
+		PayloadLock:  &sync.RWMutex{},
 	}
 	return pm
 }
@@ -132,6 +142,44 @@ func (m *PulseManager) setUnderGilSection(ctx context.Context, newPulse insolar.
 	return nil
 }
 
+// Snapshot writes a snapshot of the storage to w. Returns the latest pulse of the snapshot.
+func (m *PulseManager) Snapshot(ctx context.Context, w io.Writer) (insolar.PulseNumber, error) {
+	snap, pn, err := m.takeSnapshot(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Close()
+
+	err = snapshot.Write(ctx, snap, pn, w)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to write snapshot")
+	}
+	return pn, nil
+}
+
+// takeSnapshot takes a view of the storage under the pulse lock, so the view has the latest pulse. Heavy payload
+// writes are held off while the view is taken, so every payload is either entirely in the view or not in it at all.
+// Locks are not held while the view is written.
+func (m *PulseManager) takeSnapshot(ctx context.Context) (store.Snapshot, insolar.PulseNumber, error) {
+	m.setLock.RLock()
+	defer m.setLock.RUnlock()
+	m.PayloadLock.Lock()
+	defer m.PayloadLock.Unlock()
+	if m.stopped {
+		return nil, 0, errors.New("can't take snapshot after stop")
+	}
+	snapshotter, ok := m.DB.(store.Snapshotter)
+	if !ok {
+		return nil, 0, errors.New("storage doesn't support snapshots")
+	}
+
+	latest, err := m.PulseAccessor.Latest(ctx)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "failed to fetch latest pulse")
+	}
+	return snapshotter.NewSnapshot(), latest.PulseNumber, nil
+}
+
 // Start starts pulse manager.
 func (m *PulseManager) Start(ctx context.Context) error {
 	origin := m.NodeNet.GetOrigin()
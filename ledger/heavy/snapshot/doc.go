@@ -0,0 +1,31 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package snapshot provides backup and restore of heavy's storage.
+//
+// Snapshot is a portable archive of all key-value pairs of the storage, so it can be restored to any storage backend.
+// Snapshot is written from a single consistent view of the storage, that is taken together with the latest pulse
+// under heavy's pulse lock. Heavy payloads are not stored while the view is taken, so the view has either all or none
+// of the items of a payload. Heavy keeps storing data while the archive is written. Archive layout:
+//
+//	magic (8 bytes) | version (4 bytes) | pulse number (4 bytes) | gzip stream
+//
+// Gzip stream contains entries: tag 1 (1 byte), scope (1 byte), key length (uvarint), key, value length (uvarint),
+// value. Entries are followed by tag 0, count of entries (8 bytes) and crc32 of all entries (4 bytes).
+//
+// Restored node has the data, that was stored on the source node when the snapshot was taken. Catch-up of the data,
+// that light nodes replicated after that moment, is not implemented.
+package snapshot
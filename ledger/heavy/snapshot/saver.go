@@ -0,0 +1,106 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/heavy/snapshot.Taker -o ./ -s _mock.go
+
+// Taker takes a consistent snapshot of the storage at a pulse boundary.
+type Taker interface {
+	// Snapshot writes a snapshot archive to w and returns pulse number of the snapshot.
+	Snapshot(ctx context.Context, w io.Writer) (insolar.PulseNumber, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/heavy/snapshot.Saver -o ./ -s _mock.go
+
+// Saver saves snapshots to files.
+type Saver interface {
+	// Save takes a snapshot and saves it to a file.
+	Save(ctx context.Context) (Info, error)
+}
+
+// Info describes a saved snapshot.
+type Info struct {
+	PulseNumber insolar.PulseNumber
+	Path        string
+}
+
+// FileSaver saves snapshots to a directory. File name of the snapshot contains its pulse number.
+type FileSaver struct {
+	dir   string
+	taker Taker
+}
+
+// NewFileSaver creates new saver instance.
+func NewFileSaver(dir string, taker Taker) *FileSaver {
+	return &FileSaver{dir: dir, taker: taker}
+}
+
+// Save takes a snapshot and saves it to the directory. Snapshot is written to a temporary file first, so a file with
+// snapshot name is always complete.
+func (s *FileSaver) Save(ctx context.Context) (Info, error) {
+	err := os.MkdirAll(s.dir, 0750)
+	if err != nil {
+		return Info{}, errors.Wrap(err, "failed to create snapshot directory")
+	}
+
+	tmp, err := ioutil.TempFile(s.dir, "snapshot-*.tmp")
+	if err != nil {
+		return Info{}, errors.Wrap(err, "failed to create snapshot file")
+	}
+	defer os.Remove(tmp.Name()) // nolint: errcheck
+
+	pn, err := s.taker.Snapshot(ctx, tmp)
+	if err != nil {
+		_ = tmp.Close()
+		return Info{}, errors.Wrap(err, "failed to take snapshot")
+	}
+	err = tmp.Sync()
+	if err != nil {
+		_ = tmp.Close()
+		return Info{}, errors.Wrap(err, "failed to sync snapshot file")
+	}
+	err = tmp.Close()
+	if err != nil {
+		return Info{}, errors.Wrap(err, "failed to close snapshot file")
+	}
+
+	path := filepath.Join(s.dir, FileName(pn))
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return Info{}, errors.Wrap(err, "failed to rename snapshot file")
+	}
+
+	return Info{PulseNumber: pn, Path: path}, nil
+}
+
+// FileName returns snapshot file name for provided pulse.
+func FileName(pn insolar.PulseNumber) string {
+	return fmt.Sprintf("snapshot-%d.insnap", pn)
+}
@@ -0,0 +1,264 @@
+package snapshot
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Saver" can be found in github.com/insolar/insolar/ledger/heavy/snapshot
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//SaverMock implements github.com/insolar/insolar/ledger/heavy/snapshot.Saver
+type SaverMock struct {
+	t minimock.Tester
+
+	SaveFunc       func(p context.Context) (r Info, r1 error)
+	SaveCounter    uint64
+	SavePreCounter uint64
+	SaveMock       mSaverMockSave
+}
+
+//NewSaverMock returns a mock for github.com/insolar/insolar/ledger/heavy/snapshot.Saver
+func NewSaverMock(t minimock.Tester) *SaverMock {
+	m := &SaverMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SaveMock = mSaverMockSave{mock: m}
+
+	return m
+}
+
+type mSaverMockSave struct {
+	mock              *SaverMock
+	mainExpectation   *SaverMockSaveExpectation
+	expectationSeries []*SaverMockSaveExpectation
+}
+
+type SaverMockSaveExpectation struct {
+	input  *SaverMockSaveInput
+	result *SaverMockSaveResult
+}
+
+type SaverMockSaveInput struct {
+	p context.Context
+}
+
+type SaverMockSaveResult struct {
+	r  Info
+	r1 error
+}
+
+//Expect specifies that invocation of Saver.Save is expected from 1 to Infinity times
+func (m *mSaverMockSave) Expect(p context.Context) *mSaverMockSave {
+	m.mock.SaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &SaverMockSaveExpectation{}
+	}
+	m.mainExpectation.input = &SaverMockSaveInput{p}
+	return m
+}
+
+//Return specifies results of invocation of Saver.Save
+func (m *mSaverMockSave) Return(r Info, r1 error) *SaverMock {
+	m.mock.SaveFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &SaverMockSaveExpectation{}
+	}
+	m.mainExpectation.result = &SaverMockSaveResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Saver.Save is expected once
+func (m *mSaverMockSave) ExpectOnce(p context.Context) *SaverMockSaveExpectation {
+	m.mock.SaveFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &SaverMockSaveExpectation{}
+	expectation.input = &SaverMockSaveInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *SaverMockSaveExpectation) Return(r Info, r1 error) {
+	e.result = &SaverMockSaveResult{r, r1}
+}
+
+//Set uses given function f as a mock of Saver.Save method
+func (m *mSaverMockSave) Set(f func(p context.Context) (r Info, r1 error)) *SaverMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SaveFunc = f
+	return m.mock
+}
+
+//Save implements github.com/insolar/insolar/ledger/heavy/snapshot.Saver interface
+func (m *SaverMock) Save(p context.Context) (r Info, r1 error) {
+	counter := atomic.AddUint64(&m.SavePreCounter, 1)
+	defer atomic.AddUint64(&m.SaveCounter, 1)
+
+	if len(m.SaveMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SaveMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to SaverMock.Save. %v", p)
+			return
+		}
+
+		input := m.SaveMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, SaverMockSaveInput{p}, "Saver.Save got unexpected parameters")
+
+		result := m.SaveMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the SaverMock.Save")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.SaveMock.mainExpectation != nil {
+
+		input := m.SaveMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, SaverMockSaveInput{p}, "Saver.Save got unexpected parameters")
+		}
+
+		result := m.SaveMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the SaverMock.Save")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.SaveFunc == nil {
+		m.t.Fatalf("Unexpected call to SaverMock.Save. %v", p)
+		return
+	}
+
+	return m.SaveFunc(p)
+}
+
+//SaveMinimockCounter returns a count of SaverMock.SaveFunc invocations
+func (m *SaverMock) SaveMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SaveCounter)
+}
+
+//SaveMinimockPreCounter returns the value of SaverMock.Save invocations
+func (m *SaverMock) SaveMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SavePreCounter)
+}
+
+//SaveFinished returns true if mock invocations count is ok
+func (m *SaverMock) SaveFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SaveMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SaveCounter) == uint64(len(m.SaveMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SaveMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SaveCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SaveFunc != nil {
+		return atomic.LoadUint64(&m.SaveCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *SaverMock) ValidateCallCounters() {
+
+	if !m.SaveFinished() {
+		m.t.Fatal("Expected call to SaverMock.Save")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *SaverMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *SaverMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *SaverMock) MinimockFinish() {
+
+	if !m.SaveFinished() {
+		m.t.Fatal("Expected call to SaverMock.Save")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *SaverMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *SaverMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.SaveFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.SaveFinished() {
+				m.t.Error("Expected call to SaverMock.Save")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *SaverMock) AllMocksCalled() bool {
+
+	if !m.SaveFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package snapshot
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+func TestFileSaver_Save(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, err := ioutil.TempDir("", "snapshots-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	t.Run("saves snapshot to file", func(t *testing.T) {
+		pn := gen.PulseNumber()
+		taker := NewTakerMock(t)
+		taker.SnapshotFunc = func(_ context.Context, w io.Writer) (insolar.PulseNumber, error) {
+			_, err := w.Write([]byte("archive"))
+			return pn, err
+		}
+
+		info, err := NewFileSaver(dir, taker).Save(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, pn, info.PulseNumber)
+		assert.Equal(t, filepath.Join(dir, FileName(pn)), info.Path)
+
+		content, err := ioutil.ReadFile(info.Path)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("archive"), content)
+	})
+
+	t.Run("removes file on error", func(t *testing.T) {
+		taker := NewTakerMock(t)
+		taker.SnapshotFunc = func(_ context.Context, w io.Writer) (insolar.PulseNumber, error) {
+			_, _ = w.Write([]byte("partial"))
+			return 0, errors.New("test error")
+		}
+		before, err := ioutil.ReadDir(dir)
+		require.NoError(t, err)
+
+		_, err = NewFileSaver(dir, taker).Save(ctx)
+		assert.Error(t, err)
+
+		after, err := ioutil.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Equal(t, len(before), len(after))
+	})
+}
@@ -0,0 +1,336 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"hash"
+	"hash/crc32"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// Version is a current version of snapshot archive format.
+const Version uint32 = 1
+
+const (
+	tagEnd   byte = 0
+	tagEntry byte = 1
+
+	// restoreBatchSize is a size of keys and values written to db in one batch on restore. It's kept well below
+	// transaction size limit of badger.
+	restoreBatchSize = 4 * 1024 * 1024
+)
+
+var magic = []byte("INSLSNAP")
+
+var (
+	// ErrBadArchive is returned when archive is broken or is not a snapshot.
+	ErrBadArchive = errors.New("bad snapshot archive")
+	// ErrUnsupportedVersion is returned when archive version is not supported.
+	ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+	// ErrNotEmpty is returned when snapshot is restored to a non-empty storage.
+	ErrNotEmpty = errors.New("storage is not empty")
+)
+
+// Header describes a snapshot archive.
+type Header struct {
+	Version     uint32
+	PulseNumber insolar.PulseNumber
+}
+
+// Write writes all data from db snapshot to w as a snapshot archive for provided pulse.
+func Write(ctx context.Context, db store.Snapshot, pn insolar.PulseNumber, w io.Writer) error {
+	header := make([]byte, 0, len(magic)+8)
+	header = append(header, magic...)
+	header = append(header, uint32Bytes(Version)...)
+	header = append(header, pn.Bytes()...)
+	_, err := w.Write(header)
+	if err != nil {
+		return errors.Wrap(err, "failed to write snapshot header")
+	}
+
+	zw := gzip.NewWriter(w)
+	ew := &entryWriter{w: bufio.NewWriter(zw), crc: crc32.NewIEEE()}
+	for scope := 0; scope <= 0xFF; scope++ {
+		err = ew.writeScope(store.Scope(scope), db)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = ew.close()
+	if err != nil {
+		return err
+	}
+	err = zw.Close()
+	if err != nil {
+		return errors.Wrap(err, "failed to finish snapshot")
+	}
+
+	inslogger.FromContext(ctx).Infof("snapshot for pulse %v is written, %d entries", pn, ew.count)
+	return nil
+}
+
+// Restore writes all data from snapshot archive to db. Db should be empty. Returns header of restored snapshot.
+// If error is returned, db may contain partially restored data and should be dropped.
+func Restore(ctx context.Context, db store.DB, r io.Reader) (Header, error) {
+	empty, err := isEmpty(db)
+	if err != nil {
+		return Header{}, err
+	}
+	if !empty {
+		return Header{}, ErrNotEmpty
+	}
+
+	header, err := ReadHeader(r)
+	if err != nil {
+		return Header{}, err
+	}
+
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return Header{}, errors.Wrap(ErrBadArchive, err.Error())
+	}
+	er := &entryReader{r: bufio.NewReader(zr), crc: crc32.NewIEEE()}
+
+	batch := store.NewBatch()
+	batchSize := 0
+	for {
+		key, value, ok, err := er.next()
+		if err != nil {
+			return Header{}, err
+		}
+		if !ok {
+			break
+		}
+		batch.Set(key, value)
+		batchSize += len(key.ID()) + len(value)
+		if batchSize >= restoreBatchSize {
+			err = db.Write(batch)
+			if err != nil {
+				return Header{}, errors.Wrap(err, "failed to write restored data")
+			}
+			batch = store.NewBatch()
+			batchSize = 0
+		}
+	}
+	err = db.Write(batch)
+	if err != nil {
+		return Header{}, errors.Wrap(err, "failed to write restored data")
+	}
+
+	err = er.verify()
+	if err != nil {
+		return Header{}, err
+	}
+
+	inslogger.FromContext(ctx).Infof("snapshot for pulse %v is restored, %d entries", header.PulseNumber, er.count)
+	return header, nil
+}
+
+// ReadHeader reads and checks header of snapshot archive.
+func ReadHeader(r io.Reader) (Header, error) {
+	buf := make([]byte, len(magic)+8)
+	_, err := io.ReadFull(r, buf)
+	if err != nil {
+		return Header{}, errors.Wrap(ErrBadArchive, err.Error())
+	}
+	if !bytes.Equal(buf[:len(magic)], magic) {
+		return Header{}, ErrBadArchive
+	}
+
+	header := Header{
+		Version:     binary.BigEndian.Uint32(buf[len(magic):]),
+		PulseNumber: insolar.NewPulseNumber(buf[len(magic)+4:]),
+	}
+	if header.Version != Version {
+		return Header{}, ErrUnsupportedVersion
+	}
+	return header, nil
+}
+
+func isEmpty(db store.DB) (bool, error) {
+	for scope := 0; scope <= 0xFF; scope++ {
+		it := db.NewIterator(store.Scope(scope), nil)
+		found := it.Next()
+		it.Close()
+		if found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+type rawKey struct {
+	scope store.Scope
+	id    []byte
+}
+
+func (k rawKey) Scope() store.Scope {
+	return k.scope
+}
+
+func (k rawKey) ID() []byte {
+	return k.id
+}
+
+type entryWriter struct {
+	w     *bufio.Writer
+	crc   hash.Hash32
+	count uint64
+}
+
+func (ew *entryWriter) writeScope(scope store.Scope, db store.Snapshot) error {
+	it := db.NewIterator(scope, nil)
+	defer it.Close()
+
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return errors.Wrap(err, "failed to read value")
+		}
+		err = ew.write(scope, it.Key(), value)
+		if err != nil {
+			return errors.Wrap(err, "failed to write snapshot entry")
+		}
+	}
+	return nil
+}
+
+func (ew *entryWriter) write(scope store.Scope, key, value []byte) error {
+	var buf bytes.Buffer
+	varint := make([]byte, binary.MaxVarintLen64)
+	buf.WriteByte(byte(scope))
+	n := binary.PutUvarint(varint, uint64(len(key)))
+	buf.Write(varint[:n])
+	buf.Write(key)
+	n = binary.PutUvarint(varint, uint64(len(value)))
+	buf.Write(varint[:n])
+	buf.Write(value)
+
+	_, err := ew.w.Write([]byte{tagEntry})
+	if err != nil {
+		return err
+	}
+	_, err = ew.w.Write(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, _ = ew.crc.Write(buf.Bytes())
+	ew.count++
+	return nil
+}
+
+func (ew *entryWriter) close() error {
+	trailer := make([]byte, 13)
+	trailer[0] = tagEnd
+	binary.BigEndian.PutUint64(trailer[1:9], ew.count)
+	binary.BigEndian.PutUint32(trailer[9:], ew.crc.Sum32())
+	_, err := ew.w.Write(trailer)
+	if err != nil {
+		return errors.Wrap(err, "failed to write snapshot trailer")
+	}
+	return ew.w.Flush()
+}
+
+type entryReader struct {
+	r     *bufio.Reader
+	crc   hash.Hash32
+	count uint64
+}
+
+// next reads next entry. Returns false when the end of entries is reached.
+func (er *entryReader) next() (store.Key, []byte, bool, error) {
+	tag, err := er.r.ReadByte()
+	if err != nil {
+		return nil, nil, false, errors.Wrap(ErrBadArchive, err.Error())
+	}
+	switch tag {
+	case tagEnd:
+		return nil, nil, false, nil
+	case tagEntry:
+	default:
+		return nil, nil, false, ErrBadArchive
+	}
+
+	scope, err := er.r.ReadByte()
+	if err != nil {
+		return nil, nil, false, errors.Wrap(ErrBadArchive, err.Error())
+	}
+	_, _ = er.crc.Write([]byte{scope})
+	key, err := er.readBytes()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	value, err := er.readBytes()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	er.count++
+	return rawKey{scope: store.Scope(scope), id: key}, value, true, nil
+}
+
+func (er *entryReader) readBytes() ([]byte, error) {
+	length, err := binary.ReadUvarint(er.r)
+	if err != nil {
+		return nil, errors.Wrap(ErrBadArchive, err.Error())
+	}
+	varint := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(varint, length)
+	_, _ = er.crc.Write(varint[:n])
+
+	buf := make([]byte, length)
+	_, err = io.ReadFull(er.r, buf)
+	if err != nil {
+		return nil, errors.Wrap(ErrBadArchive, err.Error())
+	}
+	_, _ = er.crc.Write(buf)
+	return buf, nil
+}
+
+// verify checks trailer of the archive.
+func (er *entryReader) verify() error {
+	trailer := make([]byte, 12)
+	_, err := io.ReadFull(er.r, trailer)
+	if err != nil {
+		return errors.Wrap(ErrBadArchive, err.Error())
+	}
+	if binary.BigEndian.Uint64(trailer[:8]) != er.count {
+		return errors.Wrap(ErrBadArchive, "entries count mismatch")
+	}
+	if binary.BigEndian.Uint32(trailer[8:]) != er.crc.Sum32() {
+		return errors.Wrap(ErrBadArchive, "checksum mismatch")
+	}
+	return nil
+}
+
+func uint32Bytes(v uint32) []byte {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, v)
+	return buf
+}
@@ -0,0 +1,146 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package snapshot
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+type testKey struct {
+	scope store.Scope
+	id    []byte
+}
+
+func (k testKey) Scope() store.Scope {
+	return k.scope
+}
+
+func (k testKey) ID() []byte {
+	return k.id
+}
+
+func fillDB(t *testing.T, db store.DB) map[string][]byte {
+	values := map[string][]byte{}
+	f := fuzz.New().NilChance(0).NumElements(1, 10)
+	for i := 0; i < 100; i++ {
+		var key testKey
+		var value []byte
+		key.scope = store.Scope(rand.Intn(10))
+		f.Fuzz(&key.id)
+		f.Fuzz(&value)
+
+		err := db.Set(key, value)
+		require.NoError(t, err)
+		values[string(append(key.scope.Bytes(), key.id...))] = value
+	}
+	return values
+}
+
+func TestSnapshot_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	source := store.NewMemoryMockDB()
+	expected := fillDB(t, source)
+	pn := gen.PulseNumber()
+
+	var buf bytes.Buffer
+	err := Write(ctx, source.NewSnapshot(), pn, &buf)
+	require.NoError(t, err)
+
+	target := store.NewMemoryMockDB()
+	header, err := Restore(ctx, target, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, Header{Version: Version, PulseNumber: pn}, header)
+
+	for key, value := range expected {
+		restored, err := target.Get(testKey{scope: store.Scope(key[0]), id: []byte(key[1:])})
+		require.NoError(t, err)
+		assert.Equal(t, value, restored)
+	}
+}
+
+func TestSnapshot_RestoreEmptyDB(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	pn := gen.PulseNumber()
+
+	var buf bytes.Buffer
+	err := Write(ctx, store.NewMemoryMockDB().NewSnapshot(), pn, &buf)
+	require.NoError(t, err)
+
+	header, err := Restore(ctx, store.NewMemoryMockDB(), &buf)
+	require.NoError(t, err)
+	assert.Equal(t, pn, header.PulseNumber)
+}
+
+func TestSnapshot_RestoreErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	source := store.NewMemoryMockDB()
+	fillDB(t, source)
+	var buf bytes.Buffer
+	err := Write(ctx, source.NewSnapshot(), gen.PulseNumber(), &buf)
+	require.NoError(t, err)
+	archive := buf.Bytes()
+
+	t.Run("not empty storage", func(t *testing.T) {
+		t.Parallel()
+
+		db := store.NewMemoryMockDB()
+		fillDB(t, db)
+		_, err := Restore(ctx, db, bytes.NewReader(archive))
+		assert.Equal(t, ErrNotEmpty, err)
+	})
+
+	t.Run("bad magic", func(t *testing.T) {
+		t.Parallel()
+
+		broken := append([]byte{}, archive...)
+		broken[0] = 'X'
+		_, err := Restore(ctx, store.NewMemoryMockDB(), bytes.NewReader(broken))
+		assert.Equal(t, ErrBadArchive, err)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		t.Parallel()
+
+		broken := append([]byte{}, archive...)
+		broken[len(magic)+3] = byte(Version + 1)
+		_, err := Restore(ctx, store.NewMemoryMockDB(), bytes.NewReader(broken))
+		assert.Equal(t, ErrUnsupportedVersion, err)
+	})
+
+	t.Run("truncated archive", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Restore(ctx, store.NewMemoryMockDB(), bytes.NewReader(archive[:len(archive)/2]))
+		assert.Error(t, err)
+	})
+}
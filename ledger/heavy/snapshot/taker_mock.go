@@ -0,0 +1,267 @@
+package snapshot
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Taker" can be found in github.com/insolar/insolar/ledger/heavy/snapshot
+*/
+import (
+	context "context"
+	io "io"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//TakerMock implements github.com/insolar/insolar/ledger/heavy/snapshot.Taker
+type TakerMock struct {
+	t minimock.Tester
+
+	SnapshotFunc       func(p context.Context, p1 io.Writer) (r insolar.PulseNumber, r1 error)
+	SnapshotCounter    uint64
+	SnapshotPreCounter uint64
+	SnapshotMock       mTakerMockSnapshot
+}
+
+//NewTakerMock returns a mock for github.com/insolar/insolar/ledger/heavy/snapshot.Taker
+func NewTakerMock(t minimock.Tester) *TakerMock {
+	m := &TakerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SnapshotMock = mTakerMockSnapshot{mock: m}
+
+	return m
+}
+
+type mTakerMockSnapshot struct {
+	mock              *TakerMock
+	mainExpectation   *TakerMockSnapshotExpectation
+	expectationSeries []*TakerMockSnapshotExpectation
+}
+
+type TakerMockSnapshotExpectation struct {
+	input  *TakerMockSnapshotInput
+	result *TakerMockSnapshotResult
+}
+
+type TakerMockSnapshotInput struct {
+	p  context.Context
+	p1 io.Writer
+}
+
+type TakerMockSnapshotResult struct {
+	r  insolar.PulseNumber
+	r1 error
+}
+
+//Expect specifies that invocation of Taker.Snapshot is expected from 1 to Infinity times
+func (m *mTakerMockSnapshot) Expect(p context.Context, p1 io.Writer) *mTakerMockSnapshot {
+	m.mock.SnapshotFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TakerMockSnapshotExpectation{}
+	}
+	m.mainExpectation.input = &TakerMockSnapshotInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Taker.Snapshot
+func (m *mTakerMockSnapshot) Return(r insolar.PulseNumber, r1 error) *TakerMock {
+	m.mock.SnapshotFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TakerMockSnapshotExpectation{}
+	}
+	m.mainExpectation.result = &TakerMockSnapshotResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Taker.Snapshot is expected once
+func (m *mTakerMockSnapshot) ExpectOnce(p context.Context, p1 io.Writer) *TakerMockSnapshotExpectation {
+	m.mock.SnapshotFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TakerMockSnapshotExpectation{}
+	expectation.input = &TakerMockSnapshotInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TakerMockSnapshotExpectation) Return(r insolar.PulseNumber, r1 error) {
+	e.result = &TakerMockSnapshotResult{r, r1}
+}
+
+//Set uses given function f as a mock of Taker.Snapshot method
+func (m *mTakerMockSnapshot) Set(f func(p context.Context, p1 io.Writer) (r insolar.PulseNumber, r1 error)) *TakerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SnapshotFunc = f
+	return m.mock
+}
+
+//Snapshot implements github.com/insolar/insolar/ledger/heavy/snapshot.Taker interface
+func (m *TakerMock) Snapshot(p context.Context, p1 io.Writer) (r insolar.PulseNumber, r1 error) {
+	counter := atomic.AddUint64(&m.SnapshotPreCounter, 1)
+	defer atomic.AddUint64(&m.SnapshotCounter, 1)
+
+	if len(m.SnapshotMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SnapshotMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TakerMock.Snapshot. %v %v", p, p1)
+			return
+		}
+
+		input := m.SnapshotMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TakerMockSnapshotInput{p, p1}, "Taker.Snapshot got unexpected parameters")
+
+		result := m.SnapshotMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TakerMock.Snapshot")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.SnapshotMock.mainExpectation != nil {
+
+		input := m.SnapshotMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TakerMockSnapshotInput{p, p1}, "Taker.Snapshot got unexpected parameters")
+		}
+
+		result := m.SnapshotMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TakerMock.Snapshot")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.SnapshotFunc == nil {
+		m.t.Fatalf("Unexpected call to TakerMock.Snapshot. %v %v", p, p1)
+		return
+	}
+
+	return m.SnapshotFunc(p, p1)
+}
+
+//SnapshotMinimockCounter returns a count of TakerMock.SnapshotFunc invocations
+func (m *TakerMock) SnapshotMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SnapshotCounter)
+}
+
+//SnapshotMinimockPreCounter returns the value of TakerMock.Snapshot invocations
+func (m *TakerMock) SnapshotMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SnapshotPreCounter)
+}
+
+//SnapshotFinished returns true if mock invocations count is ok
+func (m *TakerMock) SnapshotFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SnapshotMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SnapshotCounter) == uint64(len(m.SnapshotMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SnapshotMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SnapshotCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SnapshotFunc != nil {
+		return atomic.LoadUint64(&m.SnapshotCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TakerMock) ValidateCallCounters() {
+
+	if !m.SnapshotFinished() {
+		m.t.Fatal("Expected call to TakerMock.Snapshot")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TakerMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *TakerMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *TakerMock) MinimockFinish() {
+
+	if !m.SnapshotFinished() {
+		m.t.Fatal("Expected call to TakerMock.Snapshot")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *TakerMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *TakerMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.SnapshotFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.SnapshotFinished() {
+				m.t.Error("Expected call to TakerMock.Snapshot")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *TakerMock) AllMocksCalled() bool {
+
+	if !m.SnapshotFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,22 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package verifier checks internal consistency of heavy's storage.
+//
+// Verifier walks pulses from genesis, recalculates ids of records and blobs from their content, checks that index
+// buckets point to stored records and that jet drops form a chain by PrevHash. The first divergence found for every
+// jet is reported.
+package verifier
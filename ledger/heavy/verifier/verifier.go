@@ -0,0 +1,358 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+)
+
+// Divergence describes an inconsistency found in storage.
+type Divergence struct {
+	JetID  insolar.JetID
+	Pulse  insolar.PulseNumber
+	Reason string
+}
+
+func (d Divergence) String() string {
+	return fmt.Sprintf("jet %s, pulse %v: %s", d.JetID.DebugString(), d.Pulse, d.Reason)
+}
+
+// Report holds results of verification.
+type Report struct {
+	Pulses  int
+	Records int
+	Blobs   int
+	Indexes int
+	Drops   int
+	// Divergences holds the first divergence for every jet ordered by pulse.
+	Divergences []Divergence
+
+	jets map[insolar.JetID]struct{}
+}
+
+// OK returns true if no divergences were found.
+func (r *Report) OK() bool {
+	return len(r.Divergences) == 0
+}
+
+func (r *Report) diverge(jetID insolar.JetID, pn insolar.PulseNumber, format string, args ...interface{}) {
+	if _, ok := r.jets[jetID]; ok {
+		return
+	}
+	r.jets[jetID] = struct{}{}
+	r.Divergences = append(r.Divergences, Divergence{
+		JetID:  jetID,
+		Pulse:  pn,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// Verifier checks consistency of heavy's storage.
+type Verifier struct {
+	db     store.DB
+	pulses *pulse.DB
+	pcs    insolar.PlatformCryptographyScheme
+}
+
+// NewVerifier creates a new verifier for provided db. Db is never modified by verifier.
+func NewVerifier(db store.DB, pcs insolar.PlatformCryptographyScheme) *Verifier {
+	return &Verifier{
+		db:     db,
+		pulses: pulse.NewDB(db),
+		pcs:    pcs,
+	}
+}
+
+// Verify walks all pulses from genesis and returns a report. Error is returned only if the walk can't be performed.
+func (v *Verifier) Verify(ctx context.Context) (*Report, error) {
+	report := &Report{jets: map[insolar.JetID]struct{}{}}
+
+	drops, err := v.drops()
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := v.pulses.ForPulseNumber(ctx, insolar.GenesisPulse.PulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch genesis pulse")
+	}
+
+	known := map[insolar.PulseNumber]struct{}{}
+	var prev *insolar.Pulse
+	for {
+		report.Pulses++
+		known[current.PulseNumber] = struct{}{}
+
+		err = v.verifyPulse(ctx, report, current, prev, drops)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to verify pulse %v", current.PulseNumber)
+		}
+
+		next, err := v.pulses.Forwards(ctx, current.PulseNumber, 1)
+		if err == pulse.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch pulse after %v", current.PulseNumber)
+		}
+		if next.PulseNumber <= current.PulseNumber {
+			report.diverge(
+				insolar.ZeroJetID, next.PulseNumber,
+				"pulse chain is not increasing after %v", current.PulseNumber,
+			)
+			break
+		}
+		c := current
+		prev = &c
+		current = next
+	}
+
+	for pn, pulseDrops := range drops {
+		if _, ok := known[pn]; ok || pn < insolar.FirstPulseNumber {
+			continue
+		}
+		for _, dr := range pulseDrops {
+			report.diverge(dr.JetID, pn, "drop for unknown pulse")
+		}
+	}
+
+	return report, nil
+}
+
+func (v *Verifier) verifyPulse(
+	ctx context.Context,
+	report *Report,
+	p insolar.Pulse,
+	prev *insolar.Pulse,
+	drops map[insolar.PulseNumber]map[insolar.JetID]drop.Drop,
+) error {
+	pn := p.PulseNumber
+	jets := map[insolar.JetID][]insolar.ID{}
+
+	err := v.walk(store.ScopeRecord, pn.Bytes(), func(key, value []byte) {
+		report.Records++
+
+		var id insolar.ID
+		copy(id[:], key)
+		rec := record.Material{}
+		err := rec.Unmarshal(value)
+		if err != nil || rec.Virtual == nil {
+			report.diverge(insolar.ZeroJetID, pn, "record %v can't be decoded", id.DebugString())
+			return
+		}
+		jets[rec.JetID] = append(jets[rec.JetID], id)
+		if id == insolar.GenesisRecord.ID() {
+			return
+		}
+		calculated := insolar.NewID(pn, record.HashVirtual(v.pcs.ReferenceHasher(), *rec.Virtual))
+		if *calculated != id {
+			report.diverge(rec.JetID, pn, "record %v has hash %v", id.DebugString(), calculated.DebugString())
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = v.walk(store.ScopeBlob, pn.Bytes(), func(key, value []byte) {
+		report.Blobs++
+
+		var id insolar.ID
+		copy(id[:], key)
+		b, err := blob.Decode(value)
+		if err != nil {
+			report.diverge(insolar.ZeroJetID, pn, "blob %v can't be decoded", id.DebugString())
+			return
+		}
+		calculated := object.CalculateIDForBlob(v.pcs, pn, b.Value)
+		if *calculated != id {
+			report.diverge(b.JetID, pn, "blob %v has hash %v", id.DebugString(), calculated.DebugString())
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	err = v.walk(store.ScopeIndex, pn.Bytes(), func(key, value []byte) {
+		report.Indexes++
+
+		buck := object.IndexBucket{}
+		err := buck.Unmarshal(value)
+		if err != nil {
+			report.diverge(insolar.ZeroJetID, pn, "index bucket can't be decoded")
+			return
+		}
+		lifeline := buck.Lifeline
+		pointers := []struct {
+			name string
+			id   *insolar.ID
+		}{
+			{name: "latest state", id: lifeline.LatestState},
+			{name: "latest approved state", id: lifeline.LatestStateApproved},
+			{name: "child pointer", id: lifeline.ChildPointer},
+		}
+		for _, ptr := range pointers {
+			name, id := ptr.name, ptr.id
+			if id == nil {
+				continue
+			}
+			if id.Pulse() > pn {
+				report.diverge(lifeline.JetID, pn, "index of %v points to %s %v from the future",
+					buck.ObjID.DebugString(), name, id.DebugString())
+				continue
+			}
+			_, err := v.db.Get(recordKey(*id))
+			if err == store.ErrNotFound {
+				report.diverge(lifeline.JetID, pn, "index of %v points to missing %s %v",
+					buck.ObjID.DebugString(), name, id.DebugString())
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	report.Drops += len(drops[pn])
+	if pn == insolar.GenesisPulse.PulseNumber {
+		return nil
+	}
+	for jetID := range jets {
+		if _, ok := drops[pn][jetID]; !ok {
+			report.diverge(jetID, pn, "drop is missing for stored records")
+		}
+	}
+	for _, dr := range sortedDrops(drops[pn]) {
+		calculated := drop.CalculateHash(v.pcs.ReferenceHasher(), dr.PrevHash, jets[dr.JetID])
+		if !bytes.Equal(calculated, dr.Hash) {
+			report.diverge(dr.JetID, pn, "drop hash doesn't match its records")
+			continue
+		}
+		v.verifyDropChain(report, dr, prev, drops)
+	}
+
+	return nil
+}
+
+// verifyDropChain checks that PrevHash of the drop matches Hash of the drop of the same jet in the previous pulse or
+// of the jet it was split from or merged from.
+func (v *Verifier) verifyDropChain(
+	report *Report,
+	dr drop.Drop,
+	prev *insolar.Pulse,
+	drops map[insolar.PulseNumber]map[insolar.JetID]drop.Drop,
+) {
+	if prev == nil {
+		return
+	}
+	prevDrops := drops[prev.PulseNumber]
+
+	var candidates []drop.Drop
+	if d, ok := prevDrops[dr.JetID]; ok {
+		candidates = append(candidates, d)
+	} else if d, ok := prevDrops[jet.Parent(dr.JetID)]; ok {
+		candidates = append(candidates, d)
+	} else {
+		left, right := jet.Siblings(*insolar.NewJetID(dr.JetID.Depth()+1, dr.JetID.Prefix()))
+		for _, child := range []insolar.JetID{left, right} {
+			if d, ok := prevDrops[child]; ok {
+				candidates = append(candidates, d)
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		if len(dr.PrevHash) != 0 {
+			report.diverge(dr.JetID, dr.Pulse, "previous drop is missing")
+		}
+		return
+	}
+	for _, c := range candidates {
+		if bytes.Equal(c.Hash, dr.PrevHash) {
+			return
+		}
+	}
+	report.diverge(dr.JetID, dr.Pulse, "drop PrevHash doesn't match hash of the previous drop")
+}
+
+// drops reads all drops grouped by pulse.
+func (v *Verifier) drops() (map[insolar.PulseNumber]map[insolar.JetID]drop.Drop, error) {
+	res := map[insolar.PulseNumber]map[insolar.JetID]drop.Drop{}
+	var decodeErr error
+	err := v.walk(store.ScopeJetDrop, nil, func(key, value []byte) {
+		dr, err := drop.Decode(value)
+		if err != nil {
+			decodeErr = errors.Wrap(err, "failed to decode drop")
+			return
+		}
+		if res[dr.Pulse] == nil {
+			res[dr.Pulse] = map[insolar.JetID]drop.Drop{}
+		}
+		res[dr.Pulse][dr.JetID] = *dr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, decodeErr
+}
+
+func (v *Verifier) walk(scope store.Scope, prefix []byte, fn func(key, value []byte)) error {
+	it := v.db.NewIterator(scope, prefix)
+	defer it.Close()
+
+	for it.Next() {
+		value, err := it.Value()
+		if err != nil {
+			return errors.Wrap(err, "failed to read value")
+		}
+		fn(it.Key(), value)
+	}
+	return nil
+}
+
+type recordKey insolar.ID
+
+func (k recordKey) Scope() store.Scope {
+	return store.ScopeRecord
+}
+
+func (k recordKey) ID() []byte {
+	return insolar.ID(k).Bytes()
+}
+
+func sortedDrops(drops map[insolar.JetID]drop.Drop) []drop.Drop {
+	res := make([]drop.Drop, 0, len(drops))
+	for _, dr := range drops {
+		res = append(res, dr)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i].JetID[:], res[j].JetID[:]) < 0
+	})
+	return res
+}
@@ -0,0 +1,198 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package verifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/testutils"
+)
+
+type ledgerEnv struct {
+	db      store.DB
+	pcs     insolar.PlatformCryptographyScheme
+	records *object.RecordDB
+	blobs   *blob.DB
+	indexes *object.IndexDB
+	drops   *drop.DB
+	pns     []insolar.PulseNumber
+}
+
+// newLedgerEnv creates a consistent ledger with three pulses and one jet.
+func newLedgerEnv(ctx context.Context, t *testing.T, jetID insolar.JetID) *ledgerEnv {
+	db := store.NewMemoryMockDB()
+	env := &ledgerEnv{
+		db:      db,
+		pcs:     testutils.NewPlatformCryptographyScheme(),
+		records: object.NewRecordDB(db),
+		blobs:   blob.NewDB(db),
+		indexes: object.NewIndexDB(db),
+		drops:   drop.NewDB(db),
+	}
+
+	pulses := pulse.NewDB(db)
+	for i := 0; i < 3; i++ {
+		pn := insolar.GenesisPulse.PulseNumber + insolar.PulseNumber(i*10)
+		require.NoError(t, pulses.Append(ctx, insolar.Pulse{PulseNumber: pn}))
+		env.pns = append(env.pns, pn)
+	}
+
+	var prevHash []byte
+	for _, pn := range env.pns[1:] {
+		id := env.setRecord(ctx, t, pn, jetID)
+		value := []byte{byte(pn)}
+		blobID := object.CalculateIDForBlob(env.pcs, pn, value)
+		require.NoError(t, env.blobs.Set(ctx, *blobID, blob.Blob{Value: value, JetID: jetID}))
+		require.NoError(t, env.indexes.SetBucket(ctx, pn, object.IndexBucket{
+			ObjID:    gen.ID(),
+			Lifeline: object.Lifeline{LatestState: &id, JetID: jetID},
+		}))
+
+		hash := drop.CalculateHash(env.pcs.ReferenceHasher(), prevHash, []insolar.ID{id})
+		require.NoError(t, env.drops.Set(ctx, drop.Drop{Pulse: pn, JetID: jetID, PrevHash: prevHash, Hash: hash}))
+		prevHash = hash
+	}
+	return env
+}
+
+func (e *ledgerEnv) setRecord(ctx context.Context, t *testing.T, pn insolar.PulseNumber, jetID insolar.JetID) insolar.ID {
+	virt := record.Wrap(record.Code{Code: gen.ID()})
+	id := insolar.NewID(pn, record.HashVirtual(e.pcs.ReferenceHasher(), virt))
+	require.NoError(t, e.records.Set(ctx, *id, record.Material{Virtual: &virt, JetID: jetID}))
+	return *id
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	t.Run("consistent ledger", func(t *testing.T) {
+		t.Parallel()
+
+		env := newLedgerEnv(ctx, t, gen.JetID())
+
+		report, err := NewVerifier(env.db, env.pcs).Verify(ctx)
+		require.NoError(t, err)
+		assert.True(t, report.OK(), report.Divergences)
+		assert.Equal(t, 3, report.Pulses)
+		assert.Equal(t, 2, report.Records)
+		assert.Equal(t, 2, report.Blobs)
+		assert.Equal(t, 2, report.Indexes)
+		assert.Equal(t, 2, report.Drops)
+	})
+
+	t.Run("reports wrong record hash", func(t *testing.T) {
+		t.Parallel()
+
+		jetID := gen.JetID()
+		env := newLedgerEnv(ctx, t, jetID)
+		virt := record.Wrap(record.Code{Code: gen.ID()})
+		pn := env.pns[2]
+		require.NoError(t, env.records.Set(ctx, *insolar.NewID(pn, []byte{1, 2, 3}), record.Material{
+			Virtual: &virt,
+			JetID:   jetID,
+		}))
+
+		report, err := NewVerifier(env.db, env.pcs).Verify(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(report.Divergences))
+		assert.Equal(t, jetID, report.Divergences[0].JetID)
+		assert.Equal(t, pn, report.Divergences[0].Pulse)
+	})
+
+	t.Run("reports missing drop", func(t *testing.T) {
+		t.Parallel()
+
+		jetID, otherJetID := gen.JetID(), gen.JetID()
+		gen.UniqueJetIDs(&jetID, &otherJetID)
+		env := newLedgerEnv(ctx, t, jetID)
+		env.setRecord(ctx, t, env.pns[1], otherJetID)
+
+		report, err := NewVerifier(env.db, env.pcs).Verify(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(report.Divergences))
+		assert.Equal(t, otherJetID, report.Divergences[0].JetID)
+		assert.Equal(t, env.pns[1], report.Divergences[0].Pulse)
+	})
+
+	t.Run("reports broken drop chain", func(t *testing.T) {
+		t.Parallel()
+
+		db := store.NewMemoryMockDB()
+		pcs := testutils.NewPlatformCryptographyScheme()
+		pulses := pulse.NewDB(db)
+		drops := drop.NewDB(db)
+		jetID := gen.JetID()
+		first := insolar.GenesisPulse.PulseNumber + 10
+		second := first + 10
+		require.NoError(t, pulses.Append(ctx, *insolar.GenesisPulse))
+		require.NoError(t, pulses.Append(ctx, insolar.Pulse{PulseNumber: first}))
+		require.NoError(t, pulses.Append(ctx, insolar.Pulse{PulseNumber: second}))
+		require.NoError(t, drops.Set(ctx, drop.Drop{
+			Pulse: first,
+			JetID: jetID,
+			Hash:  drop.CalculateHash(pcs.ReferenceHasher(), nil, nil),
+		}))
+		require.NoError(t, drops.Set(ctx, drop.Drop{
+			Pulse:    second,
+			JetID:    jetID,
+			PrevHash: []byte{2},
+			Hash:     drop.CalculateHash(pcs.ReferenceHasher(), []byte{2}, nil),
+		}))
+
+		report, err := NewVerifier(db, pcs).Verify(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(report.Divergences))
+		assert.Equal(t, jetID, report.Divergences[0].JetID)
+		assert.Equal(t, second, report.Divergences[0].Pulse)
+	})
+
+	t.Run("reports drop hash mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		jetID := gen.JetID()
+		env := newLedgerEnv(ctx, t, jetID)
+		env.setRecord(ctx, t, env.pns[1], jetID)
+
+		report, err := NewVerifier(env.db, env.pcs).Verify(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(report.Divergences))
+		assert.Equal(t, jetID, report.Divergences[0].JetID)
+		assert.Equal(t, env.pns[1], report.Divergences[0].Pulse)
+	})
+
+	t.Run("returns error without genesis pulse", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewVerifier(store.NewMemoryMockDB(), testutils.NewPlatformCryptographyScheme()).Verify(ctx)
+		assert.Error(t, err)
+	})
+}
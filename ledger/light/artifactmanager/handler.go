@@ -42,6 +42,7 @@ import (
 	"github.com/insolar/insolar/ledger/light/hot"
 	"github.com/insolar/insolar/ledger/light/proc"
 	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/ledger/object"
 )
 
@@ -63,9 +64,10 @@ type MessageHandler struct {
 
 	IDLocker object.IDLocker `inject:""`
 
-	RecordModifier object.RecordModifier `inject:""`
-	RecordAccessor object.RecordAccessor `inject:""`
-	Nodes          node.Accessor         `inject:""`
+	RecordModifier       object.RecordModifier       `inject:""`
+	RecordAccessor       object.RecordAccessor       `inject:""`
+	RecordResultAccessor object.RecordResultAccessor `inject:""`
+	Nodes                node.Accessor               `inject:""`
 
 	HotDataWaiter hot.JetWaiter   `inject:""`
 	JetReleaser   hot.JetReleaser `inject:""`
@@ -73,6 +75,7 @@ type MessageHandler struct {
 	LifelineIndex         object.LifelineIndex
 	IndexBucketModifier   object.IndexBucketModifier
 	LifelineStateModifier object.LifelineStateModifier
+	Retention             replication.RetentionAccessor
 
 	conf           *configuration.Ledger
 	middleware     *middleware
@@ -114,6 +117,7 @@ func NewMessageHandler(
 			p.Dep.Index = h.LifelineIndex
 			p.Dep.Coordinator = h.JetCoordinator
 			p.Dep.Bus = h.Bus
+			p.Dep.Retention = h.Retention
 		},
 		SetRecord: func(p *proc.SetRecord) {
 			p.Dep.RecentStorageProvider = h.RecentStorageProvider
@@ -133,15 +137,20 @@ func NewMessageHandler(
 			p.Dep.JetUpdater = h.jetTreeUpdater
 			p.Dep.Bus = h.Bus
 			p.Dep.RecordAccessor = h.RecordAccessor
+			p.Dep.Retention = h.Retention
 		},
 		GetCode: func(p *proc.GetCode) {
 			p.Dep.Bus = h.Bus
 			p.Dep.RecordAccessor = h.RecordAccessor
 			p.Dep.Coordinator = h.JetCoordinator
 			p.Dep.BlobAccessor = h.BlobAccessor
+			p.Dep.Retention = h.Retention
 		},
 		GetRequest: func(p *proc.GetRequest) {
 			p.Dep.RecordAccessor = h.RecordAccessor
+			p.Dep.Coordinator = h.JetCoordinator
+			p.Dep.Bus = h.Bus
+			p.Dep.Retention = h.Retention
 		},
 		UpdateObject: func(p *proc.UpdateObject) {
 			p.Dep.RecordModifier = h.RecordModifier
@@ -160,6 +169,7 @@ func NewMessageHandler(
 			p.Dep.RecordAccessor = h.RecordAccessor
 			p.Dep.JetStorage = h.JetStorage
 			p.Dep.JetTreeUpdater = h.jetTreeUpdater
+			p.Dep.Retention = h.Retention
 		},
 		RegisterChild: func(p *proc.RegisterChild) {
 			p.Dep.IDLocker = h.IDLocker
@@ -279,6 +289,14 @@ func (h *MessageHandler) setHandlersForLight(m *middleware) {
 		),
 	)
 
+	h.Bus.MustRegister(
+		insolar.TypeGetResult,
+		BuildMiddleware(
+			h.handleGetResult,
+			instrumentHandler("handleGetResult"),
+		),
+	)
+
 	h.Bus.MustRegister(insolar.TypeValidateRecord, h.handleValidateRecord)
 }
 
@@ -343,6 +361,32 @@ func (h *MessageHandler) handleGetPendingRequestID(ctx context.Context, parcel i
 	return &rep, nil
 }
 
+// handleGetResult replies with result record from local storage. Results are stored by the node, that was executor
+// for the request jet when result was registered, so sender should ask executors of every pulse after the request.
+func (h *MessageHandler) handleGetResult(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetResult)
+
+	id, err := h.RecordResultAccessor.ResultForRequest(ctx, msg.Request)
+	if err == object.ErrNotFound {
+		return &reply.Error{ErrType: reply.ErrNoResult}, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result id")
+	}
+
+	rec, err := h.RecordAccessor.ForID(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch result")
+	}
+
+	data, err := rec.Virtual.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize result")
+	}
+
+	return &reply.Result{ID: id, Record: data}, nil
+}
+
 func (h *MessageHandler) handleValidateRecord(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	return &reply.OK{}, nil
 }
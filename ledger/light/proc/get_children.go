@@ -26,6 +26,7 @@ import (
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/pkg/errors"
 )
@@ -42,6 +43,7 @@ type GetChildren struct {
 		JetStorage             jet.Storage
 		JetTreeUpdater         jet.Fetcher
 		DelegationTokenFactory insolar.DelegationTokenFactory
+		Retention              replication.RetentionAccessor
 	}
 }
 
@@ -88,6 +90,10 @@ func (p *GetChildren) reply(ctx context.Context) bus.Reply {
 	if err != nil && err != pulse.ErrNotFound {
 		return bus.Reply{Err: err}
 	}
+	// Children could be removed by the storage budget before the light chain limit is reached.
+	if !onHeavy && p.Dep.Retention != nil {
+		onHeavy = p.Dep.Retention.IsPruned(currentChild.Pulse())
+	}
 	if onHeavy {
 		node, err := p.Dep.Coordinator.Heavy(ctx, p.parcel.Pulse())
 		if err != nil {
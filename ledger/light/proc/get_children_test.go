@@ -11,6 +11,7 @@ import (
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/testutils"
 	"github.com/stretchr/testify/assert"
@@ -160,3 +161,44 @@ func TestGetChildren_RedirectToHeavy(t *testing.T) {
 	assert.Equal(t, []byte{1, 2, 3}, token.Signature)
 	assert.Equal(t, heavyRef, redirect.GetReceiver())
 }
+
+func TestGetChildren_RedirectsToHeavyWhenPruned(t *testing.T) {
+	msg := message.GetChildren{
+		Parent: *genRandomRef(0),
+	}
+
+	heavyRef := genRandomRef(0)
+	childID := genRandomID(insolar.FirstPulseNumber)
+
+	jc := jet.NewCoordinatorMock(t)
+	jc.HeavyMock.Return(heavyRef, nil)
+	jc.IsBeyondLimitMock.Return(false, nil)
+
+	retention := replication.NewRetentionAccessorMock(t)
+	retention.IsPrunedFunc = func(pn insolar.PulseNumber) bool {
+		require.Equal(t, childID.Pulse(), pn)
+		return true
+	}
+
+	tf := testutils.NewDelegationTokenFactoryMock(t)
+	tf.IssueGetChildrenRedirectMock.Return(&delegationtoken.GetChildrenRedirectToken{Signature: []byte{1, 2, 3}}, nil)
+
+	gc := GetChildren{
+		index: object.Lifeline{ChildPointer: childID},
+		msg:   &msg,
+		parcel: &message.Parcel{
+			Msg:         &msg,
+			Sender:      *genRandomRef(insolar.FirstPulseNumber),
+			PulseNumber: insolar.FirstPulseNumber + 1,
+		},
+	}
+	gc.Dep.Coordinator = jc
+	gc.Dep.DelegationTokenFactory = tf
+	gc.Dep.Retention = retention
+
+	rep := gc.reply(context.TODO())
+	require.NoError(t, rep.Err)
+	redirect, ok := rep.Reply.(*reply.GetChildrenRedirectReply)
+	require.True(t, ok)
+	assert.Equal(t, heavyRef, redirect.GetReceiver())
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/pkg/errors"
 )
@@ -41,6 +42,7 @@ type GetCode struct {
 		RecordAccessor object.RecordAccessor
 		Coordinator    jet.Coordinator
 		BlobAccessor   blob.Accessor
+		Retention      replication.RetentionAccessor
 	}
 }
 
@@ -58,29 +60,13 @@ func (p *GetCode) Proceed(ctx context.Context) error {
 
 func (p *GetCode) reply(ctx context.Context) bus.Reply {
 	codeID := *p.code.Record()
+	// Code could be removed by the storage budget before the light chain limit is reached.
+	if p.Dep.Retention != nil && p.Dep.Retention.IsPruned(codeID.Pulse()) {
+		return p.replyFromHeavy(ctx)
+	}
 	rec, err := p.Dep.RecordAccessor.ForID(ctx, codeID)
 	if err == object.ErrNotFound {
-		heavy, err := p.Dep.Coordinator.Heavy(ctx, flow.Pulse(ctx))
-		if err != nil {
-			return bus.Reply{Err: errors.Wrap(err, "failed to calculate heavy")}
-		}
-		genericReply, err := p.Dep.Bus.Send(ctx, &message.GetCode{
-			Code: p.code,
-		}, &insolar.MessageSendOptions{
-			Receiver: heavy,
-		})
-		if err != nil {
-			return bus.Reply{Err: errors.Wrap(err, "failed to fetch code from heavy")}
-		}
-		rep, ok := genericReply.(*reply.Code)
-		if !ok {
-			err := fmt.Errorf(
-				"failed to fetch code from heavy: unexpected reply type %T",
-				genericReply,
-			)
-			return bus.Reply{Err: err}
-		}
-		return bus.Reply{Reply: rep}
+		return p.replyFromHeavy(ctx)
 	}
 	if err != nil {
 		return bus.Reply{Err: errors.Wrap(err, "failed to fetch code")}
@@ -94,6 +80,9 @@ func (p *GetCode) reply(ctx context.Context) bus.Reply {
 	}
 
 	code, err := p.Dep.BlobAccessor.ForID(ctx, codeRec.Code)
+	if err == blob.ErrNotFound {
+		return p.replyFromHeavy(ctx)
+	}
 	if err != nil {
 		return bus.Reply{Err: errors.Wrap(err, "failed to fetch code blob")}
 	}
@@ -104,3 +93,27 @@ func (p *GetCode) reply(ctx context.Context) bus.Reply {
 	}
 	return bus.Reply{Reply: rep}
 }
+
+func (p *GetCode) replyFromHeavy(ctx context.Context) bus.Reply {
+	heavy, err := p.Dep.Coordinator.Heavy(ctx, flow.Pulse(ctx))
+	if err != nil {
+		return bus.Reply{Err: errors.Wrap(err, "failed to calculate heavy")}
+	}
+	genericReply, err := p.Dep.Bus.Send(ctx, &message.GetCode{
+		Code: p.code,
+	}, &insolar.MessageSendOptions{
+		Receiver: heavy,
+	})
+	if err != nil {
+		return bus.Reply{Err: errors.Wrap(err, "failed to fetch code from heavy")}
+	}
+	rep, ok := genericReply.(*reply.Code)
+	if !ok {
+		err := fmt.Errorf(
+			"failed to fetch code from heavy: unexpected reply type %T",
+			genericReply,
+		)
+		return bus.Reply{Err: err}
+	}
+	return bus.Reply{Reply: rep}
+}
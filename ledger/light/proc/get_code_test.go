@@ -24,12 +24,16 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/flow/bus"
 	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/light/proc"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/testutils"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,6 +73,42 @@ func TestGetCode_Proceed(t *testing.T) {
 	}}, rep)
 }
 
+func TestGetCode_Proceed_Pruned(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	a := require.New(t)
+	ctx := inslogger.TestContext(t)
+
+	replyTo := make(chan bus.Reply, 1)
+	codeRef := gen.Reference()
+	heavy := gen.Reference()
+	expected := &reply.Code{Code: []byte{1, 2, 3}, MachineType: insolar.MachineTypeBuiltin}
+	getCode := proc.NewGetCode(codeRef, replyTo)
+
+	retention := replication.NewRetentionAccessorMock(mc)
+	retention.IsPrunedFunc = func(pn insolar.PulseNumber) bool {
+		a.Equal(codeRef.Record().Pulse(), pn)
+		return true
+	}
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.HeavyMock.Return(&heavy, nil)
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(c context.Context, msg insolar.Message, opts *insolar.MessageSendOptions) (insolar.Reply, error) {
+		a.Equal(&message.GetCode{Code: codeRef}, msg)
+		a.Equal(heavy, *opts.Receiver)
+		return expected, nil
+	}
+	getCode.Dep.Retention = retention
+	getCode.Dep.Coordinator = coordinator
+	getCode.Dep.Bus = mb
+
+	err := getCode.Proceed(ctx)
+	a.NoError(err)
+
+	rep := <-replyTo
+	a.Equal(bus.Reply{Reply: expected}, rep)
+}
+
 func codeRecord(codeID insolar.ID) record.Material {
 	return record.Material{
 		Virtual: &record.Virtual{
@@ -27,6 +27,7 @@ import (
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/pkg/errors"
 )
@@ -47,6 +48,7 @@ type GetIndex struct {
 		Locker      object.IDLocker
 		Coordinator jet.Coordinator
 		Bus         insolar.MessageBus
+		Retention   replication.RetentionAccessor
 	}
 }
 
@@ -74,6 +76,11 @@ func (p *GetIndex) process(ctx context.Context) error {
 	p.Dep.Locker.Lock(&objectID)
 	defer p.Dep.Locker.Unlock(&objectID)
 
+	// Data of the pulse could be removed by the storage budget before the light chain limit is reached.
+	if p.Dep.Retention != nil && p.Dep.Retention.IsPruned(p.pn) {
+		return p.fetchFromHeavy(ctx)
+	}
+
 	idx, err := p.Dep.Index.ForID(ctx, p.pn, objectID)
 	if err == nil {
 		p.Result.Index = idx
@@ -90,6 +97,13 @@ func (p *GetIndex) process(ctx context.Context) error {
 	}
 
 	logger.Debug("failed to fetch index (fetching from heavy)")
+	return p.fetchFromHeavy(ctx)
+}
+
+func (p *GetIndex) fetchFromHeavy(ctx context.Context) error {
+	objectID := *p.object.Record()
+	logger := inslogger.FromContext(ctx)
+
 	heavy, err := p.Dep.Coordinator.Heavy(ctx, flow.Pulse(ctx))
 	if err != nil {
 		return errors.Wrap(err, "failed to calculate heavy")
@@ -18,11 +18,16 @@ package proc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow"
 	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/pkg/errors"
 )
@@ -33,6 +38,9 @@ type GetRequest struct {
 
 	Dep struct {
 		RecordAccessor object.RecordAccessor
+		Coordinator    jet.Coordinator
+		Bus            insolar.MessageBus
+		Retention      replication.RetentionAccessor
 	}
 }
 
@@ -44,6 +52,11 @@ func NewGetRequest(request insolar.ID, replyTo chan<- bus.Reply) *GetRequest {
 }
 
 func (p *GetRequest) Proceed(ctx context.Context) error {
+	// Request could be removed by the storage budget before the light chain limit is reached.
+	if p.Dep.Retention != nil && p.Dep.Retention.IsPruned(p.request.Pulse()) {
+		return p.fetchFromHeavy(ctx)
+	}
+
 	rec, err := p.Dep.RecordAccessor.ForID(ctx, p.request)
 	if err != nil {
 		return errors.Wrap(err, "failed to fetch request")
@@ -69,3 +82,25 @@ func (p *GetRequest) Proceed(ctx context.Context) error {
 	p.replyTo <- bus.Reply{Reply: rep}
 	return nil
 }
+
+func (p *GetRequest) fetchFromHeavy(ctx context.Context) error {
+	heavy, err := p.Dep.Coordinator.Heavy(ctx, flow.Pulse(ctx))
+	if err != nil {
+		return errors.Wrap(err, "failed to calculate heavy")
+	}
+	genericReply, err := p.Dep.Bus.Send(ctx, &message.GetRequest{
+		Request: p.request,
+	}, &insolar.MessageSendOptions{
+		Receiver: heavy,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch request from heavy")
+	}
+	rep, ok := genericReply.(*reply.Request)
+	if !ok {
+		return fmt.Errorf("failed to fetch request from heavy: unexpected reply type %T", genericReply)
+	}
+
+	p.replyTo <- bus.Reply{Reply: rep}
+	return nil
+}
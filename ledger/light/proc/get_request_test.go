@@ -0,0 +1,71 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package proc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/flow/bus"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/light/proc"
+	"github.com/insolar/insolar/ledger/light/replication"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetRequest_Proceed_Pruned(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+	a := require.New(t)
+	ctx := inslogger.TestContext(t)
+
+	replyTo := make(chan bus.Reply, 1)
+	requestID := gen.ID()
+	heavy := gen.Reference()
+	expected := &reply.Request{ID: requestID, Record: []byte{1, 2, 3}}
+	getRequest := proc.NewGetRequest(requestID, replyTo)
+
+	retention := replication.NewRetentionAccessorMock(mc)
+	retention.IsPrunedFunc = func(pn insolar.PulseNumber) bool {
+		a.Equal(requestID.Pulse(), pn)
+		return true
+	}
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.HeavyMock.Return(&heavy, nil)
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(c context.Context, msg insolar.Message, opts *insolar.MessageSendOptions) (insolar.Reply, error) {
+		a.Equal(&message.GetRequest{Request: requestID}, msg)
+		a.Equal(heavy, *opts.Receiver)
+		return expected, nil
+	}
+	getRequest.Dep.Retention = retention
+	getRequest.Dep.Coordinator = coordinator
+	getRequest.Dep.Bus = mb
+
+	err := getRequest.Proceed(ctx)
+	a.NoError(err)
+
+	rep := <-replyTo
+	a.Equal(bus.Reply{Reply: expected}, rep)
+}
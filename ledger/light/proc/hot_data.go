@@ -79,6 +79,22 @@ func (p *HotData) process(ctx context.Context) error {
 		return errors.Wrapf(err, "[jet]: drop error (pulse: %v)", p.msg.Drop.Pulse)
 	}
 
+	if p.msg.SiblingDrop != nil {
+		err = p.Dep.DropModifier.Set(ctx, *p.msg.SiblingDrop)
+		if err == drop.ErrOverride {
+			err = nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "[jet]: sibling drop error (pulse: %v)", p.msg.SiblingDrop.Pulse)
+		}
+
+		// Previous executor merged two sibling jets, so their parent becomes a leaf again.
+		err = p.Dep.JetStorage.Merge(ctx, p.msg.PulseNumber, jetID)
+		if err != nil {
+			return errors.Wrapf(err, "[jet]: failed to merge jet %v (pulse: %v)", jetID.DebugString(), p.msg.PulseNumber)
+		}
+	}
+
 	pendingStorage := p.Dep.RecentStorageProvider.GetPendingStorage(ctx, insolar.ID(jetID))
 	logger.Debugf("received %d pending requests", len(p.msg.PendingRequests))
 
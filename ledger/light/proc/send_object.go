@@ -30,6 +30,7 @@ import (
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/light/replication"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/messagebus"
 	"github.com/pkg/errors"
@@ -47,6 +48,7 @@ type SendObject struct {
 		RecordAccessor object.RecordAccessor
 		Blobs          blob.Storage
 		Bus            insolar.MessageBus
+		Retention      replication.RetentionAccessor
 	}
 }
 
@@ -88,6 +90,10 @@ func (p *SendObject) handle(
 	if err != nil && err != pulse.ErrNotFound {
 		return nil, err
 	}
+	// State could be removed by the storage budget before the light chain limit is reached.
+	if !onHeavy && p.Dep.Retention != nil {
+		onHeavy = p.Dep.Retention.IsPruned(stateID.Pulse())
+	}
 	if onHeavy {
 		hNode, err := p.Dep.Coordinator.Heavy(ctx, parcel.Pulse())
 		if err != nil {
@@ -114,6 +120,8 @@ func (p *SendObject) handle(
 			ChildPointer: p.index.ChildPointer,
 			Parent:       p.index.Parent,
 			Memory:       obj.Memory,
+			PrevState:    obj.PrevState,
+			Request:      obj.Request,
 		}, nil
 	}
 
@@ -159,6 +167,8 @@ func (p *SendObject) handle(
 			ChildPointer: p.index.ChildPointer,
 			Parent:       p.index.Parent,
 			Memory:       obj.Memory,
+			PrevState:    obj.PrevState,
+			Request:      obj.Request,
 		}, nil
 	}
 	if err != nil {
@@ -187,6 +197,8 @@ func (p *SendObject) handle(
 		IsPrototype:  state.GetIsPrototype(),
 		ChildPointer: childPointer,
 		Parent:       p.index.Parent,
+		PrevState:    state.PrevStateID(),
+		Request:      state.GetRequest(),
 	}
 
 	if state.GetMemory() != nil && state.GetMemory().NotEmpty() {
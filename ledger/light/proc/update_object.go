@@ -185,6 +185,8 @@ func (p *UpdateObject) handle(ctx context.Context) bus.Reply {
 		IsPrototype:  state.GetIsPrototype(),
 		ChildPointer: idx.ChildPointer,
 		Parent:       idx.Parent,
+		PrevState:    state.PrevStateID(),
+		Request:      state.GetRequest(),
 	}
 	return bus.Reply{Reply: &rep}
 }
@@ -19,7 +19,6 @@ package pulsemanager
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -33,6 +32,7 @@ import (
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/node"
 	"github.com/insolar/insolar/insolar/pulse"
+	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/instrumentation/instracer"
@@ -103,14 +103,19 @@ type PulseManager struct {
 type jetInfo struct {
 	id       insolar.JetID
 	mineNext bool
-	left     *jetInfo
-	right    *jetInfo
+	// size is a size of data, that the jet has collected during the pulse.
+	size  uint64
+	left  *jetInfo
+	right *jetInfo
+	// merged is set when left and right jets were merged into the jet.
+	merged bool
 }
 
 // Just store ledger configuration in PM. This is not required.
 type pmOptions struct {
 	// enableSync            bool
 	splitThreshold   uint64
+	mergeThreshold   uint64
 	storeLightPulses int
 	// heavySyncMessageLimit int
 	lightChainLimit int
@@ -134,6 +139,7 @@ func NewPulseManager(
 		currentPulse: *insolar.GenesisPulse,
 		options: pmOptions{
 			splitThreshold:   pmconf.SplitThreshold,
+			mergeThreshold:   pmconf.MergeThreshold,
 			storeLightPulses: conf.LightChainLimit,
 			lightChainLimit:  conf.LightChainLimit,
 		},
@@ -164,11 +170,6 @@ func (m *PulseManager) processEndPulse(
 		info := i
 
 		g.Go(func() error {
-			drop, dropSerialized, _, err := m.createDrop(ctx, insolar.ID(info.id), prevPulseNumber, currentPulse.PulseNumber)
-			if err != nil {
-				return errors.Wrapf(err, "create drop on pulse %v failed", currentPulse.PulseNumber)
-			}
-
 			sender := func(msg message.HotData, jetID insolar.JetID) {
 				ctx, span := instracer.StartSpan(ctx, "pulse.send_hot")
 				defer span.End()
@@ -187,6 +188,29 @@ func (m *PulseManager) processEndPulse(
 				}
 			}
 
+			if info.merged {
+				msg, err := m.getMergedHotData(
+					ctx, info, prevPulseNumber, currentPulse.PulseNumber, newPulse.PulseNumber,
+				)
+				if err != nil {
+					return errors.Wrapf(err, "getMergedHotData failed for jet id %v", info.id)
+				}
+				// Merge happened.
+				go sender(*msg, info.id)
+
+				m.RecentStorageProvider.RemovePendingStorage(ctx, insolar.ID(info.left.id))
+				m.RecentStorageProvider.RemovePendingStorage(ctx, insolar.ID(info.right.id))
+
+				return nil
+			}
+
+			drop, dropSerialized, _, err := m.createDrop(
+				ctx, insolar.ID(info.id), prevPulseNumber, currentPulse.PulseNumber, info.size,
+			)
+			if err != nil {
+				return errors.Wrapf(err, "create drop on pulse %v failed", currentPulse.PulseNumber)
+			}
+
 			if info.left == nil && info.right == nil {
 				msg, err := m.getExecutorHotData(
 					ctx, info.id, currentPulse.PulseNumber, newPulse.PulseNumber, drop, dropSerialized,
@@ -225,15 +249,29 @@ func (m *PulseManager) createDrop(
 	ctx context.Context,
 	jetID insolar.ID,
 	prevPulse, currentPulse insolar.PulseNumber,
+	size uint64,
 ) (
 	block *drop.Drop,
 	dropSerialized []byte,
 	messages [][]byte,
 	err error,
 ) {
+	var records []insolar.ID
+	for _, rec := range m.RecSyncAccessor.ForPulse(ctx, insolar.JetID(jetID), currentPulse) {
+		if rec.Virtual == nil {
+			continue
+		}
+		hash := record.HashVirtual(m.PlatformCryptographyScheme.ReferenceHasher(), *rec.Virtual)
+		records = append(records, *insolar.NewID(currentPulse, hash))
+	}
+	prevHash := m.prevDropHash(ctx, insolar.JetID(jetID), prevPulse)
+
 	block = &drop.Drop{
-		Pulse: currentPulse,
-		JetID: insolar.JetID(jetID),
+		Pulse:    currentPulse,
+		PrevHash: prevHash,
+		Hash:     drop.CalculateHash(m.PlatformCryptographyScheme.ReferenceHasher(), prevHash, records),
+		JetID:    insolar.JetID(jetID),
+		Size:     size,
 	}
 
 	err = m.DropModifier.Set(ctx, *block)
@@ -245,6 +283,19 @@ func (m *PulseManager) createDrop(
 	return
 }
 
+// prevDropHash returns hash of the drop, that precedes the jet drop in the chain. It's the drop of the same jet, of the
+// jet it was split from or of the left jet it was merged from. Empty hash is returned if the drop is unknown.
+func (m *PulseManager) prevDropHash(ctx context.Context, jetID insolar.JetID, prevPulse insolar.PulseNumber) []byte {
+	left, _ := jet.Siblings(*insolar.NewJetID(jetID.Depth()+1, jetID.Prefix()))
+	for _, candidate := range []insolar.JetID{jetID, jet.Parent(jetID), left} {
+		dr, err := m.DropAccessor.ForPulse(ctx, candidate, prevPulse)
+		if err == nil {
+			return dr.Hash
+		}
+	}
+	return nil
+}
+
 func (m *PulseManager) getExecutorHotData(
 	ctx context.Context,
 	jetID insolar.JetID,
@@ -308,7 +359,83 @@ func (m *PulseManager) getExecutorHotData(
 	return msg, nil
 }
 
-var splitCount = 5
+// getMergedHotData creates drops for both merged jets and combines their hot data into a single message
+// for the parent jet.
+func (m *PulseManager) getMergedHotData(
+	ctx context.Context,
+	info jetInfo,
+	prevPN insolar.PulseNumber,
+	currentPN insolar.PulseNumber,
+	newPulsePN insolar.PulseNumber,
+) (*message.HotData, error) {
+	leftDrop, leftDropSerialized, _, err := m.createDrop(ctx, insolar.ID(info.left.id), prevPN, currentPN, info.left.size)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create drop on pulse %v failed", currentPN)
+	}
+	rightDrop, rightDropSerialized, _, err := m.createDrop(ctx, insolar.ID(info.right.id), prevPN, currentPN, info.right.size)
+	if err != nil {
+		return nil, errors.Wrapf(err, "create drop on pulse %v failed", currentPN)
+	}
+
+	msg, err := m.getExecutorHotData(ctx, info.left.id, currentPN, newPulsePN, leftDrop, leftDropSerialized)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getExecutorData failed for jet id %v", info.left.id)
+	}
+	rightMsg, err := m.getExecutorHotData(ctx, info.right.id, currentPN, newPulsePN, rightDrop, rightDropSerialized)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getExecutorData failed for jet id %v", info.right.id)
+	}
+
+	msg.HotIndexes = append(msg.HotIndexes, rightMsg.HotIndexes...)
+	for objID, objContext := range rightMsg.PendingRequests {
+		msg.PendingRequests[objID] = objContext
+	}
+	msg.SiblingDrop = &rightMsg.Drop
+
+	return msg, nil
+}
+
+// dropSize returns physical size of records and blobs saved for the jet during the pulse.
+func (m *PulseManager) dropSize(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) uint64 {
+	var size uint64
+	for _, rec := range m.RecSyncAccessor.ForPulse(ctx, jetID, pn) {
+		size += uint64(rec.Size())
+	}
+	for _, b := range m.BlobSyncAccessor.ForPulse(ctx, jetID, pn) {
+		size += uint64(len(b.Value))
+	}
+	return size
+}
+
+// shouldSplit checks if the jet has collected more data during the pulse than split threshold allows.
+func (m *PulseManager) shouldSplit(jetID insolar.JetID, size uint64) bool {
+	if jetID.Depth() >= insolar.JetMaximumDepth {
+		return false
+	}
+	return size > m.options.splitThreshold
+}
+
+// shouldMerge checks if the jet and its sibling both were processed by current node during the pulse
+// and have collected less data than merge threshold allows. Mine holds sizes of jets processed by current node.
+// Returns both siblings, the left one goes first.
+func (m *PulseManager) shouldMerge(
+	jetID insolar.JetID,
+	mine map[insolar.JetID]uint64,
+) (insolar.JetID, insolar.JetID, bool) {
+	if jetID.Depth() == 0 {
+		return insolar.ZeroJetID, insolar.ZeroJetID, false
+	}
+
+	left, right := jet.Siblings(jetID)
+	if _, ok := mine[left]; !ok {
+		return insolar.ZeroJetID, insolar.ZeroJetID, false
+	}
+	if _, ok := mine[right]; !ok {
+		return insolar.ZeroJetID, insolar.ZeroJetID, false
+	}
+
+	return left, right, mine[left]+mine[right] < m.options.mergeThreshold
+}
 
 func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse insolar.PulseNumber) ([]jetInfo, error) {
 	ctx, span := instracer.StartSpan(ctx, "jets.process")
@@ -327,8 +454,9 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 		"current_pulse": currentPulse,
 		"new_pulse":     newPulse,
 	})
-	indexToSplit := rand.Intn(len(jetIDs))
-	for i, jetID := range jetIDs {
+	// Both sibling jets should be processed by current node to be merged, so collect them with their sizes first.
+	mine := map[insolar.JetID]uint64{}
+	for _, jetID := range jetIDs {
 		wasExecutor := false
 		executor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(jetID), currentPulse)
 		if err != nil && err != node.ErrNoNodes {
@@ -337,6 +465,14 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 		if err == nil {
 			wasExecutor = *executor == me
 		}
+		if wasExecutor {
+			mine[jetID] = m.dropSize(ctx, jetID, currentPulse)
+		}
+	}
+
+	merged := map[insolar.JetID]struct{}{}
+	for _, jetID := range jetIDs {
+		size, wasExecutor := mine[jetID]
 
 		logger = logger.WithField("jetid", jetID.DebugString())
 		inslogger.SetLogger(ctx, logger)
@@ -344,11 +480,46 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 		if !wasExecutor {
 			continue
 		}
+		if _, ok := merged[jetID]; ok {
+			continue
+		}
 
-		info := jetInfo{id: jetID}
-		if indexToSplit == i && splitCount > 0 {
-			splitCount--
+		info := jetInfo{id: jetID, size: size}
+		if leftJetID, rightJetID, ok := m.shouldMerge(jetID, mine); ok {
+			parentJetID := jet.Parent(jetID)
+			err := m.JetModifier.Merge(ctx, newPulse, parentJetID)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to merge jet tree")
+			}
+
+			// Set actual because we are the last executor for both merged jets.
+			m.JetModifier.Update(ctx, newPulse, true, parentJetID)
+
+			merged[leftJetID] = struct{}{}
+			merged[rightJetID] = struct{}{}
+			info = jetInfo{
+				id:     parentJetID,
+				left:   &jetInfo{id: leftJetID, size: mine[leftJetID]},
+				right:  &jetInfo{id: rightJetID, size: mine[rightJetID]},
+				merged: true,
+			}
+			nextExecutor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(parentJetID), newPulse)
+			if err != nil {
+				return nil, err
+			}
+			if *nextExecutor == me {
+				info.mineNext = true
+				m.RecentStorageProvider.MergePendingStorage(
+					ctx, insolar.ID(leftJetID), insolar.ID(rightJetID), insolar.ID(parentJetID),
+				)
+			}
 
+			logger.WithFields(map[string]interface{}{
+				"left_child":  leftJetID.DebugString(),
+				"right_child": rightJetID.DebugString(),
+				"parent":      parentJetID.DebugString(),
+			}).Info("jet merge performed")
+		} else if m.shouldSplit(jetID, size) {
 			leftJetID, rightJetID, err := m.JetModifier.Split(
 				ctx,
 				newPulse,
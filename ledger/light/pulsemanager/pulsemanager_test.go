@@ -0,0 +1,190 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsemanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/blob"
+	"github.com/insolar/insolar/ledger/light/recentstorage"
+	"github.com/insolar/insolar/ledger/object"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/network"
+)
+
+// newJetsPulseManager returns pulse manager, that was the only light executor for all jets. Sizes are sizes of data
+// collected by jets during the pulse.
+func newJetsPulseManager(
+	mc *minimock.Controller, jets *jet.Store, sizes map[insolar.JetID]uint64,
+) *PulseManager {
+	me := testutils.RandomRef()
+
+	origin := network.NewNetworkNodeMock(mc)
+	origin.RoleMock.Return(insolar.StaticRoleLightMaterial)
+	nodeNet := network.NewNodeNetworkMock(mc)
+	nodeNet.GetOriginMock.Return(origin)
+
+	coordinator := jet.NewCoordinatorMock(mc)
+	coordinator.MeMock.Return(me)
+	coordinator.LightExecutorForJetFunc = func(
+		context.Context, insolar.ID, insolar.PulseNumber,
+	) (*insolar.Reference, error) {
+		return &me, nil
+	}
+
+	records := object.NewRecordCollectionAccessorMock(mc)
+	records.ForPulseFunc = func(context.Context, insolar.JetID, insolar.PulseNumber) []record.Material {
+		return nil
+	}
+	blobs := blob.NewCollectionAccessorMock(mc)
+	blobs.ForPulseFunc = func(_ context.Context, jetID insolar.JetID, _ insolar.PulseNumber) []blob.Blob {
+		return []blob.Blob{{Value: make([]byte, sizes[jetID]), JetID: jetID}}
+	}
+
+	provider := recentstorage.NewProviderMock(mc)
+	provider.ClonePendingStorageFunc = func(context.Context, insolar.ID, insolar.ID) {}
+	provider.MergePendingStorageFunc = func(context.Context, insolar.ID, insolar.ID, insolar.ID) {}
+
+	return &PulseManager{
+		NodeNet:               nodeNet,
+		JetCoordinator:        coordinator,
+		JetAccessor:           jets,
+		JetModifier:           jets,
+		RecSyncAccessor:       records,
+		BlobSyncAccessor:      blobs,
+		RecentStorageProvider: provider,
+		options: pmOptions{
+			splitThreshold: 100,
+			mergeThreshold: 10,
+		},
+	}
+}
+
+func TestPulseManager_shouldSplit(t *testing.T) {
+	m := &PulseManager{options: pmOptions{splitThreshold: 100}}
+
+	require.False(t, m.shouldSplit(*insolar.NewJetID(1, nil), 100))
+	require.True(t, m.shouldSplit(*insolar.NewJetID(1, nil), 101))
+	require.False(t, m.shouldSplit(*insolar.NewJetID(insolar.JetMaximumDepth, nil), 1000), "deepest jet can't be split")
+}
+
+func TestPulseManager_processJets_Split(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	currentPN := insolar.PulseNumber(insolar.FirstPulseNumber + 1)
+	newPN := currentPN + 1
+
+	jets := jet.NewStore()
+	root := insolar.ZeroJetID
+	jets.Update(ctx, currentPN, true, root)
+
+	m := newJetsPulseManager(mc, jets, map[insolar.JetID]uint64{root: 101})
+
+	infos, err := m.processJets(ctx, currentPN, newPN)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+
+	info := infos[0]
+	require.Equal(t, root, info.id)
+	require.Equal(t, uint64(101), info.size, "drop size should be computed by processJets")
+	require.NotNil(t, info.left)
+	require.NotNil(t, info.right)
+	left, right := jet.Siblings(info.left.id)
+	require.Equal(t, root, jet.Parent(left))
+	require.Equal(t, left, info.left.id)
+	require.Equal(t, right, info.right.id)
+	require.ElementsMatch(t, []insolar.JetID{left, right}, jets.All(ctx, newPN))
+}
+
+func TestPulseManager_processJets_NoSplit(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	currentPN := insolar.PulseNumber(insolar.FirstPulseNumber + 1)
+	newPN := currentPN + 1
+
+	jets := jet.NewStore()
+	root := insolar.ZeroJetID
+	jets.Update(ctx, currentPN, true, root)
+
+	m := newJetsPulseManager(mc, jets, map[insolar.JetID]uint64{root: 100})
+
+	infos, err := m.processJets(ctx, currentPN, newPN)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Nil(t, infos[0].left)
+	require.Nil(t, infos[0].right)
+	require.True(t, infos[0].mineNext)
+	require.Equal(t, []insolar.JetID{root}, jets.All(ctx, newPN))
+}
+
+func TestPulseManager_processJets_Merge(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	currentPN := insolar.PulseNumber(insolar.FirstPulseNumber + 1)
+	newPN := currentPN + 1
+
+	jets := jet.NewStore()
+	root := insolar.ZeroJetID
+	jets.Update(ctx, currentPN, true, root)
+	left, right, err := jets.Split(ctx, currentPN, root)
+	require.NoError(t, err)
+	jets.Update(ctx, currentPN, true, left, right)
+
+	t.Run("siblings are too big", func(t *testing.T) {
+		m := newJetsPulseManager(mc, jets, map[insolar.JetID]uint64{left: 5, right: 5})
+
+		infos, err := m.processJets(ctx, currentPN, newPN)
+		require.NoError(t, err)
+		require.Len(t, infos, 2)
+		for _, info := range infos {
+			require.False(t, info.merged)
+		}
+		require.ElementsMatch(t, []insolar.JetID{left, right}, jets.All(ctx, newPN))
+	})
+
+	t.Run("siblings are merged", func(t *testing.T) {
+		m := newJetsPulseManager(mc, jets, map[insolar.JetID]uint64{left: 4, right: 5})
+
+		infos, err := m.processJets(ctx, currentPN, newPN)
+		require.NoError(t, err)
+		require.Len(t, infos, 1)
+
+		info := infos[0]
+		require.True(t, info.merged)
+		require.True(t, info.mineNext)
+		require.Equal(t, root, info.id)
+		require.Equal(t, left, info.left.id)
+		require.Equal(t, uint64(4), info.left.size)
+		require.Equal(t, right, info.right.id)
+		require.Equal(t, uint64(5), info.right.size)
+		require.Equal(t, []insolar.JetID{root}, jets.All(ctx, newPN))
+	})
+}
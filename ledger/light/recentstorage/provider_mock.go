@@ -35,6 +35,11 @@ type ProviderMock struct {
 	GetPendingStoragePreCounter uint64
 	GetPendingStorageMock       mProviderMockGetPendingStorage
 
+	MergePendingStorageFunc       func(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 insolar.ID)
+	MergePendingStorageCounter    uint64
+	MergePendingStoragePreCounter uint64
+	MergePendingStorageMock       mProviderMockMergePendingStorage
+
 	RemovePendingStorageFunc       func(p context.Context, p1 insolar.ID)
 	RemovePendingStorageCounter    uint64
 	RemovePendingStoragePreCounter uint64
@@ -52,6 +57,7 @@ func NewProviderMock(t minimock.Tester) *ProviderMock {
 	m.ClonePendingStorageMock = mProviderMockClonePendingStorage{mock: m}
 	m.CountMock = mProviderMockCount{mock: m}
 	m.GetPendingStorageMock = mProviderMockGetPendingStorage{mock: m}
+	m.MergePendingStorageMock = mProviderMockMergePendingStorage{mock: m}
 	m.RemovePendingStorageMock = mProviderMockRemovePendingStorage{mock: m}
 
 	return m
@@ -464,6 +470,132 @@ func (m *ProviderMock) GetPendingStorageFinished() bool {
 	return true
 }
 
+type mProviderMockMergePendingStorage struct {
+	mock              *ProviderMock
+	mainExpectation   *ProviderMockMergePendingStorageExpectation
+	expectationSeries []*ProviderMockMergePendingStorageExpectation
+}
+
+type ProviderMockMergePendingStorageExpectation struct {
+	input *ProviderMockMergePendingStorageInput
+}
+
+type ProviderMockMergePendingStorageInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 insolar.ID
+	p3 insolar.ID
+}
+
+//Expect specifies that invocation of Provider.MergePendingStorage is expected from 1 to Infinity times
+func (m *mProviderMockMergePendingStorage) Expect(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 insolar.ID) *mProviderMockMergePendingStorage {
+	m.mock.MergePendingStorageFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ProviderMockMergePendingStorageExpectation{}
+	}
+	m.mainExpectation.input = &ProviderMockMergePendingStorageInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Provider.MergePendingStorage
+func (m *mProviderMockMergePendingStorage) Return() *ProviderMock {
+	m.mock.MergePendingStorageFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ProviderMockMergePendingStorageExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Provider.MergePendingStorage is expected once
+func (m *mProviderMockMergePendingStorage) ExpectOnce(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 insolar.ID) *ProviderMockMergePendingStorageExpectation {
+	m.mock.MergePendingStorageFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ProviderMockMergePendingStorageExpectation{}
+	expectation.input = &ProviderMockMergePendingStorageInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Provider.MergePendingStorage method
+func (m *mProviderMockMergePendingStorage) Set(f func(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 insolar.ID)) *ProviderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.MergePendingStorageFunc = f
+	return m.mock
+}
+
+//MergePendingStorage implements github.com/insolar/insolar/ledger/light/recentstorage.Provider interface
+func (m *ProviderMock) MergePendingStorage(p context.Context, p1 insolar.ID, p2 insolar.ID, p3 insolar.ID) {
+	counter := atomic.AddUint64(&m.MergePendingStoragePreCounter, 1)
+	defer atomic.AddUint64(&m.MergePendingStorageCounter, 1)
+
+	if len(m.MergePendingStorageMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.MergePendingStorageMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ProviderMock.MergePendingStorage. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.MergePendingStorageMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ProviderMockMergePendingStorageInput{p, p1, p2, p3}, "Provider.MergePendingStorage got unexpected parameters")
+
+		return
+	}
+
+	if m.MergePendingStorageMock.mainExpectation != nil {
+
+		input := m.MergePendingStorageMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ProviderMockMergePendingStorageInput{p, p1, p2, p3}, "Provider.MergePendingStorage got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.MergePendingStorageFunc == nil {
+		m.t.Fatalf("Unexpected call to ProviderMock.MergePendingStorage. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	m.MergePendingStorageFunc(p, p1, p2, p3)
+}
+
+//MergePendingStorageMinimockCounter returns a count of ProviderMock.MergePendingStorageFunc invocations
+func (m *ProviderMock) MergePendingStorageMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.MergePendingStorageCounter)
+}
+
+//MergePendingStorageMinimockPreCounter returns the value of ProviderMock.MergePendingStorage invocations
+func (m *ProviderMock) MergePendingStorageMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.MergePendingStoragePreCounter)
+}
+
+//MergePendingStorageFinished returns true if mock invocations count is ok
+func (m *ProviderMock) MergePendingStorageFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.MergePendingStorageMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.MergePendingStorageCounter) == uint64(len(m.MergePendingStorageMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.MergePendingStorageMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.MergePendingStorageCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.MergePendingStorageFunc != nil {
+		return atomic.LoadUint64(&m.MergePendingStorageCounter) > 0
+	}
+
+	return true
+}
+
 type mProviderMockRemovePendingStorage struct {
 	mock              *ProviderMock
 	mainExpectation   *ProviderMockRemovePendingStorageExpectation
@@ -604,6 +736,10 @@ func (m *ProviderMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ProviderMock.GetPendingStorage")
 	}
 
+	if !m.MergePendingStorageFinished() {
+		m.t.Fatal("Expected call to ProviderMock.MergePendingStorage")
+	}
+
 	if !m.RemovePendingStorageFinished() {
 		m.t.Fatal("Expected call to ProviderMock.RemovePendingStorage")
 	}
@@ -637,6 +773,10 @@ func (m *ProviderMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ProviderMock.GetPendingStorage")
 	}
 
+	if !m.MergePendingStorageFinished() {
+		m.t.Fatal("Expected call to ProviderMock.MergePendingStorage")
+	}
+
 	if !m.RemovePendingStorageFinished() {
 		m.t.Fatal("Expected call to ProviderMock.RemovePendingStorage")
 	}
@@ -658,6 +798,7 @@ func (m *ProviderMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.ClonePendingStorageFinished()
 		ok = ok && m.CountFinished()
 		ok = ok && m.GetPendingStorageFinished()
+		ok = ok && m.MergePendingStorageFinished()
 		ok = ok && m.RemovePendingStorageFinished()
 
 		if ok {
@@ -679,6 +820,10 @@ func (m *ProviderMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ProviderMock.GetPendingStorage")
 			}
 
+			if !m.MergePendingStorageFinished() {
+				m.t.Error("Expected call to ProviderMock.MergePendingStorage")
+			}
+
 			if !m.RemovePendingStorageFinished() {
 				m.t.Error("Expected call to ProviderMock.RemovePendingStorage")
 			}
@@ -707,6 +852,10 @@ func (m *ProviderMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.MergePendingStorageFinished() {
+		return false
+	}
+
 	if !m.RemovePendingStorageFinished() {
 		return false
 	}
@@ -32,6 +32,8 @@ type Provider interface {
 
 	ClonePendingStorage(ctx context.Context, fromJetID, toJetID insolar.ID)
 
+	MergePendingStorage(ctx context.Context, leftJetID, rightJetID, toJetID insolar.ID)
+
 	RemovePendingStorage(ctx context.Context, id insolar.ID)
 }
 
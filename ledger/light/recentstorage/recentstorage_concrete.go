@@ -76,29 +76,32 @@ func (p *RecentStorageProvider) ClonePendingStorage(ctx context.Context, fromJet
 		return
 	}
 
-	fromStorage.lock.RLock()
-	toStorage := &PendingStorageConcrete{
-		jetID:    toJetID,
-		requests: map[insolar.ID]*lockedPendingObjectContext{},
-	}
-	for objID, pendingContext := range fromStorage.requests {
-		if len(pendingContext.Context.Requests) == 0 {
-			continue
-		}
+	toStorage := NewPendingStorage(toJetID)
+	fromStorage.copyTo(toStorage)
 
-		pendingContext.lock.Lock()
+	p.pendingLock.Lock()
+	p.pendingStorages[toJetID] = toStorage
+	p.pendingLock.Unlock()
+}
 
-		clone := PendingObjectContext{
-			Active:   pendingContext.Context.Active,
-			Requests: []insolar.ID{},
-		}
+// MergePendingStorage clones pending requests of two sibling jets to their parent jet
+func (p *RecentStorageProvider) MergePendingStorage(ctx context.Context, leftJetID, rightJetID, toJetID insolar.ID) {
+	p.pendingLock.Lock()
+	leftStorage, leftOk := p.pendingStorages[leftJetID]
+	rightStorage, rightOk := p.pendingStorages[rightJetID]
+	p.pendingLock.Unlock()
 
-		clone.Requests = append(clone.Requests, pendingContext.Context.Requests...)
-		toStorage.requests[objID] = &lockedPendingObjectContext{Context: &clone}
+	if !leftOk && !rightOk {
+		return
+	}
 
-		pendingContext.lock.Unlock()
+	toStorage := NewPendingStorage(toJetID)
+	if leftOk {
+		leftStorage.copyTo(toStorage)
+	}
+	if rightOk {
+		rightStorage.copyTo(toStorage)
 	}
-	fromStorage.lock.RUnlock()
 
 	p.pendingLock.Lock()
 	p.pendingStorages[toJetID] = toStorage
@@ -153,6 +156,30 @@ func NewPendingStorage(jetID insolar.ID) *PendingStorageConcrete {
 	}
 }
 
+// copyTo copies non-empty requests collections to another storage
+func (r *PendingStorageConcrete) copyTo(to *PendingStorageConcrete) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	for objID, pendingContext := range r.requests {
+		if len(pendingContext.Context.Requests) == 0 {
+			continue
+		}
+
+		pendingContext.lock.Lock()
+
+		clone := PendingObjectContext{
+			Active:   pendingContext.Context.Active,
+			Requests: []insolar.ID{},
+		}
+
+		clone.Requests = append(clone.Requests, pendingContext.Context.Requests...)
+		to.requests[objID] = &lockedPendingObjectContext{Context: &clone}
+
+		pendingContext.lock.Unlock()
+	}
+}
+
 // AddPendingRequest adds an id of pending request to memory
 // The id stores in a collection ids of a specific object
 func (r *PendingStorageConcrete) AddPendingRequest(ctx context.Context, obj, req insolar.ID) {
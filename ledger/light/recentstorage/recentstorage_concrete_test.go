@@ -361,3 +361,28 @@ func TestPendingStorageConcrete_RemovePendingRequest_RemoveNotExisting(t *testin
 	require.Equal(t, first, pendingStorage.requests[objID].Context.Requests[0])
 	require.Equal(t, second, pendingStorage.requests[objID].Context.Requests[1])
 }
+
+func TestRecentStorageProvider_MergePendingStorage(t *testing.T) {
+	t.Parallel()
+	ctx := inslogger.TestContext(t)
+
+	leftJetID := *insolar.NewID(123, []byte{1})
+	rightJetID := *insolar.NewID(123, []byte{2})
+	parentJetID := *insolar.NewID(123, []byte{3})
+
+	leftObj := *insolar.NewID(123, []byte{10})
+	rightObj := *insolar.NewID(123, []byte{20})
+	leftReq := *insolar.NewID(123, []byte{11})
+	rightReq := *insolar.NewID(123, []byte{21})
+
+	provider := NewRecentStorageProvider()
+	provider.GetPendingStorage(ctx, leftJetID).AddPendingRequest(ctx, leftObj, leftReq)
+	provider.GetPendingStorage(ctx, rightJetID).AddPendingRequest(ctx, rightObj, rightReq)
+
+	provider.MergePendingStorage(ctx, leftJetID, rightJetID, parentJetID)
+
+	merged := provider.GetPendingStorage(ctx, parentJetID)
+	require.Equal(t, []insolar.ID{leftReq}, merged.GetRequestsForObject(leftObj))
+	require.Equal(t, []insolar.ID{rightReq}, merged.GetRequestsForObject(rightObj))
+	require.Equal(t, 1, len(provider.GetPendingStorage(ctx, leftJetID).GetRequests()))
+}
@@ -18,6 +18,7 @@ package replication
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/insolar/insolar/insolar"
@@ -25,9 +26,11 @@ import (
 	"github.com/insolar/insolar/insolar/node"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/drop"
 	"github.com/insolar/insolar/ledger/object"
+	"go.opencensus.io/stats"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/ledger/light/replication.Cleaner -o ./ -s _mock.go
@@ -37,6 +40,18 @@ import (
 type Cleaner interface {
 	// NotifyAboutPulse notifies a component about a pulse
 	NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber)
+	// NotifyAboutReplication notifies a component about a replicated pulse. Size is a size in bytes of records and blobs
+	// of the pulse. Confirmed is true, if heavy has accepted all the data of the pulse.
+	NotifyAboutReplication(ctx context.Context, pn insolar.PulseNumber, size uint64, confirmed bool)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/light/replication.RetentionAccessor -o ./ -s _mock.go
+
+// RetentionAccessor provides information about pulses, which data was removed by storage budget.
+type RetentionAccessor interface {
+	// IsPruned returns true, if records and blobs of the pulse were removed before the light chain limit was reached.
+	// Such data should be fetched from heavy.
+	IsPruned(pn insolar.PulseNumber) bool
 }
 
 // LightCleaner is an implementation of Cleaner interface
@@ -50,11 +65,28 @@ type LightCleaner struct {
 	blobCleaner  blob.Cleaner
 	recCleaner   object.RecordCleaner
 	indexCleaner object.IndexCleaner
+	journal      JournalCleaner
 	pulseShifter pulse.Shifter
 
 	pulseCalculator pulse.Calculator
 
 	lightChainLimit int
+	storageBudget   uint64
+
+	// expired holds pulses beyond the light chain limit, that are not cleaned yet. It's accessed by clean goroutine only.
+	expired []insolar.PulseNumber
+
+	usageLock sync.Mutex
+	usage     []pulseUsage
+	usedBytes uint64
+}
+
+// pulseUsage holds a size of data, that is stored for a replicated pulse.
+type pulseUsage struct {
+	pn        insolar.PulseNumber
+	size      uint64
+	confirmed bool
+	pruned    bool
 }
 
 // NewCleaner creates a new instance of LightCleaner
@@ -65,9 +97,11 @@ func NewCleaner(
 	blobCleaner blob.Cleaner,
 	recCleaner object.RecordCleaner,
 	indexCleaner object.IndexCleaner,
+	journal JournalCleaner,
 	pulseShifter pulse.Shifter,
 	pulseCalculator pulse.Calculator,
 	lightChainLimit int,
+	storageBudget uint64,
 ) *LightCleaner {
 	return &LightCleaner{
 		jetStorage:      jetStorage,
@@ -76,9 +110,11 @@ func NewCleaner(
 		blobCleaner:     blobCleaner,
 		recCleaner:      recCleaner,
 		indexCleaner:    indexCleaner,
+		journal:         journal,
 		pulseShifter:    pulseShifter,
 		pulseCalculator: pulseCalculator,
 		lightChainLimit: lightChainLimit,
+		storageBudget:   storageBudget,
 		pulseForClean:   make(chan insolar.PulseNumber),
 	}
 }
@@ -102,13 +138,129 @@ func (c *LightCleaner) clean(ctx context.Context) {
 		expiredPn, err := c.pulseCalculator.Backwards(ctx, pn, c.lightChainLimit)
 		if err == pulse.ErrNotFound {
 			logger.Errorf("[Cleaner][NotifyAboutPulse] expiredPn for pn - %v doesn't exist. limit - %v", pn, c.lightChainLimit)
+			c.cleanExpired(ctx)
+			c.enforceBudget(ctx)
 			continue
 		}
 		if err != nil {
 			panic(err)
 		}
 
-		c.cleanPulse(ctx, expiredPn.PulseNumber)
+		c.expired = append(c.expired, expiredPn.PulseNumber)
+		c.cleanExpired(ctx)
+		c.enforceBudget(ctx)
+	}
+}
+
+// cleanExpired cleans expired pulses in order. Cleaning stops at the first pulse, that has entries not acknowledged by
+// heavy, so the data can be re-sent. The pulse and the following ones are cleaned after heavy acknowledges them.
+func (c *LightCleaner) cleanExpired(ctx context.Context) {
+	logger := inslogger.FromContext(ctx)
+	for len(c.expired) > 0 {
+		pn := c.expired[0]
+		pending, err := c.journal.HasPending(ctx, pn)
+		if err != nil {
+			logger.Errorf("[Cleaner][cleanExpired] failed to check replication journal for pn - %v: %s", pn, err)
+			return
+		}
+		if pending {
+			logger.Warnf("[Cleaner][cleanExpired] pn - %v isn't acknowledged by heavy, cleaning is deferred", pn)
+			return
+		}
+
+		c.cleanPulse(ctx, pn)
+		c.releaseUsage(ctx, pn)
+		c.expired = c.expired[1:]
+	}
+}
+
+// NotifyAboutReplication saves a size of the replicated pulse. The size is used for checking the storage budget.
+// Repeated notification for the same pulse adds the size and updates the confirmation state.
+func (c *LightCleaner) NotifyAboutReplication(
+	ctx context.Context, pn insolar.PulseNumber, size uint64, confirmed bool,
+) {
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+
+	i := sort.Search(len(c.usage), func(i int) bool { return c.usage[i].pn >= pn })
+	if i < len(c.usage) && c.usage[i].pn == pn {
+		c.usage[i].size += size
+		c.usage[i].confirmed = confirmed
+	} else {
+		c.usage = append(c.usage, pulseUsage{})
+		copy(c.usage[i+1:], c.usage[i:])
+		c.usage[i] = pulseUsage{pn: pn, size: size, confirmed: confirmed}
+	}
+	c.usedBytes += size
+	stats.Record(ctx, statRetainedBytes.M(int64(c.usedBytes)))
+}
+
+// IsPruned returns true, if records and blobs of the pulse were removed by the storage budget.
+func (c *LightCleaner) IsPruned(pn insolar.PulseNumber) bool {
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+
+	for _, u := range c.usage {
+		if u.pn == pn {
+			return u.pruned
+		}
+	}
+	return false
+}
+
+// releaseUsage forgets sizes of pulses, that are cleaned by the light chain limit.
+func (c *LightCleaner) releaseUsage(ctx context.Context, expired insolar.PulseNumber) {
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+
+	var reclaimed uint64
+	i := 0
+	for ; i < len(c.usage) && c.usage[i].pn <= expired; i++ {
+		if !c.usage[i].pruned {
+			reclaimed += c.usage[i].size
+		}
+	}
+	c.usage = c.usage[i:]
+	c.usedBytes -= reclaimed
+
+	ctx = insmetrics.InsertTag(ctx, tagPolicy, "limit")
+	stats.Record(ctx, statReclaimedBytes.M(int64(reclaimed)), statRetainedBytes.M(int64(c.usedBytes)))
+}
+
+// enforceBudget removes records and blobs of the oldest pulses confirmed by heavy, until the used size fits
+// the storage budget. Jets, drops and indexes are kept until the light chain limit is reached.
+func (c *LightCleaner) enforceBudget(ctx context.Context) {
+	if c.storageBudget == 0 {
+		return
+	}
+
+	c.usageLock.Lock()
+	defer c.usageLock.Unlock()
+
+	logger := inslogger.FromContext(ctx)
+	ctx = insmetrics.InsertTag(ctx, tagPolicy, "budget")
+	for i := 0; i < len(c.usage) && c.usedBytes > c.storageBudget; i++ {
+		u := &c.usage[i]
+		if u.pruned || !u.confirmed {
+			continue
+		}
+
+		logger.Debugf("[Cleaner][enforceBudget] storage budget is exceeded, pruning pn - %v", u.pn)
+		c.blobCleaner.DeleteForPN(ctx, u.pn)
+		c.recCleaner.DeleteForPN(ctx, u.pn)
+		u.pruned = true
+		c.usedBytes -= u.size
+
+		stats.Record(ctx, statReclaimedBytes.M(int64(u.size)), statPrunedPulses.M(1))
+	}
+	stats.Record(ctx, statRetainedBytes.M(int64(c.usedBytes)))
+
+	if c.usedBytes > c.storageBudget {
+		logger.Warnf(
+			"[Cleaner][enforceBudget] storage budget is exceeded, but there are no replicated pulses to prune. used - %v, budget - %v",
+			c.usedBytes,
+			c.storageBudget,
+		)
 	}
 }
 
@@ -121,6 +273,7 @@ func (c *LightCleaner) cleanPulse(ctx context.Context, pn insolar.PulseNumber) {
 
 	c.jetStorage.DeleteForPN(ctx, pn)
 	c.indexCleaner.DeleteForPN(ctx, pn)
+	c.journal.DeleteForPN(ctx, pn)
 
 	err := c.pulseShifter.Shift(ctx, pn)
 	if err != nil {
@@ -24,6 +24,11 @@ type CleanerMock struct {
 	NotifyAboutPulseCounter    uint64
 	NotifyAboutPulsePreCounter uint64
 	NotifyAboutPulseMock       mCleanerMockNotifyAboutPulse
+
+	NotifyAboutReplicationFunc       func(p context.Context, p1 insolar.PulseNumber, p2 uint64, p3 bool)
+	NotifyAboutReplicationCounter    uint64
+	NotifyAboutReplicationPreCounter uint64
+	NotifyAboutReplicationMock       mCleanerMockNotifyAboutReplication
 }
 
 //NewCleanerMock returns a mock for github.com/insolar/insolar/ledger/light/replication.Cleaner
@@ -35,6 +40,7 @@ func NewCleanerMock(t minimock.Tester) *CleanerMock {
 	}
 
 	m.NotifyAboutPulseMock = mCleanerMockNotifyAboutPulse{mock: m}
+	m.NotifyAboutReplicationMock = mCleanerMockNotifyAboutReplication{mock: m}
 
 	return m
 }
@@ -163,6 +169,132 @@ func (m *CleanerMock) NotifyAboutPulseFinished() bool {
 	return true
 }
 
+type mCleanerMockNotifyAboutReplication struct {
+	mock              *CleanerMock
+	mainExpectation   *CleanerMockNotifyAboutReplicationExpectation
+	expectationSeries []*CleanerMockNotifyAboutReplicationExpectation
+}
+
+type CleanerMockNotifyAboutReplicationExpectation struct {
+	input *CleanerMockNotifyAboutReplicationInput
+}
+
+type CleanerMockNotifyAboutReplicationInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 uint64
+	p3 bool
+}
+
+//Expect specifies that invocation of Cleaner.NotifyAboutReplication is expected from 1 to Infinity times
+func (m *mCleanerMockNotifyAboutReplication) Expect(p context.Context, p1 insolar.PulseNumber, p2 uint64, p3 bool) *mCleanerMockNotifyAboutReplication {
+	m.mock.NotifyAboutReplicationFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CleanerMockNotifyAboutReplicationExpectation{}
+	}
+	m.mainExpectation.input = &CleanerMockNotifyAboutReplicationInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Cleaner.NotifyAboutReplication
+func (m *mCleanerMockNotifyAboutReplication) Return() *CleanerMock {
+	m.mock.NotifyAboutReplicationFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CleanerMockNotifyAboutReplicationExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Cleaner.NotifyAboutReplication is expected once
+func (m *mCleanerMockNotifyAboutReplication) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 uint64, p3 bool) *CleanerMockNotifyAboutReplicationExpectation {
+	m.mock.NotifyAboutReplicationFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &CleanerMockNotifyAboutReplicationExpectation{}
+	expectation.input = &CleanerMockNotifyAboutReplicationInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Cleaner.NotifyAboutReplication method
+func (m *mCleanerMockNotifyAboutReplication) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 uint64, p3 bool)) *CleanerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.NotifyAboutReplicationFunc = f
+	return m.mock
+}
+
+//NotifyAboutReplication implements github.com/insolar/insolar/ledger/light/replication.Cleaner interface
+func (m *CleanerMock) NotifyAboutReplication(p context.Context, p1 insolar.PulseNumber, p2 uint64, p3 bool) {
+	counter := atomic.AddUint64(&m.NotifyAboutReplicationPreCounter, 1)
+	defer atomic.AddUint64(&m.NotifyAboutReplicationCounter, 1)
+
+	if len(m.NotifyAboutReplicationMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.NotifyAboutReplicationMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to CleanerMock.NotifyAboutReplication. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.NotifyAboutReplicationMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, CleanerMockNotifyAboutReplicationInput{p, p1, p2, p3}, "Cleaner.NotifyAboutReplication got unexpected parameters")
+
+		return
+	}
+
+	if m.NotifyAboutReplicationMock.mainExpectation != nil {
+
+		input := m.NotifyAboutReplicationMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, CleanerMockNotifyAboutReplicationInput{p, p1, p2, p3}, "Cleaner.NotifyAboutReplication got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.NotifyAboutReplicationFunc == nil {
+		m.t.Fatalf("Unexpected call to CleanerMock.NotifyAboutReplication. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	m.NotifyAboutReplicationFunc(p, p1, p2, p3)
+}
+
+//NotifyAboutReplicationMinimockCounter returns a count of CleanerMock.NotifyAboutReplicationFunc invocations
+func (m *CleanerMock) NotifyAboutReplicationMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyAboutReplicationCounter)
+}
+
+//NotifyAboutReplicationMinimockPreCounter returns the value of CleanerMock.NotifyAboutReplication invocations
+func (m *CleanerMock) NotifyAboutReplicationMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.NotifyAboutReplicationPreCounter)
+}
+
+//NotifyAboutReplicationFinished returns true if mock invocations count is ok
+func (m *CleanerMock) NotifyAboutReplicationFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.NotifyAboutReplicationMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.NotifyAboutReplicationCounter) == uint64(len(m.NotifyAboutReplicationMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.NotifyAboutReplicationMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.NotifyAboutReplicationCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.NotifyAboutReplicationFunc != nil {
+		return atomic.LoadUint64(&m.NotifyAboutReplicationCounter) > 0
+	}
+
+	return true
+}
+
 //ValidateCallCounters checks that all mocked methods of the interface have been called at least once
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *CleanerMock) ValidateCallCounters() {
@@ -171,6 +303,10 @@ func (m *CleanerMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to CleanerMock.NotifyAboutPulse")
 	}
 
+	if !m.NotifyAboutReplicationFinished() {
+		m.t.Fatal("Expected call to CleanerMock.NotifyAboutReplication")
+	}
+
 }
 
 //CheckMocksCalled checks that all mocked methods of the interface have been called at least once
@@ -192,6 +328,10 @@ func (m *CleanerMock) MinimockFinish() {
 		m.t.Fatal("Expected call to CleanerMock.NotifyAboutPulse")
 	}
 
+	if !m.NotifyAboutReplicationFinished() {
+		m.t.Fatal("Expected call to CleanerMock.NotifyAboutReplication")
+	}
+
 }
 
 //Wait waits for all mocked methods to be called at least once
@@ -207,6 +347,7 @@ func (m *CleanerMock) MinimockWait(timeout time.Duration) {
 	for {
 		ok := true
 		ok = ok && m.NotifyAboutPulseFinished()
+		ok = ok && m.NotifyAboutReplicationFinished()
 
 		if ok {
 			return
@@ -219,6 +360,10 @@ func (m *CleanerMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to CleanerMock.NotifyAboutPulse")
 			}
 
+			if !m.NotifyAboutReplicationFinished() {
+				m.t.Error("Expected call to CleanerMock.NotifyAboutReplication")
+			}
+
 			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
 			return
 		default:
@@ -235,5 +380,9 @@ func (m *CleanerMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.NotifyAboutReplicationFinished() {
+		return false
+	}
+
 	return true
 }
@@ -17,6 +17,7 @@
 package replication
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/drop"
 	"github.com/insolar/insolar/ledger/object"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCleaner_cleanPulse(t *testing.T) {
@@ -56,10 +58,13 @@ func TestCleaner_cleanPulse(t *testing.T) {
 	ic := object.NewIndexCleanerMock(ctrl)
 	ic.DeleteForPNMock.Expect(ctx, inputPulse.PulseNumber)
 
+	jc := NewJournalCleanerMock(ctrl)
+	jc.DeleteForPNMock.Expect(ctx, inputPulse.PulseNumber)
+
 	ps := pulse.NewShifterMock(ctrl)
 	ps.ShiftMock.Expect(ctx, inputPulse.PulseNumber).Return(nil)
 
-	cleaner := NewCleaner(jm, nm, dc, bc, rc, ic, ps, nil, 0)
+	cleaner := NewCleaner(jm, nm, dc, bc, rc, ic, jc, ps, nil, 0, 0)
 
 	cleaner.cleanPulse(ctx, inputPulse.PulseNumber)
 
@@ -93,13 +98,17 @@ func TestCleaner_clean(t *testing.T) {
 	ic := object.NewIndexCleanerMock(ctrl)
 	ic.DeleteForPNMock.Expect(ctx, calculatedPulse.PulseNumber)
 
+	jc := NewJournalCleanerMock(ctrl)
+	jc.HasPendingMock.Expect(ctx, calculatedPulse.PulseNumber).Return(false, nil)
+	jc.DeleteForPNMock.Expect(ctx, calculatedPulse.PulseNumber)
+
 	ps := pulse.NewShifterMock(ctrl)
 	ps.ShiftMock.Expect(ctx, calculatedPulse.PulseNumber).Return(nil)
 
 	pc := pulse.NewCalculatorMock(ctrl)
 	pc.BackwardsMock.Expect(ctx, inputPulse.PulseNumber, limit).Return(calculatedPulse, nil)
 
-	cleaner := NewCleaner(jm, nm, dc, bc, rc, ic, ps, pc, limit)
+	cleaner := NewCleaner(jm, nm, dc, bc, rc, ic, jc, ps, pc, limit, 0)
 	defer close(cleaner.pulseForClean)
 
 	go cleaner.clean(ctx)
@@ -135,16 +144,120 @@ func TestLightCleaner_NotifyAboutPulse(t *testing.T) {
 	ic := object.NewIndexCleanerMock(ctrl)
 	ic.DeleteForPNMock.Expect(ctx, calculatedPulse.PulseNumber)
 
+	jc := NewJournalCleanerMock(ctrl)
+	jc.HasPendingMock.Expect(ctx, calculatedPulse.PulseNumber).Return(false, nil)
+	jc.DeleteForPNMock.Expect(ctx, calculatedPulse.PulseNumber)
+
 	ps := pulse.NewShifterMock(ctrl)
 	ps.ShiftMock.Expect(ctx, calculatedPulse.PulseNumber).Return(nil)
 
 	pc := pulse.NewCalculatorMock(ctrl)
 	pc.BackwardsMock.Expect(ctx, inputPulse.PulseNumber, limit).Return(calculatedPulse, nil)
 
-	cleaner := NewCleaner(jm, nm, dc, bc, rc, ic, ps, pc, limit)
+	cleaner := NewCleaner(jm, nm, dc, bc, rc, ic, jc, ps, pc, limit, 0)
 	defer close(cleaner.pulseForClean)
 
 	go cleaner.NotifyAboutPulse(ctx, inputPulse.PulseNumber)
 
 	ctrl.Wait(time.Minute)
 }
+
+func TestLightCleaner_cleanExpired_DefersPending(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ctrl := minimock.NewController(t)
+
+	pending := map[insolar.PulseNumber]bool{1: false, 2: true, 3: false}
+	var cleaned []insolar.PulseNumber
+
+	jm := jet.NewStorageMock(ctrl)
+	jm.DeleteForPNMock.Set(func(context.Context, insolar.PulseNumber) {})
+	nm := node.NewModifierMock(ctrl)
+	nm.DeleteForPNMock.Set(func(insolar.PulseNumber) {})
+	dc := drop.NewCleanerMock(ctrl)
+	dc.DeleteForPNMock.Set(func(context.Context, insolar.PulseNumber) {})
+	bc := blob.NewCleanerMock(ctrl)
+	bc.DeleteForPNMock.Set(func(context.Context, insolar.PulseNumber) {})
+	rc := object.NewRecordCleanerMock(ctrl)
+	rc.DeleteForPNMock.Set(func(_ context.Context, pn insolar.PulseNumber) {
+		cleaned = append(cleaned, pn)
+	})
+	ic := object.NewIndexCleanerMock(ctrl)
+	ic.DeleteForPNMock.Set(func(context.Context, insolar.PulseNumber) {})
+	jc := NewJournalCleanerMock(ctrl)
+	jc.HasPendingFunc = func(_ context.Context, pn insolar.PulseNumber) (bool, error) {
+		return pending[pn], nil
+	}
+	jc.DeleteForPNMock.Set(func(context.Context, insolar.PulseNumber) {})
+	ps := pulse.NewShifterMock(ctrl)
+	ps.ShiftMock.Set(func(context.Context, insolar.PulseNumber) error { return nil })
+
+	cleaner := NewCleaner(jm, nm, dc, bc, rc, ic, jc, ps, nil, 0, 0)
+	defer close(cleaner.pulseForClean)
+
+	cleaner.expired = []insolar.PulseNumber{1, 2, 3}
+	cleaner.cleanExpired(ctx)
+
+	assert.Equal(t, []insolar.PulseNumber{1}, cleaned)
+	assert.Equal(t, []insolar.PulseNumber{2, 3}, cleaner.expired)
+
+	pending[2] = false
+	cleaner.cleanExpired(ctx)
+
+	assert.Equal(t, []insolar.PulseNumber{1, 2, 3}, cleaned)
+	assert.Empty(t, cleaner.expired)
+
+	ctrl.Finish()
+}
+
+func TestLightCleaner_enforceBudget(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ctrl := minimock.NewController(t)
+
+	var pruned []insolar.PulseNumber
+	bc := blob.NewCleanerMock(ctrl)
+	bc.DeleteForPNMock.Set(func(_ context.Context, pn insolar.PulseNumber) {
+		pruned = append(pruned, pn)
+	})
+	rc := object.NewRecordCleanerMock(ctrl)
+	rc.DeleteForPNMock.Set(func(context.Context, insolar.PulseNumber) {})
+
+	cleaner := NewCleaner(nil, nil, nil, bc, rc, nil, nil, nil, nil, 0, 150)
+	defer close(cleaner.pulseForClean)
+
+	cleaner.NotifyAboutReplication(ctx, 1, 100, true)
+	cleaner.NotifyAboutReplication(ctx, 2, 100, false)
+	cleaner.NotifyAboutReplication(ctx, 3, 100, true)
+
+	cleaner.enforceBudget(ctx)
+
+	assert.Equal(t, []insolar.PulseNumber{1, 3}, pruned)
+	assert.Equal(t, uint64(100), cleaner.usedBytes)
+	assert.True(t, cleaner.IsPruned(1))
+	assert.False(t, cleaner.IsPruned(2))
+	assert.True(t, cleaner.IsPruned(3))
+	assert.False(t, cleaner.IsPruned(4))
+
+	cleaner.releaseUsage(ctx, 2)
+
+	assert.Equal(t, uint64(0), cleaner.usedBytes)
+	assert.False(t, cleaner.IsPruned(1))
+	assert.True(t, cleaner.IsPruned(3))
+
+	ctrl.Finish()
+}
+
+func TestLightCleaner_enforceBudget_NoBudget(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	ctrl := minimock.NewController(t)
+
+	cleaner := NewCleaner(nil, nil, nil, blob.NewCleanerMock(ctrl), object.NewRecordCleanerMock(ctrl), nil, nil, nil, nil, 0, 0)
+	defer close(cleaner.pulseForClean)
+
+	cleaner.NotifyAboutReplication(ctx, 1, 100, true)
+	cleaner.enforceBudget(ctx)
+
+	assert.False(t, cleaner.IsPruned(1))
+	assert.Equal(t, uint64(100), cleaner.usedBytes)
+
+	ctrl.Finish()
+}
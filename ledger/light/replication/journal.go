@@ -0,0 +1,303 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package replication
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/light/replication.Journal -o ./ -s _mock.go
+
+// Journal is a durable journal of heavy payloads. A payload is kept in the journal until heavy acknowledges it, so
+// it can be re-sent after a failure or a restart of the node.
+type Journal interface {
+	// Add saves a payload, that is going to be sent to heavy.
+	Add(ctx context.Context, msg *message.HeavyPayload) error
+	// Acknowledge marks a payload for a pulse and a jet as accepted by heavy and drops its data.
+	Acknowledge(ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID) error
+	// Fail saves a failed attempt to send a payload for a pulse and a jet.
+	Fail(ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID, reason error) error
+	// Pending returns payloads, that are not acknowledged by heavy yet. Payloads are ordered by pulse.
+	Pending(ctx context.Context) ([]*message.HeavyPayload, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/light/replication.JournalCleaner -o ./ -s _mock.go
+
+// JournalCleaner provides an interface for removing acknowledged entries from the journal.
+type JournalCleaner interface {
+	// HasPending returns true, if there are entries for a pulse, that are not acknowledged by heavy yet.
+	HasPending(ctx context.Context, pn insolar.PulseNumber) (bool, error)
+	// DeleteForPN removes acknowledged entries for a pulse. Entries, that are not acknowledged, are kept.
+	DeleteForPN(ctx context.Context, pn insolar.PulseNumber)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/light/replication.StatusAccessor -o ./ -s _mock.go
+
+// StatusAccessor provides an interface for fetching replication state.
+type StatusAccessor interface {
+	// Status returns replication state of all jets for a pulse. If pulse is zero, all pending entries are returned.
+	Status(ctx context.Context, pn insolar.PulseNumber) ([]Status, error)
+}
+
+// Status is a replication state of a pulse and a jet.
+type Status struct {
+	PulseNumber  insolar.PulseNumber
+	JetID        insolar.JetID
+	Acknowledged bool
+	Attempts     uint32
+	LastError    string
+}
+
+type journalEntry struct {
+	Payload      []byte
+	Acknowledged bool
+	Attempts     uint32
+	LastError    string
+}
+
+type journalKey struct {
+	pn    insolar.PulseNumber
+	jetID insolar.JetID
+}
+
+func (k journalKey) Scope() store.Scope {
+	return store.ScopeReplicationJournal
+}
+
+func (k journalKey) ID() []byte {
+	return append(k.pn.Bytes(), k.jetID[:]...)
+}
+
+func newJournalKey(raw []byte) journalKey {
+	key := journalKey{pn: insolar.NewPulseNumber(raw)}
+	copy(key.jetID[:], raw[insolar.PulseNumberSize:])
+	return key
+}
+
+// JournalDB is a db-based replication journal.
+type JournalDB struct {
+	lock sync.Mutex
+	db   store.DB
+}
+
+// NewJournalDB creates a new journal, that holds data in a db.
+func NewJournalDB(db store.DB) *JournalDB {
+	return &JournalDB{db: db}
+}
+
+// Add saves a payload, that is going to be sent to heavy. Attempts of a re-added payload are kept.
+func (j *JournalDB) Add(ctx context.Context, msg *message.HeavyPayload) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	key := journalKey{pn: msg.PulseNum, jetID: msg.JetID}
+	entry, err := j.get(key)
+	if err != nil && err != store.ErrNotFound {
+		return err
+	}
+	entry.Payload = message.ToBytes(msg)
+	entry.Acknowledged = false
+	return j.set(key, entry)
+}
+
+// Acknowledge marks a payload for a pulse and a jet as accepted by heavy and drops its data.
+func (j *JournalDB) Acknowledge(ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	key := journalKey{pn: pn, jetID: jetID}
+	entry, err := j.get(key)
+	if err != nil && err != store.ErrNotFound {
+		return err
+	}
+	entry.Payload = nil
+	entry.Acknowledged = true
+	entry.LastError = ""
+	return j.set(key, entry)
+}
+
+// Fail saves a failed attempt to send a payload for a pulse and a jet.
+func (j *JournalDB) Fail(ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID, reason error) error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	key := journalKey{pn: pn, jetID: jetID}
+	entry, err := j.get(key)
+	if err != nil {
+		return err
+	}
+	entry.Attempts++
+	if reason != nil {
+		entry.LastError = reason.Error()
+	}
+	return j.set(key, entry)
+}
+
+// Pending returns payloads, that are not acknowledged by heavy yet. Payloads are ordered by pulse.
+func (j *JournalDB) Pending(ctx context.Context) ([]*message.HeavyPayload, error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	var res []*message.HeavyPayload
+	err := j.iterate(0, func(key journalKey, entry journalEntry) error {
+		if entry.Acknowledged {
+			return nil
+		}
+		msg, err := message.Deserialize(bytes.NewReader(entry.Payload))
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode payload for pulse %v and jet %v", key.pn, key.jetID.DebugString())
+		}
+		payload, ok := msg.(*message.HeavyPayload)
+		if !ok {
+			return errors.Errorf("unexpected message type %T in replication journal", msg)
+		}
+		res = append(res, payload)
+		return nil
+	})
+	return res, err
+}
+
+// Status returns replication state of all jets for a pulse. If pulse is zero, all pending entries are returned.
+func (j *JournalDB) Status(ctx context.Context, pn insolar.PulseNumber) ([]Status, error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	var res []Status
+	err := j.iterate(pn, func(key journalKey, entry journalEntry) error {
+		if pn == 0 && entry.Acknowledged {
+			return nil
+		}
+		res = append(res, Status{
+			PulseNumber:  key.pn,
+			JetID:        key.jetID,
+			Acknowledged: entry.Acknowledged,
+			Attempts:     entry.Attempts,
+			LastError:    entry.LastError,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].PulseNumber != res[j].PulseNumber {
+			return res[i].PulseNumber < res[j].PulseNumber
+		}
+		return bytes.Compare(res[i].JetID[:], res[j].JetID[:]) < 0
+	})
+	return res, nil
+}
+
+// HasPending returns true, if there are entries for a pulse, that are not acknowledged by heavy yet.
+func (j *JournalDB) HasPending(ctx context.Context, pn insolar.PulseNumber) (bool, error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	pending := false
+	err := j.iterate(pn, func(key journalKey, entry journalEntry) error {
+		if !entry.Acknowledged {
+			pending = true
+		}
+		return nil
+	})
+	return pending, err
+}
+
+// DeleteForPN removes acknowledged entries for a pulse. Entries, that are not acknowledged, are kept.
+func (j *JournalDB) DeleteForPN(ctx context.Context, pn insolar.PulseNumber) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	batch := store.NewBatch()
+	err := j.iterate(pn, func(key journalKey, entry journalEntry) error {
+		if entry.Acknowledged {
+			batch.Delete(key)
+		}
+		return nil
+	})
+	if err == nil {
+		err = j.db.Write(batch)
+	}
+	if err != nil {
+		inslogger.FromContext(ctx).Errorf("[JournalDB][DeleteForPN] failed to clean journal for pn - %v: %s", pn, err)
+	}
+}
+
+// iterate calls f for all entries of a pulse. If pulse is zero, all entries are iterated.
+func (j *JournalDB) iterate(pn insolar.PulseNumber, f func(key journalKey, entry journalEntry) error) error {
+	var prefix []byte
+	if pn != 0 {
+		prefix = pn.Bytes()
+	}
+	it := j.db.NewIterator(store.ScopeReplicationJournal, prefix)
+	defer it.Close()
+
+	for it.Next() {
+		buf, err := it.Value()
+		if err != nil {
+			return err
+		}
+		entry, err := decodeJournalEntry(buf)
+		if err != nil {
+			return err
+		}
+		err = f(newJournalKey(it.Key()), entry)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (j *JournalDB) get(key journalKey) (journalEntry, error) {
+	buf, err := j.db.Get(key)
+	if err != nil {
+		return journalEntry{}, err
+	}
+	return decodeJournalEntry(buf)
+}
+
+func (j *JournalDB) set(key journalKey, entry journalEntry) error {
+	var buf []byte
+	enc := codec.NewEncoderBytes(&buf, &codec.CborHandle{})
+	err := enc.Encode(entry)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode journal entry")
+	}
+	return j.db.Set(key, buf)
+}
+
+func decodeJournalEntry(buf []byte) (journalEntry, error) {
+	var entry journalEntry
+	dec := codec.NewDecoderBytes(buf, &codec.CborHandle{})
+	err := dec.Decode(&entry)
+	if err != nil {
+		return journalEntry{}, errors.Wrap(err, "failed to decode journal entry")
+	}
+	return entry, nil
+}
@@ -0,0 +1,413 @@
+package replication
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "JournalCleaner" can be found in github.com/insolar/insolar/ledger/light/replication
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//JournalCleanerMock implements github.com/insolar/insolar/ledger/light/replication.JournalCleaner
+type JournalCleanerMock struct {
+	t minimock.Tester
+
+	DeleteForPNFunc       func(p context.Context, p1 insolar.PulseNumber)
+	DeleteForPNCounter    uint64
+	DeleteForPNPreCounter uint64
+	DeleteForPNMock       mJournalCleanerMockDeleteForPN
+
+	HasPendingFunc       func(p context.Context, p1 insolar.PulseNumber) (r bool, r1 error)
+	HasPendingCounter    uint64
+	HasPendingPreCounter uint64
+	HasPendingMock       mJournalCleanerMockHasPending
+}
+
+//NewJournalCleanerMock returns a mock for github.com/insolar/insolar/ledger/light/replication.JournalCleaner
+func NewJournalCleanerMock(t minimock.Tester) *JournalCleanerMock {
+	m := &JournalCleanerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteForPNMock = mJournalCleanerMockDeleteForPN{mock: m}
+	m.HasPendingMock = mJournalCleanerMockHasPending{mock: m}
+
+	return m
+}
+
+type mJournalCleanerMockDeleteForPN struct {
+	mock              *JournalCleanerMock
+	mainExpectation   *JournalCleanerMockDeleteForPNExpectation
+	expectationSeries []*JournalCleanerMockDeleteForPNExpectation
+}
+
+type JournalCleanerMockDeleteForPNExpectation struct {
+	input *JournalCleanerMockDeleteForPNInput
+}
+
+type JournalCleanerMockDeleteForPNInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+}
+
+//Expect specifies that invocation of JournalCleaner.DeleteForPN is expected from 1 to Infinity times
+func (m *mJournalCleanerMockDeleteForPN) Expect(p context.Context, p1 insolar.PulseNumber) *mJournalCleanerMockDeleteForPN {
+	m.mock.DeleteForPNFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalCleanerMockDeleteForPNExpectation{}
+	}
+	m.mainExpectation.input = &JournalCleanerMockDeleteForPNInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of JournalCleaner.DeleteForPN
+func (m *mJournalCleanerMockDeleteForPN) Return() *JournalCleanerMock {
+	m.mock.DeleteForPNFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalCleanerMockDeleteForPNExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of JournalCleaner.DeleteForPN is expected once
+func (m *mJournalCleanerMockDeleteForPN) ExpectOnce(p context.Context, p1 insolar.PulseNumber) *JournalCleanerMockDeleteForPNExpectation {
+	m.mock.DeleteForPNFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JournalCleanerMockDeleteForPNExpectation{}
+	expectation.input = &JournalCleanerMockDeleteForPNInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of JournalCleaner.DeleteForPN method
+func (m *mJournalCleanerMockDeleteForPN) Set(f func(p context.Context, p1 insolar.PulseNumber)) *JournalCleanerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.DeleteForPNFunc = f
+	return m.mock
+}
+
+//DeleteForPN implements github.com/insolar/insolar/ledger/light/replication.JournalCleaner interface
+func (m *JournalCleanerMock) DeleteForPN(p context.Context, p1 insolar.PulseNumber) {
+	counter := atomic.AddUint64(&m.DeleteForPNPreCounter, 1)
+	defer atomic.AddUint64(&m.DeleteForPNCounter, 1)
+
+	if len(m.DeleteForPNMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.DeleteForPNMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JournalCleanerMock.DeleteForPN. %v %v", p, p1)
+			return
+		}
+
+		input := m.DeleteForPNMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JournalCleanerMockDeleteForPNInput{p, p1}, "JournalCleaner.DeleteForPN got unexpected parameters")
+
+		return
+	}
+
+	if m.DeleteForPNMock.mainExpectation != nil {
+
+		input := m.DeleteForPNMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JournalCleanerMockDeleteForPNInput{p, p1}, "JournalCleaner.DeleteForPN got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.DeleteForPNFunc == nil {
+		m.t.Fatalf("Unexpected call to JournalCleanerMock.DeleteForPN. %v %v", p, p1)
+		return
+	}
+
+	m.DeleteForPNFunc(p, p1)
+}
+
+//DeleteForPNMinimockCounter returns a count of JournalCleanerMock.DeleteForPNFunc invocations
+func (m *JournalCleanerMock) DeleteForPNMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.DeleteForPNCounter)
+}
+
+//DeleteForPNMinimockPreCounter returns the value of JournalCleanerMock.DeleteForPN invocations
+func (m *JournalCleanerMock) DeleteForPNMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.DeleteForPNPreCounter)
+}
+
+//DeleteForPNFinished returns true if mock invocations count is ok
+func (m *JournalCleanerMock) DeleteForPNFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.DeleteForPNMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.DeleteForPNCounter) == uint64(len(m.DeleteForPNMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.DeleteForPNMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.DeleteForPNCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.DeleteForPNFunc != nil {
+		return atomic.LoadUint64(&m.DeleteForPNCounter) > 0
+	}
+
+	return true
+}
+
+type mJournalCleanerMockHasPending struct {
+	mock              *JournalCleanerMock
+	mainExpectation   *JournalCleanerMockHasPendingExpectation
+	expectationSeries []*JournalCleanerMockHasPendingExpectation
+}
+
+type JournalCleanerMockHasPendingExpectation struct {
+	input  *JournalCleanerMockHasPendingInput
+	result *JournalCleanerMockHasPendingResult
+}
+
+type JournalCleanerMockHasPendingInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+}
+
+type JournalCleanerMockHasPendingResult struct {
+	r  bool
+	r1 error
+}
+
+//Expect specifies that invocation of JournalCleaner.HasPending is expected from 1 to Infinity times
+func (m *mJournalCleanerMockHasPending) Expect(p context.Context, p1 insolar.PulseNumber) *mJournalCleanerMockHasPending {
+	m.mock.HasPendingFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalCleanerMockHasPendingExpectation{}
+	}
+	m.mainExpectation.input = &JournalCleanerMockHasPendingInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of JournalCleaner.HasPending
+func (m *mJournalCleanerMockHasPending) Return(r bool, r1 error) *JournalCleanerMock {
+	m.mock.HasPendingFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalCleanerMockHasPendingExpectation{}
+	}
+	m.mainExpectation.result = &JournalCleanerMockHasPendingResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of JournalCleaner.HasPending is expected once
+func (m *mJournalCleanerMockHasPending) ExpectOnce(p context.Context, p1 insolar.PulseNumber) *JournalCleanerMockHasPendingExpectation {
+	m.mock.HasPendingFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JournalCleanerMockHasPendingExpectation{}
+	expectation.input = &JournalCleanerMockHasPendingInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *JournalCleanerMockHasPendingExpectation) Return(r bool, r1 error) {
+	e.result = &JournalCleanerMockHasPendingResult{r, r1}
+}
+
+//Set uses given function f as a mock of JournalCleaner.HasPending method
+func (m *mJournalCleanerMockHasPending) Set(f func(p context.Context, p1 insolar.PulseNumber) (r bool, r1 error)) *JournalCleanerMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.HasPendingFunc = f
+	return m.mock
+}
+
+//HasPending implements github.com/insolar/insolar/ledger/light/replication.JournalCleaner interface
+func (m *JournalCleanerMock) HasPending(p context.Context, p1 insolar.PulseNumber) (r bool, r1 error) {
+	counter := atomic.AddUint64(&m.HasPendingPreCounter, 1)
+	defer atomic.AddUint64(&m.HasPendingCounter, 1)
+
+	if len(m.HasPendingMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.HasPendingMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JournalCleanerMock.HasPending. %v %v", p, p1)
+			return
+		}
+
+		input := m.HasPendingMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JournalCleanerMockHasPendingInput{p, p1}, "JournalCleaner.HasPending got unexpected parameters")
+
+		result := m.HasPendingMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalCleanerMock.HasPending")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.HasPendingMock.mainExpectation != nil {
+
+		input := m.HasPendingMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JournalCleanerMockHasPendingInput{p, p1}, "JournalCleaner.HasPending got unexpected parameters")
+		}
+
+		result := m.HasPendingMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalCleanerMock.HasPending")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.HasPendingFunc == nil {
+		m.t.Fatalf("Unexpected call to JournalCleanerMock.HasPending. %v %v", p, p1)
+		return
+	}
+
+	return m.HasPendingFunc(p, p1)
+}
+
+//HasPendingMinimockCounter returns a count of JournalCleanerMock.HasPendingFunc invocations
+func (m *JournalCleanerMock) HasPendingMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.HasPendingCounter)
+}
+
+//HasPendingMinimockPreCounter returns the value of JournalCleanerMock.HasPending invocations
+func (m *JournalCleanerMock) HasPendingMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.HasPendingPreCounter)
+}
+
+//HasPendingFinished returns true if mock invocations count is ok
+func (m *JournalCleanerMock) HasPendingFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.HasPendingMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.HasPendingCounter) == uint64(len(m.HasPendingMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.HasPendingMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.HasPendingCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.HasPendingFunc != nil {
+		return atomic.LoadUint64(&m.HasPendingCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *JournalCleanerMock) ValidateCallCounters() {
+
+	if !m.DeleteForPNFinished() {
+		m.t.Fatal("Expected call to JournalCleanerMock.DeleteForPN")
+	}
+
+	if !m.HasPendingFinished() {
+		m.t.Fatal("Expected call to JournalCleanerMock.HasPending")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *JournalCleanerMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *JournalCleanerMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *JournalCleanerMock) MinimockFinish() {
+
+	if !m.DeleteForPNFinished() {
+		m.t.Fatal("Expected call to JournalCleanerMock.DeleteForPN")
+	}
+
+	if !m.HasPendingFinished() {
+		m.t.Fatal("Expected call to JournalCleanerMock.HasPending")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *JournalCleanerMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *JournalCleanerMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.DeleteForPNFinished()
+		ok = ok && m.HasPendingFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.DeleteForPNFinished() {
+				m.t.Error("Expected call to JournalCleanerMock.DeleteForPN")
+			}
+
+			if !m.HasPendingFinished() {
+				m.t.Error("Expected call to JournalCleanerMock.HasPending")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *JournalCleanerMock) AllMocksCalled() bool {
+
+	if !m.DeleteForPNFinished() {
+		return false
+	}
+
+	if !m.HasPendingFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,782 @@
+package replication
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Journal" can be found in github.com/insolar/insolar/ledger/light/replication
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+	message "github.com/insolar/insolar/insolar/message"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//JournalMock implements github.com/insolar/insolar/ledger/light/replication.Journal
+type JournalMock struct {
+	t minimock.Tester
+
+	AcknowledgeFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r error)
+	AcknowledgeCounter    uint64
+	AcknowledgePreCounter uint64
+	AcknowledgeMock       mJournalMockAcknowledge
+
+	AddFunc       func(p context.Context, p1 *message.HeavyPayload) (r error)
+	AddCounter    uint64
+	AddPreCounter uint64
+	AddMock       mJournalMockAdd
+
+	FailFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID, p3 error) (r error)
+	FailCounter    uint64
+	FailPreCounter uint64
+	FailMock       mJournalMockFail
+
+	PendingFunc       func(p context.Context) (r []*message.HeavyPayload, r1 error)
+	PendingCounter    uint64
+	PendingPreCounter uint64
+	PendingMock       mJournalMockPending
+}
+
+//NewJournalMock returns a mock for github.com/insolar/insolar/ledger/light/replication.Journal
+func NewJournalMock(t minimock.Tester) *JournalMock {
+	m := &JournalMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AcknowledgeMock = mJournalMockAcknowledge{mock: m}
+	m.AddMock = mJournalMockAdd{mock: m}
+	m.FailMock = mJournalMockFail{mock: m}
+	m.PendingMock = mJournalMockPending{mock: m}
+
+	return m
+}
+
+type mJournalMockAcknowledge struct {
+	mock              *JournalMock
+	mainExpectation   *JournalMockAcknowledgeExpectation
+	expectationSeries []*JournalMockAcknowledgeExpectation
+}
+
+type JournalMockAcknowledgeExpectation struct {
+	input  *JournalMockAcknowledgeInput
+	result *JournalMockAcknowledgeResult
+}
+
+type JournalMockAcknowledgeInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 insolar.JetID
+}
+
+type JournalMockAcknowledgeResult struct {
+	r error
+}
+
+//Expect specifies that invocation of Journal.Acknowledge is expected from 1 to Infinity times
+func (m *mJournalMockAcknowledge) Expect(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) *mJournalMockAcknowledge {
+	m.mock.AcknowledgeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalMockAcknowledgeExpectation{}
+	}
+	m.mainExpectation.input = &JournalMockAcknowledgeInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Journal.Acknowledge
+func (m *mJournalMockAcknowledge) Return(r error) *JournalMock {
+	m.mock.AcknowledgeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalMockAcknowledgeExpectation{}
+	}
+	m.mainExpectation.result = &JournalMockAcknowledgeResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Journal.Acknowledge is expected once
+func (m *mJournalMockAcknowledge) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) *JournalMockAcknowledgeExpectation {
+	m.mock.AcknowledgeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JournalMockAcknowledgeExpectation{}
+	expectation.input = &JournalMockAcknowledgeInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *JournalMockAcknowledgeExpectation) Return(r error) {
+	e.result = &JournalMockAcknowledgeResult{r}
+}
+
+//Set uses given function f as a mock of Journal.Acknowledge method
+func (m *mJournalMockAcknowledge) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r error)) *JournalMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AcknowledgeFunc = f
+	return m.mock
+}
+
+//Acknowledge implements github.com/insolar/insolar/ledger/light/replication.Journal interface
+func (m *JournalMock) Acknowledge(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r error) {
+	counter := atomic.AddUint64(&m.AcknowledgePreCounter, 1)
+	defer atomic.AddUint64(&m.AcknowledgeCounter, 1)
+
+	if len(m.AcknowledgeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AcknowledgeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JournalMock.Acknowledge. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.AcknowledgeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JournalMockAcknowledgeInput{p, p1, p2}, "Journal.Acknowledge got unexpected parameters")
+
+		result := m.AcknowledgeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalMock.Acknowledge")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AcknowledgeMock.mainExpectation != nil {
+
+		input := m.AcknowledgeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JournalMockAcknowledgeInput{p, p1, p2}, "Journal.Acknowledge got unexpected parameters")
+		}
+
+		result := m.AcknowledgeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalMock.Acknowledge")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AcknowledgeFunc == nil {
+		m.t.Fatalf("Unexpected call to JournalMock.Acknowledge. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.AcknowledgeFunc(p, p1, p2)
+}
+
+//AcknowledgeMinimockCounter returns a count of JournalMock.AcknowledgeFunc invocations
+func (m *JournalMock) AcknowledgeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AcknowledgeCounter)
+}
+
+//AcknowledgeMinimockPreCounter returns the value of JournalMock.Acknowledge invocations
+func (m *JournalMock) AcknowledgeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AcknowledgePreCounter)
+}
+
+//AcknowledgeFinished returns true if mock invocations count is ok
+func (m *JournalMock) AcknowledgeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AcknowledgeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AcknowledgeCounter) == uint64(len(m.AcknowledgeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AcknowledgeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AcknowledgeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AcknowledgeFunc != nil {
+		return atomic.LoadUint64(&m.AcknowledgeCounter) > 0
+	}
+
+	return true
+}
+
+type mJournalMockAdd struct {
+	mock              *JournalMock
+	mainExpectation   *JournalMockAddExpectation
+	expectationSeries []*JournalMockAddExpectation
+}
+
+type JournalMockAddExpectation struct {
+	input  *JournalMockAddInput
+	result *JournalMockAddResult
+}
+
+type JournalMockAddInput struct {
+	p  context.Context
+	p1 *message.HeavyPayload
+}
+
+type JournalMockAddResult struct {
+	r error
+}
+
+//Expect specifies that invocation of Journal.Add is expected from 1 to Infinity times
+func (m *mJournalMockAdd) Expect(p context.Context, p1 *message.HeavyPayload) *mJournalMockAdd {
+	m.mock.AddFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalMockAddExpectation{}
+	}
+	m.mainExpectation.input = &JournalMockAddInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Journal.Add
+func (m *mJournalMockAdd) Return(r error) *JournalMock {
+	m.mock.AddFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalMockAddExpectation{}
+	}
+	m.mainExpectation.result = &JournalMockAddResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Journal.Add is expected once
+func (m *mJournalMockAdd) ExpectOnce(p context.Context, p1 *message.HeavyPayload) *JournalMockAddExpectation {
+	m.mock.AddFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JournalMockAddExpectation{}
+	expectation.input = &JournalMockAddInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *JournalMockAddExpectation) Return(r error) {
+	e.result = &JournalMockAddResult{r}
+}
+
+//Set uses given function f as a mock of Journal.Add method
+func (m *mJournalMockAdd) Set(f func(p context.Context, p1 *message.HeavyPayload) (r error)) *JournalMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddFunc = f
+	return m.mock
+}
+
+//Add implements github.com/insolar/insolar/ledger/light/replication.Journal interface
+func (m *JournalMock) Add(p context.Context, p1 *message.HeavyPayload) (r error) {
+	counter := atomic.AddUint64(&m.AddPreCounter, 1)
+	defer atomic.AddUint64(&m.AddCounter, 1)
+
+	if len(m.AddMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JournalMock.Add. %v %v", p, p1)
+			return
+		}
+
+		input := m.AddMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JournalMockAddInput{p, p1}, "Journal.Add got unexpected parameters")
+
+		result := m.AddMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalMock.Add")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AddMock.mainExpectation != nil {
+
+		input := m.AddMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JournalMockAddInput{p, p1}, "Journal.Add got unexpected parameters")
+		}
+
+		result := m.AddMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalMock.Add")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AddFunc == nil {
+		m.t.Fatalf("Unexpected call to JournalMock.Add. %v %v", p, p1)
+		return
+	}
+
+	return m.AddFunc(p, p1)
+}
+
+//AddMinimockCounter returns a count of JournalMock.AddFunc invocations
+func (m *JournalMock) AddMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddCounter)
+}
+
+//AddMinimockPreCounter returns the value of JournalMock.Add invocations
+func (m *JournalMock) AddMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddPreCounter)
+}
+
+//AddFinished returns true if mock invocations count is ok
+func (m *JournalMock) AddFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddCounter) == uint64(len(m.AddMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddFunc != nil {
+		return atomic.LoadUint64(&m.AddCounter) > 0
+	}
+
+	return true
+}
+
+type mJournalMockFail struct {
+	mock              *JournalMock
+	mainExpectation   *JournalMockFailExpectation
+	expectationSeries []*JournalMockFailExpectation
+}
+
+type JournalMockFailExpectation struct {
+	input  *JournalMockFailInput
+	result *JournalMockFailResult
+}
+
+type JournalMockFailInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 insolar.JetID
+	p3 error
+}
+
+type JournalMockFailResult struct {
+	r error
+}
+
+//Expect specifies that invocation of Journal.Fail is expected from 1 to Infinity times
+func (m *mJournalMockFail) Expect(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID, p3 error) *mJournalMockFail {
+	m.mock.FailFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalMockFailExpectation{}
+	}
+	m.mainExpectation.input = &JournalMockFailInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Journal.Fail
+func (m *mJournalMockFail) Return(r error) *JournalMock {
+	m.mock.FailFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalMockFailExpectation{}
+	}
+	m.mainExpectation.result = &JournalMockFailResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Journal.Fail is expected once
+func (m *mJournalMockFail) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID, p3 error) *JournalMockFailExpectation {
+	m.mock.FailFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JournalMockFailExpectation{}
+	expectation.input = &JournalMockFailInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *JournalMockFailExpectation) Return(r error) {
+	e.result = &JournalMockFailResult{r}
+}
+
+//Set uses given function f as a mock of Journal.Fail method
+func (m *mJournalMockFail) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID, p3 error) (r error)) *JournalMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.FailFunc = f
+	return m.mock
+}
+
+//Fail implements github.com/insolar/insolar/ledger/light/replication.Journal interface
+func (m *JournalMock) Fail(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID, p3 error) (r error) {
+	counter := atomic.AddUint64(&m.FailPreCounter, 1)
+	defer atomic.AddUint64(&m.FailCounter, 1)
+
+	if len(m.FailMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.FailMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JournalMock.Fail. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.FailMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JournalMockFailInput{p, p1, p2, p3}, "Journal.Fail got unexpected parameters")
+
+		result := m.FailMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalMock.Fail")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.FailMock.mainExpectation != nil {
+
+		input := m.FailMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JournalMockFailInput{p, p1, p2, p3}, "Journal.Fail got unexpected parameters")
+		}
+
+		result := m.FailMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalMock.Fail")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.FailFunc == nil {
+		m.t.Fatalf("Unexpected call to JournalMock.Fail. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.FailFunc(p, p1, p2, p3)
+}
+
+//FailMinimockCounter returns a count of JournalMock.FailFunc invocations
+func (m *JournalMock) FailMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.FailCounter)
+}
+
+//FailMinimockPreCounter returns the value of JournalMock.Fail invocations
+func (m *JournalMock) FailMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.FailPreCounter)
+}
+
+//FailFinished returns true if mock invocations count is ok
+func (m *JournalMock) FailFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.FailMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.FailCounter) == uint64(len(m.FailMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.FailMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.FailCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.FailFunc != nil {
+		return atomic.LoadUint64(&m.FailCounter) > 0
+	}
+
+	return true
+}
+
+type mJournalMockPending struct {
+	mock              *JournalMock
+	mainExpectation   *JournalMockPendingExpectation
+	expectationSeries []*JournalMockPendingExpectation
+}
+
+type JournalMockPendingExpectation struct {
+	input  *JournalMockPendingInput
+	result *JournalMockPendingResult
+}
+
+type JournalMockPendingInput struct {
+	p context.Context
+}
+
+type JournalMockPendingResult struct {
+	r  []*message.HeavyPayload
+	r1 error
+}
+
+//Expect specifies that invocation of Journal.Pending is expected from 1 to Infinity times
+func (m *mJournalMockPending) Expect(p context.Context) *mJournalMockPending {
+	m.mock.PendingFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalMockPendingExpectation{}
+	}
+	m.mainExpectation.input = &JournalMockPendingInput{p}
+	return m
+}
+
+//Return specifies results of invocation of Journal.Pending
+func (m *mJournalMockPending) Return(r []*message.HeavyPayload, r1 error) *JournalMock {
+	m.mock.PendingFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &JournalMockPendingExpectation{}
+	}
+	m.mainExpectation.result = &JournalMockPendingResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Journal.Pending is expected once
+func (m *mJournalMockPending) ExpectOnce(p context.Context) *JournalMockPendingExpectation {
+	m.mock.PendingFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &JournalMockPendingExpectation{}
+	expectation.input = &JournalMockPendingInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *JournalMockPendingExpectation) Return(r []*message.HeavyPayload, r1 error) {
+	e.result = &JournalMockPendingResult{r, r1}
+}
+
+//Set uses given function f as a mock of Journal.Pending method
+func (m *mJournalMockPending) Set(f func(p context.Context) (r []*message.HeavyPayload, r1 error)) *JournalMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.PendingFunc = f
+	return m.mock
+}
+
+//Pending implements github.com/insolar/insolar/ledger/light/replication.Journal interface
+func (m *JournalMock) Pending(p context.Context) (r []*message.HeavyPayload, r1 error) {
+	counter := atomic.AddUint64(&m.PendingPreCounter, 1)
+	defer atomic.AddUint64(&m.PendingCounter, 1)
+
+	if len(m.PendingMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.PendingMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to JournalMock.Pending. %v", p)
+			return
+		}
+
+		input := m.PendingMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, JournalMockPendingInput{p}, "Journal.Pending got unexpected parameters")
+
+		result := m.PendingMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalMock.Pending")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.PendingMock.mainExpectation != nil {
+
+		input := m.PendingMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, JournalMockPendingInput{p}, "Journal.Pending got unexpected parameters")
+		}
+
+		result := m.PendingMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the JournalMock.Pending")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.PendingFunc == nil {
+		m.t.Fatalf("Unexpected call to JournalMock.Pending. %v", p)
+		return
+	}
+
+	return m.PendingFunc(p)
+}
+
+//PendingMinimockCounter returns a count of JournalMock.PendingFunc invocations
+func (m *JournalMock) PendingMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.PendingCounter)
+}
+
+//PendingMinimockPreCounter returns the value of JournalMock.Pending invocations
+func (m *JournalMock) PendingMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.PendingPreCounter)
+}
+
+//PendingFinished returns true if mock invocations count is ok
+func (m *JournalMock) PendingFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.PendingMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.PendingCounter) == uint64(len(m.PendingMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.PendingMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.PendingCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.PendingFunc != nil {
+		return atomic.LoadUint64(&m.PendingCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *JournalMock) ValidateCallCounters() {
+
+	if !m.AcknowledgeFinished() {
+		m.t.Fatal("Expected call to JournalMock.Acknowledge")
+	}
+
+	if !m.AddFinished() {
+		m.t.Fatal("Expected call to JournalMock.Add")
+	}
+
+	if !m.FailFinished() {
+		m.t.Fatal("Expected call to JournalMock.Fail")
+	}
+
+	if !m.PendingFinished() {
+		m.t.Fatal("Expected call to JournalMock.Pending")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *JournalMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *JournalMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *JournalMock) MinimockFinish() {
+
+	if !m.AcknowledgeFinished() {
+		m.t.Fatal("Expected call to JournalMock.Acknowledge")
+	}
+
+	if !m.AddFinished() {
+		m.t.Fatal("Expected call to JournalMock.Add")
+	}
+
+	if !m.FailFinished() {
+		m.t.Fatal("Expected call to JournalMock.Fail")
+	}
+
+	if !m.PendingFinished() {
+		m.t.Fatal("Expected call to JournalMock.Pending")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *JournalMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *JournalMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.AcknowledgeFinished()
+		ok = ok && m.AddFinished()
+		ok = ok && m.FailFinished()
+		ok = ok && m.PendingFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.AcknowledgeFinished() {
+				m.t.Error("Expected call to JournalMock.Acknowledge")
+			}
+
+			if !m.AddFinished() {
+				m.t.Error("Expected call to JournalMock.Add")
+			}
+
+			if !m.FailFinished() {
+				m.t.Error("Expected call to JournalMock.Fail")
+			}
+
+			if !m.PendingFinished() {
+				m.t.Error("Expected call to JournalMock.Pending")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *JournalMock) AllMocksCalled() bool {
+
+	if !m.AcknowledgeFinished() {
+		return false
+	}
+
+	if !m.AddFinished() {
+		return false
+	}
+
+	if !m.FailFinished() {
+		return false
+	}
+
+	if !m.PendingFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,134 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package replication
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+func testPayload() *message.HeavyPayload {
+	return &message.HeavyPayload{
+		JetID:        gen.JetID(),
+		PulseNum:     gen.PulseNumber(),
+		IndexBuckets: [][]byte{{1}},
+		Drop:         []byte{2},
+		Blobs:        [][]byte{{3}},
+		Records:      [][]byte{{4}},
+	}
+}
+
+func TestJournalDB_Pending(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	journal := NewJournalDB(store.NewMemoryMockDB())
+
+	first := testPayload()
+	second := testPayload()
+	second.PulseNum = first.PulseNum + 1
+
+	require.NoError(t, journal.Add(ctx, second))
+	require.NoError(t, journal.Add(ctx, first))
+
+	pending, err := journal.Pending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*message.HeavyPayload{first, second}, pending)
+
+	require.NoError(t, journal.Acknowledge(ctx, first.PulseNum, first.JetID))
+
+	pending, err = journal.Pending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*message.HeavyPayload{second}, pending)
+}
+
+func TestJournalDB_Status(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	journal := NewJournalDB(store.NewMemoryMockDB())
+
+	acked := testPayload()
+	failed := testPayload()
+	failed.PulseNum = acked.PulseNum
+
+	require.NoError(t, journal.Add(ctx, acked))
+	require.NoError(t, journal.Add(ctx, failed))
+	require.NoError(t, journal.Fail(ctx, acked.PulseNum, acked.JetID, errors.New("first")))
+	require.NoError(t, journal.Acknowledge(ctx, acked.PulseNum, acked.JetID))
+	require.NoError(t, journal.Fail(ctx, failed.PulseNum, failed.JetID, errors.New("second")))
+
+	ackedStatus := Status{PulseNumber: acked.PulseNum, JetID: acked.JetID, Acknowledged: true, Attempts: 1}
+	failedStatus := Status{PulseNumber: failed.PulseNum, JetID: failed.JetID, Attempts: 1, LastError: "second"}
+
+	t.Run("returns all jets of a pulse", func(t *testing.T) {
+		statuses, err := journal.Status(ctx, acked.PulseNum)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []Status{ackedStatus, failedStatus}, statuses)
+	})
+
+	t.Run("returns pending jets for zero pulse", func(t *testing.T) {
+		statuses, err := journal.Status(ctx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, []Status{failedStatus}, statuses)
+	})
+
+	t.Run("returns nothing for unknown pulse", func(t *testing.T) {
+		statuses, err := journal.Status(ctx, acked.PulseNum+1)
+		require.NoError(t, err)
+		assert.Empty(t, statuses)
+	})
+}
+
+func TestJournalDB_DeleteForPN(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	journal := NewJournalDB(store.NewMemoryMockDB())
+
+	acked := testPayload()
+	pending := testPayload()
+	pending.PulseNum = acked.PulseNum
+
+	require.NoError(t, journal.Add(ctx, acked))
+	require.NoError(t, journal.Add(ctx, pending))
+	require.NoError(t, journal.Acknowledge(ctx, acked.PulseNum, acked.JetID))
+
+	journal.DeleteForPN(ctx, acked.PulseNum)
+
+	statuses, err := journal.Status(ctx, acked.PulseNum)
+	require.NoError(t, err)
+	require.Len(t, statuses, 1)
+	assert.Equal(t, pending.JetID, statuses[0].JetID)
+
+	has, err := journal.HasPending(ctx, acked.PulseNum)
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	require.NoError(t, journal.Acknowledge(ctx, pending.PulseNum, pending.JetID))
+	has, err = journal.HasPending(ctx, pending.PulseNum)
+	require.NoError(t, err)
+	assert.False(t, has)
+}
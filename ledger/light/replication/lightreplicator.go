@@ -23,6 +23,7 @@ import (
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
@@ -44,6 +45,7 @@ type LightReplicatorDefault struct {
 	jetCalculator   jet.Calculator
 	dataGatherer    DataGatherer
 	cleaner         Cleaner
+	journal         Journal
 	msgBus          insolar.MessageBus
 	pulseCalculator pulse.Calculator
 }
@@ -53,6 +55,7 @@ func NewReplicatorDefault(
 	jetCalculator jet.Calculator,
 	dataGatherer DataGatherer,
 	cleaner Cleaner,
+	journal Journal,
 	msgBus insolar.MessageBus,
 	calculator pulse.Calculator,
 ) *LightReplicatorDefault {
@@ -60,6 +63,7 @@ func NewReplicatorDefault(
 		jetCalculator:     jetCalculator,
 		dataGatherer:      dataGatherer,
 		cleaner:           cleaner,
+		journal:           journal,
 		msgBus:            msgBus,
 		pulseCalculator:   calculator,
 		syncWaitingPulses: make(chan insolar.PulseNumber),
@@ -70,8 +74,8 @@ func NewReplicatorDefault(
 // When it's called, a provided pulse is added to a channel.
 // There is a special gorutine that is reading that channel. When a new pulse is being received,
 // the routine starts to gather data (with using of LightDataGatherer). After gathering all the data,
-// it attempts to send it to the heavy. After sending a heavy payload to a heavy, data is deleted
-// with help of Cleaner
+// it saves it to the Journal and attempts to send it to the heavy. Payloads, which weren't acknowledged by the heavy
+// before, are re-sent first. After sending a heavy payload to a heavy, data is deleted with help of Cleaner
 func (t *LightReplicatorDefault) NotifyAboutPulse(ctx context.Context, pn insolar.PulseNumber) {
 	t.once.Do(func() {
 		go t.sync(ctx)
@@ -95,8 +99,12 @@ func (t *LightReplicatorDefault) sync(ctx context.Context) {
 	for pn := range t.syncWaitingPulses {
 		logger.Debugf("[Replicator][sync] pn received - %v", pn)
 
+		t.resendPending(ctx)
+
 		jets := t.jetCalculator.MineForPulse(ctx, pn)
 		logger.Debugf("[Replicator][sync] founds %v jets", len(jets))
+		var size uint64
+		confirmed := true
 		for _, jID := range jets {
 			msg, err := t.dataGatherer.ForPulseAndJet(ctx, pn, jID)
 			if err != nil {
@@ -109,18 +117,85 @@ func (t *LightReplicatorDefault) sync(ctx context.Context) {
 					),
 				)
 			}
-			err = t.sendToHeavy(ctx, msg)
+			size += payloadSize(msg)
+			err = t.journal.Add(ctx, msg)
 			if err != nil {
-				logger.Errorf("[Replicator][sync]  Problems with sending msg to a heavy node", err)
-			} else {
-				logger.Debugf("[Replicator][sync]  Data has been sent to a heavy. pn - %v, jetID - %v", msg.PulseNum, msg.JetID.DebugString())
+				logger.Errorf("[Replicator][sync] Problems with saving msg to the journal. err - %v", err)
+			}
+			if !t.send(ctx, msg) {
+				confirmed = false
 			}
 		}
 
+		t.cleaner.NotifyAboutReplication(ctx, pn, size, confirmed)
 		t.cleaner.NotifyAboutPulse(ctx, pn)
 	}
 }
 
+// resendPending re-sends payloads from the journal, which weren't acknowledged by the heavy.
+func (t *LightReplicatorDefault) resendPending(ctx context.Context) {
+	logger := inslogger.FromContext(ctx)
+	pending, err := t.journal.Pending(ctx)
+	if err != nil {
+		logger.Errorf("[Replicator][resendPending] Problems with reading the journal. err - %v", err)
+		return
+	}
+
+	var pulses []insolar.PulseNumber
+	failed := map[insolar.PulseNumber]bool{}
+	for _, msg := range pending {
+		logger.Debugf("[Replicator][resendPending] re-send pn - %v, jetID - %v", msg.PulseNum, msg.JetID.DebugString())
+		stats.Record(ctx, statRetriedHeavyPayloadCount.M(1))
+
+		if _, ok := failed[msg.PulseNum]; !ok {
+			pulses = append(pulses, msg.PulseNum)
+			failed[msg.PulseNum] = false
+		}
+		if !t.send(ctx, msg) {
+			failed[msg.PulseNum] = true
+		}
+	}
+
+	for _, pn := range pulses {
+		if !failed[pn] {
+			t.cleaner.NotifyAboutReplication(ctx, pn, 0, true)
+		}
+	}
+}
+
+// send sends a payload to the heavy and saves the result to the journal. Returns true if the heavy has accepted it.
+func (t *LightReplicatorDefault) send(ctx context.Context, msg *message.HeavyPayload) bool {
+	logger := inslogger.FromContext(ctx)
+	err := t.sendToHeavy(ctx, msg)
+	if err != nil {
+		logger.Errorf("[Replicator][sync]  Problems with sending msg to a heavy node", err)
+		err = t.journal.Fail(ctx, msg.PulseNum, msg.JetID, err)
+		if err != nil {
+			logger.Errorf("[Replicator][sync] Problems with saving failure to the journal. err - %v", err)
+		}
+		return false
+	}
+
+	logger.Debugf("[Replicator][sync]  Data has been sent to a heavy. pn - %v, jetID - %v", msg.PulseNum, msg.JetID.DebugString())
+	err = t.journal.Acknowledge(ctx, msg.PulseNum, msg.JetID)
+	if err != nil {
+		logger.Errorf("[Replicator][sync] Problems with saving acknowledgement to the journal. err - %v", err)
+	}
+	return true
+}
+
+// payloadSize returns a size of records and blobs of the payload.
+func payloadSize(msg *message.HeavyPayload) uint64 {
+	var size uint64
+	for _, rec := range msg.Records {
+		size += uint64(len(rec))
+	}
+	for _, b := range msg.Blobs {
+		size += uint64(len(b))
+	}
+	return size
+}
+
 func (t *LightReplicatorDefault) sendToHeavy(ctx context.Context, data insolar.Message) error {
 	rep, err := t.msgBus.Send(ctx, data, nil)
 	if err != nil {
@@ -17,6 +17,7 @@
 package replication
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -39,6 +40,7 @@ func TestNewReplicatorDefault(t *testing.T) {
 		jet.NewCalculatorMock(t),
 		NewDataGathererMock(t),
 		NewCleanerMock(t),
+		NewJournalMock(t),
 		testutils.NewMessageBusMock(t),
 		pulse.NewCalculatorMock(t),
 	)
@@ -47,6 +49,7 @@ func TestNewReplicatorDefault(t *testing.T) {
 	require.NotNil(t, r.jetCalculator)
 	require.NotNil(t, r.dataGatherer)
 	require.NotNil(t, r.cleaner)
+	require.NotNil(t, r.journal)
 	require.NotNil(t, r.msgBus)
 	require.NotNil(t, r.pulseCalculator)
 	require.NotNil(t, r.syncWaitingPulses)
@@ -101,10 +104,12 @@ func TestLightReplicatorDefault_sync(t *testing.T) {
 	mb := testutils.NewMessageBusMock(ctrl)
 	pc := pulse.NewCalculatorMock(ctrl)
 	dg := NewDataGathererMock(ctrl)
+	j := NewJournalMock(ctrl)
 	r := NewReplicatorDefault(
 		jc,
 		dg,
 		c,
+		j,
 		mb,
 		pc,
 	)
@@ -120,6 +125,10 @@ func TestLightReplicatorDefault_sync(t *testing.T) {
 	jc.MineForPulseMock.Expect(ctx, pn).Return([]insolar.JetID{jetID})
 	dg.ForPulseAndJetMock.Return(&msg, nil)
 	mb.SendMock.Return(&reply.OK{}, nil)
+	j.PendingMock.Return(nil, nil)
+	j.AddMock.Expect(ctx, &msg).Return(nil)
+	j.AcknowledgeMock.Expect(ctx, msg.PulseNum, msg.JetID).Return(nil)
+	c.NotifyAboutReplicationMock.Expect(ctx, pn, 0, true)
 	c.NotifyAboutPulseMock.Expect(ctx, pn)
 
 	go r.sync(ctx)
@@ -143,10 +152,12 @@ func TestLightReplicatorDefault_NotifyAboutPulse(t *testing.T) {
 	pc := pulse.NewCalculatorMock(ctrl)
 	pc.BackwardsMock.Expect(ctx, inputPN, 1).Return(insolar.Pulse{PulseNumber: expectedPN}, nil)
 	dg := NewDataGathererMock(ctrl)
+	j := NewJournalMock(ctrl)
 	r := NewReplicatorDefault(
 		jc,
 		dg,
 		c,
+		j,
 		mb,
 		pc,
 	)
@@ -161,6 +172,10 @@ func TestLightReplicatorDefault_NotifyAboutPulse(t *testing.T) {
 	jc.MineForPulseMock.Expect(ctx, expectedPN).Return([]insolar.JetID{jetID})
 	dg.ForPulseAndJetMock.Return(&msg, nil)
 	mb.SendMock.Return(&reply.OK{}, nil)
+	j.PendingMock.Return(nil, nil)
+	j.AddMock.Expect(ctx, &msg).Return(nil)
+	j.AcknowledgeMock.Expect(ctx, msg.PulseNum, msg.JetID).Return(nil)
+	c.NotifyAboutReplicationMock.Expect(ctx, expectedPN, 0, true)
 	c.NotifyAboutPulseMock.Expect(ctx, expectedPN)
 
 	go r.NotifyAboutPulse(ctx, inputPN)
@@ -168,3 +183,77 @@ func TestLightReplicatorDefault_NotifyAboutPulse(t *testing.T) {
 	ctrl.Wait(time.Minute)
 	ctrl.Finish()
 }
+
+func TestLightReplicatorDefault_sync_ReportsUsage(t *testing.T) {
+	t.Parallel()
+	ctrl := minimock.NewController(t)
+	ctx := inslogger.TestContext(t)
+	jc := jet.NewCalculatorMock(ctrl)
+	c := NewCleanerMock(ctrl)
+	mb := testutils.NewMessageBusMock(ctrl)
+	dg := NewDataGathererMock(ctrl)
+	j := NewJournalMock(ctrl)
+	r := NewReplicatorDefault(jc, dg, c, j, mb, pulse.NewCalculatorMock(ctrl))
+	defer close(r.syncWaitingPulses)
+
+	pn := gen.PulseNumber()
+	msg := message.HeavyPayload{
+		JetID:    gen.JetID(),
+		PulseNum: pn,
+		Records:  [][]byte{make([]byte, 10), make([]byte, 20)},
+		Blobs:    [][]byte{make([]byte, 30)},
+	}
+
+	jc.MineForPulseMock.Expect(ctx, pn).Return([]insolar.JetID{gen.JetID(), gen.JetID()})
+	dg.ForPulseAndJetMock.Return(&msg, nil)
+	sent := 0
+	mb.SendMock.Set(func(context.Context, insolar.Message, *insolar.MessageSendOptions) (insolar.Reply, error) {
+		sent++
+		if sent == 1 {
+			return &reply.OK{}, nil
+		}
+		return nil, errors.New("expected")
+	})
+	j.PendingMock.Return(nil, nil)
+	j.AddMock.Return(nil)
+	j.AcknowledgeMock.Return(nil)
+	j.FailMock.Return(nil)
+	c.NotifyAboutReplicationMock.Expect(ctx, pn, 120, false)
+	c.NotifyAboutPulseMock.Expect(ctx, pn)
+
+	go r.sync(ctx)
+	r.syncWaitingPulses <- pn
+
+	ctrl.Wait(time.Minute)
+	ctrl.Finish()
+}
+
+func TestLightReplicatorDefault_resendPending(t *testing.T) {
+	t.Parallel()
+	ctrl := minimock.NewController(t)
+	ctx := inslogger.TestContext(t)
+	c := NewCleanerMock(ctrl)
+	mb := testutils.NewMessageBusMock(ctrl)
+	j := NewJournalMock(ctrl)
+	r := NewReplicatorDefault(jet.NewCalculatorMock(ctrl), NewDataGathererMock(ctrl), c, j, mb, pulse.NewCalculatorMock(ctrl))
+	defer close(r.syncWaitingPulses)
+
+	acked := message.HeavyPayload{JetID: gen.JetID(), PulseNum: gen.PulseNumber()}
+	failed := message.HeavyPayload{JetID: gen.JetID(), PulseNum: acked.PulseNum + 1}
+	heavyErr := errors.New("expected")
+
+	j.PendingMock.Return([]*message.HeavyPayload{&acked, &failed}, nil)
+	mb.SendMock.Set(func(_ context.Context, msg insolar.Message, _ *insolar.MessageSendOptions) (insolar.Reply, error) {
+		if msg == &failed {
+			return nil, heavyErr
+		}
+		return &reply.OK{}, nil
+	})
+	j.AcknowledgeMock.Expect(ctx, acked.PulseNum, acked.JetID).Return(nil)
+	j.FailMock.Expect(ctx, failed.PulseNum, failed.JetID, heavyErr).Return(nil)
+	c.NotifyAboutReplicationMock.Expect(ctx, acked.PulseNum, 0, true)
+
+	r.resendPending(ctx)
+
+	ctrl.Finish()
+}
@@ -19,6 +19,13 @@ package replication
 import (
 	"go.opencensus.io/stats"
 	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+)
+
+var (
+	tagPolicy = insmetrics.MustTagKey("policy")
 )
 
 var (
@@ -32,6 +39,26 @@ var (
 		"How many heavy-payload messages were failed",
 		stats.UnitDimensionless,
 	)
+	statRetriedHeavyPayloadCount = stats.Int64(
+		"lightsyncer/retriedheavypayload/count",
+		"How many heavy-payload messages were re-sent to a heavy node",
+		stats.UnitDimensionless,
+	)
+	statReclaimedBytes = stats.Int64(
+		"lightcleaner/reclaimed/bytes",
+		"How many bytes of records and blobs were removed from a light node",
+		stats.UnitBytes,
+	)
+	statRetainedBytes = stats.Int64(
+		"lightcleaner/retained/bytes",
+		"How many bytes of records and blobs of replicated pulses are stored on a light node",
+		stats.UnitBytes,
+	)
+	statPrunedPulses = stats.Int64(
+		"lightcleaner/pruned/count",
+		"How many pulses were pruned before the light chain limit because of the storage budget",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -48,6 +75,31 @@ func init() {
 			Measure:     statErrHeavyPayloadCount,
 			Aggregation: view.Count(),
 		},
+		&view.View{
+			Name:        statRetriedHeavyPayloadCount.Name(),
+			Description: statRetriedHeavyPayloadCount.Description(),
+			Measure:     statRetriedHeavyPayloadCount,
+			Aggregation: view.Count(),
+		},
+		&view.View{
+			Name:        statReclaimedBytes.Name(),
+			Description: statReclaimedBytes.Description(),
+			Measure:     statReclaimedBytes,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{tagPolicy},
+		},
+		&view.View{
+			Name:        statRetainedBytes.Name(),
+			Description: statRetainedBytes.Description(),
+			Measure:     statRetainedBytes,
+			Aggregation: view.LastValue(),
+		},
+		&view.View{
+			Name:        statPrunedPulses.Name(),
+			Description: statPrunedPulses.Description(),
+			Measure:     statPrunedPulses,
+			Aggregation: view.Count(),
+		},
 	)
 	if err != nil {
 		panic(err)
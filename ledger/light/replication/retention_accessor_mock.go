@@ -0,0 +1,261 @@
+package replication
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "RetentionAccessor" can be found in github.com/insolar/insolar/ledger/light/replication
+*/
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//RetentionAccessorMock implements github.com/insolar/insolar/ledger/light/replication.RetentionAccessor
+type RetentionAccessorMock struct {
+	t minimock.Tester
+
+	IsPrunedFunc       func(p insolar.PulseNumber) (r bool)
+	IsPrunedCounter    uint64
+	IsPrunedPreCounter uint64
+	IsPrunedMock       mRetentionAccessorMockIsPruned
+}
+
+//NewRetentionAccessorMock returns a mock for github.com/insolar/insolar/ledger/light/replication.RetentionAccessor
+func NewRetentionAccessorMock(t minimock.Tester) *RetentionAccessorMock {
+	m := &RetentionAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.IsPrunedMock = mRetentionAccessorMockIsPruned{mock: m}
+
+	return m
+}
+
+type mRetentionAccessorMockIsPruned struct {
+	mock              *RetentionAccessorMock
+	mainExpectation   *RetentionAccessorMockIsPrunedExpectation
+	expectationSeries []*RetentionAccessorMockIsPrunedExpectation
+}
+
+type RetentionAccessorMockIsPrunedExpectation struct {
+	input  *RetentionAccessorMockIsPrunedInput
+	result *RetentionAccessorMockIsPrunedResult
+}
+
+type RetentionAccessorMockIsPrunedInput struct {
+	p insolar.PulseNumber
+}
+
+type RetentionAccessorMockIsPrunedResult struct {
+	r bool
+}
+
+//Expect specifies that invocation of RetentionAccessor.IsPruned is expected from 1 to Infinity times
+func (m *mRetentionAccessorMockIsPruned) Expect(p insolar.PulseNumber) *mRetentionAccessorMockIsPruned {
+	m.mock.IsPrunedFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RetentionAccessorMockIsPrunedExpectation{}
+	}
+	m.mainExpectation.input = &RetentionAccessorMockIsPrunedInput{p}
+	return m
+}
+
+//Return specifies results of invocation of RetentionAccessor.IsPruned
+func (m *mRetentionAccessorMockIsPruned) Return(r bool) *RetentionAccessorMock {
+	m.mock.IsPrunedFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RetentionAccessorMockIsPrunedExpectation{}
+	}
+	m.mainExpectation.result = &RetentionAccessorMockIsPrunedResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of RetentionAccessor.IsPruned is expected once
+func (m *mRetentionAccessorMockIsPruned) ExpectOnce(p insolar.PulseNumber) *RetentionAccessorMockIsPrunedExpectation {
+	m.mock.IsPrunedFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RetentionAccessorMockIsPrunedExpectation{}
+	expectation.input = &RetentionAccessorMockIsPrunedInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RetentionAccessorMockIsPrunedExpectation) Return(r bool) {
+	e.result = &RetentionAccessorMockIsPrunedResult{r}
+}
+
+//Set uses given function f as a mock of RetentionAccessor.IsPruned method
+func (m *mRetentionAccessorMockIsPruned) Set(f func(p insolar.PulseNumber) (r bool)) *RetentionAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IsPrunedFunc = f
+	return m.mock
+}
+
+//IsPruned implements github.com/insolar/insolar/ledger/light/replication.RetentionAccessor interface
+func (m *RetentionAccessorMock) IsPruned(p insolar.PulseNumber) (r bool) {
+	counter := atomic.AddUint64(&m.IsPrunedPreCounter, 1)
+	defer atomic.AddUint64(&m.IsPrunedCounter, 1)
+
+	if len(m.IsPrunedMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IsPrunedMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RetentionAccessorMock.IsPruned. %v", p)
+			return
+		}
+
+		input := m.IsPrunedMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RetentionAccessorMockIsPrunedInput{p}, "RetentionAccessor.IsPruned got unexpected parameters")
+
+		result := m.IsPrunedMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RetentionAccessorMock.IsPruned")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IsPrunedMock.mainExpectation != nil {
+
+		input := m.IsPrunedMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RetentionAccessorMockIsPrunedInput{p}, "RetentionAccessor.IsPruned got unexpected parameters")
+		}
+
+		result := m.IsPrunedMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RetentionAccessorMock.IsPruned")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IsPrunedFunc == nil {
+		m.t.Fatalf("Unexpected call to RetentionAccessorMock.IsPruned. %v", p)
+		return
+	}
+
+	return m.IsPrunedFunc(p)
+}
+
+//IsPrunedMinimockCounter returns a count of RetentionAccessorMock.IsPrunedFunc invocations
+func (m *RetentionAccessorMock) IsPrunedMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IsPrunedCounter)
+}
+
+//IsPrunedMinimockPreCounter returns the value of RetentionAccessorMock.IsPruned invocations
+func (m *RetentionAccessorMock) IsPrunedMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IsPrunedPreCounter)
+}
+
+//IsPrunedFinished returns true if mock invocations count is ok
+func (m *RetentionAccessorMock) IsPrunedFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IsPrunedMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IsPrunedCounter) == uint64(len(m.IsPrunedMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IsPrunedMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IsPrunedCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IsPrunedFunc != nil {
+		return atomic.LoadUint64(&m.IsPrunedCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RetentionAccessorMock) ValidateCallCounters() {
+
+	if !m.IsPrunedFinished() {
+		m.t.Fatal("Expected call to RetentionAccessorMock.IsPruned")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RetentionAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *RetentionAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *RetentionAccessorMock) MinimockFinish() {
+
+	if !m.IsPrunedFinished() {
+		m.t.Fatal("Expected call to RetentionAccessorMock.IsPruned")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *RetentionAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *RetentionAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.IsPrunedFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.IsPrunedFinished() {
+				m.t.Error("Expected call to RetentionAccessorMock.IsPruned")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *RetentionAccessorMock) AllMocksCalled() bool {
+
+	if !m.IsPrunedFinished() {
+		return false
+	}
+
+	return true
+}
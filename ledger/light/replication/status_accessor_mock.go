@@ -0,0 +1,266 @@
+package replication
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "StatusAccessor" can be found in github.com/insolar/insolar/ledger/light/replication
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//StatusAccessorMock implements github.com/insolar/insolar/ledger/light/replication.StatusAccessor
+type StatusAccessorMock struct {
+	t minimock.Tester
+
+	StatusFunc       func(p context.Context, p1 insolar.PulseNumber) (r []Status, r1 error)
+	StatusCounter    uint64
+	StatusPreCounter uint64
+	StatusMock       mStatusAccessorMockStatus
+}
+
+//NewStatusAccessorMock returns a mock for github.com/insolar/insolar/ledger/light/replication.StatusAccessor
+func NewStatusAccessorMock(t minimock.Tester) *StatusAccessorMock {
+	m := &StatusAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.StatusMock = mStatusAccessorMockStatus{mock: m}
+
+	return m
+}
+
+type mStatusAccessorMockStatus struct {
+	mock              *StatusAccessorMock
+	mainExpectation   *StatusAccessorMockStatusExpectation
+	expectationSeries []*StatusAccessorMockStatusExpectation
+}
+
+type StatusAccessorMockStatusExpectation struct {
+	input  *StatusAccessorMockStatusInput
+	result *StatusAccessorMockStatusResult
+}
+
+type StatusAccessorMockStatusInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+}
+
+type StatusAccessorMockStatusResult struct {
+	r  []Status
+	r1 error
+}
+
+//Expect specifies that invocation of StatusAccessor.Status is expected from 1 to Infinity times
+func (m *mStatusAccessorMockStatus) Expect(p context.Context, p1 insolar.PulseNumber) *mStatusAccessorMockStatus {
+	m.mock.StatusFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StatusAccessorMockStatusExpectation{}
+	}
+	m.mainExpectation.input = &StatusAccessorMockStatusInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of StatusAccessor.Status
+func (m *mStatusAccessorMockStatus) Return(r []Status, r1 error) *StatusAccessorMock {
+	m.mock.StatusFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StatusAccessorMockStatusExpectation{}
+	}
+	m.mainExpectation.result = &StatusAccessorMockStatusResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of StatusAccessor.Status is expected once
+func (m *mStatusAccessorMockStatus) ExpectOnce(p context.Context, p1 insolar.PulseNumber) *StatusAccessorMockStatusExpectation {
+	m.mock.StatusFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &StatusAccessorMockStatusExpectation{}
+	expectation.input = &StatusAccessorMockStatusInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *StatusAccessorMockStatusExpectation) Return(r []Status, r1 error) {
+	e.result = &StatusAccessorMockStatusResult{r, r1}
+}
+
+//Set uses given function f as a mock of StatusAccessor.Status method
+func (m *mStatusAccessorMockStatus) Set(f func(p context.Context, p1 insolar.PulseNumber) (r []Status, r1 error)) *StatusAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.StatusFunc = f
+	return m.mock
+}
+
+//Status implements github.com/insolar/insolar/ledger/light/replication.StatusAccessor interface
+func (m *StatusAccessorMock) Status(p context.Context, p1 insolar.PulseNumber) (r []Status, r1 error) {
+	counter := atomic.AddUint64(&m.StatusPreCounter, 1)
+	defer atomic.AddUint64(&m.StatusCounter, 1)
+
+	if len(m.StatusMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.StatusMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to StatusAccessorMock.Status. %v %v", p, p1)
+			return
+		}
+
+		input := m.StatusMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, StatusAccessorMockStatusInput{p, p1}, "StatusAccessor.Status got unexpected parameters")
+
+		result := m.StatusMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the StatusAccessorMock.Status")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.StatusMock.mainExpectation != nil {
+
+		input := m.StatusMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, StatusAccessorMockStatusInput{p, p1}, "StatusAccessor.Status got unexpected parameters")
+		}
+
+		result := m.StatusMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the StatusAccessorMock.Status")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.StatusFunc == nil {
+		m.t.Fatalf("Unexpected call to StatusAccessorMock.Status. %v %v", p, p1)
+		return
+	}
+
+	return m.StatusFunc(p, p1)
+}
+
+//StatusMinimockCounter returns a count of StatusAccessorMock.StatusFunc invocations
+func (m *StatusAccessorMock) StatusMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.StatusCounter)
+}
+
+//StatusMinimockPreCounter returns the value of StatusAccessorMock.Status invocations
+func (m *StatusAccessorMock) StatusMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.StatusPreCounter)
+}
+
+//StatusFinished returns true if mock invocations count is ok
+func (m *StatusAccessorMock) StatusFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.StatusMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.StatusCounter) == uint64(len(m.StatusMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.StatusMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.StatusCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.StatusFunc != nil {
+		return atomic.LoadUint64(&m.StatusCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *StatusAccessorMock) ValidateCallCounters() {
+
+	if !m.StatusFinished() {
+		m.t.Fatal("Expected call to StatusAccessorMock.Status")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *StatusAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *StatusAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *StatusAccessorMock) MinimockFinish() {
+
+	if !m.StatusFinished() {
+		m.t.Fatal("Expected call to StatusAccessorMock.Status")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *StatusAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *StatusAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.StatusFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.StatusFinished() {
+				m.t.Error("Expected call to StatusAccessorMock.Status")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *StatusAccessorMock) AllMocksCalled() bool {
+
+	if !m.StatusFinished() {
+		return false
+	}
+
+	return true
+}
@@ -80,6 +80,14 @@ type IndexBucketAccessor interface {
 	ForPNAndJet(ctx context.Context, pn insolar.PulseNumber, jetID insolar.JetID) []IndexBucket
 }
 
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.IndexBucketObjAccessor -o ./ -s _mock.go
+
+// IndexBucketObjAccessor provides an interface for fetching a single bucket from an index.
+type IndexBucketObjAccessor interface {
+	// ForPNAndObjID returns a bucket for a provided pn and objID
+	ForPNAndObjID(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) (IndexBucket, error)
+}
+
 // lockedIndexBucket is a thread-safe wrapper around IndexBucket struct.
 // Due to IndexBucket is a protobuf-generated struct,
 // lockedIndexBucket was created for creating an opportunity for using of IndexBucket struct  in a thread-safe way.
@@ -416,6 +424,18 @@ func (i *IndexDB) ForID(ctx context.Context, pn insolar.PulseNumber, objID insol
 	return buck.Lifeline, nil
 }
 
+// ForPNAndObjID returns a bucket with provided PN and ObjID
+func (i *IndexDB) ForPNAndObjID(ctx context.Context, pn insolar.PulseNumber, objID insolar.ID) (IndexBucket, error) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	buck, err := i.getBucket(pn, objID)
+	if err != nil {
+		return IndexBucket{}, err
+	}
+	return *buck, nil
+}
+
 func (i *IndexDB) setBucket(pn insolar.PulseNumber, objID insolar.ID, bucket *IndexBucket) error {
 	key := indexKey{pn: pn, objID: objID}
 
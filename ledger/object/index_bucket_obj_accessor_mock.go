@@ -0,0 +1,267 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "IndexBucketObjAccessor" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//IndexBucketObjAccessorMock implements github.com/insolar/insolar/ledger/object.IndexBucketObjAccessor
+type IndexBucketObjAccessorMock struct {
+	t minimock.Tester
+
+	ForPNAndObjIDFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID) (r IndexBucket, r1 error)
+	ForPNAndObjIDCounter    uint64
+	ForPNAndObjIDPreCounter uint64
+	ForPNAndObjIDMock       mIndexBucketObjAccessorMockForPNAndObjID
+}
+
+//NewIndexBucketObjAccessorMock returns a mock for github.com/insolar/insolar/ledger/object.IndexBucketObjAccessor
+func NewIndexBucketObjAccessorMock(t minimock.Tester) *IndexBucketObjAccessorMock {
+	m := &IndexBucketObjAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForPNAndObjIDMock = mIndexBucketObjAccessorMockForPNAndObjID{mock: m}
+
+	return m
+}
+
+type mIndexBucketObjAccessorMockForPNAndObjID struct {
+	mock              *IndexBucketObjAccessorMock
+	mainExpectation   *IndexBucketObjAccessorMockForPNAndObjIDExpectation
+	expectationSeries []*IndexBucketObjAccessorMockForPNAndObjIDExpectation
+}
+
+type IndexBucketObjAccessorMockForPNAndObjIDExpectation struct {
+	input  *IndexBucketObjAccessorMockForPNAndObjIDInput
+	result *IndexBucketObjAccessorMockForPNAndObjIDResult
+}
+
+type IndexBucketObjAccessorMockForPNAndObjIDInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 insolar.ID
+}
+
+type IndexBucketObjAccessorMockForPNAndObjIDResult struct {
+	r  IndexBucket
+	r1 error
+}
+
+//Expect specifies that invocation of IndexBucketObjAccessor.ForPNAndObjID is expected from 1 to Infinity times
+func (m *mIndexBucketObjAccessorMockForPNAndObjID) Expect(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID) *mIndexBucketObjAccessorMockForPNAndObjID {
+	m.mock.ForPNAndObjIDFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &IndexBucketObjAccessorMockForPNAndObjIDExpectation{}
+	}
+	m.mainExpectation.input = &IndexBucketObjAccessorMockForPNAndObjIDInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of IndexBucketObjAccessor.ForPNAndObjID
+func (m *mIndexBucketObjAccessorMockForPNAndObjID) Return(r IndexBucket, r1 error) *IndexBucketObjAccessorMock {
+	m.mock.ForPNAndObjIDFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &IndexBucketObjAccessorMockForPNAndObjIDExpectation{}
+	}
+	m.mainExpectation.result = &IndexBucketObjAccessorMockForPNAndObjIDResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of IndexBucketObjAccessor.ForPNAndObjID is expected once
+func (m *mIndexBucketObjAccessorMockForPNAndObjID) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID) *IndexBucketObjAccessorMockForPNAndObjIDExpectation {
+	m.mock.ForPNAndObjIDFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &IndexBucketObjAccessorMockForPNAndObjIDExpectation{}
+	expectation.input = &IndexBucketObjAccessorMockForPNAndObjIDInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *IndexBucketObjAccessorMockForPNAndObjIDExpectation) Return(r IndexBucket, r1 error) {
+	e.result = &IndexBucketObjAccessorMockForPNAndObjIDResult{r, r1}
+}
+
+//Set uses given function f as a mock of IndexBucketObjAccessor.ForPNAndObjID method
+func (m *mIndexBucketObjAccessorMockForPNAndObjID) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID) (r IndexBucket, r1 error)) *IndexBucketObjAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForPNAndObjIDFunc = f
+	return m.mock
+}
+
+//ForPNAndObjID implements github.com/insolar/insolar/ledger/object.IndexBucketObjAccessor interface
+func (m *IndexBucketObjAccessorMock) ForPNAndObjID(p context.Context, p1 insolar.PulseNumber, p2 insolar.ID) (r IndexBucket, r1 error) {
+	counter := atomic.AddUint64(&m.ForPNAndObjIDPreCounter, 1)
+	defer atomic.AddUint64(&m.ForPNAndObjIDCounter, 1)
+
+	if len(m.ForPNAndObjIDMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForPNAndObjIDMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to IndexBucketObjAccessorMock.ForPNAndObjID. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.ForPNAndObjIDMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, IndexBucketObjAccessorMockForPNAndObjIDInput{p, p1, p2}, "IndexBucketObjAccessor.ForPNAndObjID got unexpected parameters")
+
+		result := m.ForPNAndObjIDMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the IndexBucketObjAccessorMock.ForPNAndObjID")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForPNAndObjIDMock.mainExpectation != nil {
+
+		input := m.ForPNAndObjIDMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, IndexBucketObjAccessorMockForPNAndObjIDInput{p, p1, p2}, "IndexBucketObjAccessor.ForPNAndObjID got unexpected parameters")
+		}
+
+		result := m.ForPNAndObjIDMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the IndexBucketObjAccessorMock.ForPNAndObjID")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForPNAndObjIDFunc == nil {
+		m.t.Fatalf("Unexpected call to IndexBucketObjAccessorMock.ForPNAndObjID. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.ForPNAndObjIDFunc(p, p1, p2)
+}
+
+//ForPNAndObjIDMinimockCounter returns a count of IndexBucketObjAccessorMock.ForPNAndObjIDFunc invocations
+func (m *IndexBucketObjAccessorMock) ForPNAndObjIDMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForPNAndObjIDCounter)
+}
+
+//ForPNAndObjIDMinimockPreCounter returns the value of IndexBucketObjAccessorMock.ForPNAndObjID invocations
+func (m *IndexBucketObjAccessorMock) ForPNAndObjIDMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForPNAndObjIDPreCounter)
+}
+
+//ForPNAndObjIDFinished returns true if mock invocations count is ok
+func (m *IndexBucketObjAccessorMock) ForPNAndObjIDFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForPNAndObjIDMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForPNAndObjIDCounter) == uint64(len(m.ForPNAndObjIDMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForPNAndObjIDMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForPNAndObjIDCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForPNAndObjIDFunc != nil {
+		return atomic.LoadUint64(&m.ForPNAndObjIDCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *IndexBucketObjAccessorMock) ValidateCallCounters() {
+
+	if !m.ForPNAndObjIDFinished() {
+		m.t.Fatal("Expected call to IndexBucketObjAccessorMock.ForPNAndObjID")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *IndexBucketObjAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *IndexBucketObjAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *IndexBucketObjAccessorMock) MinimockFinish() {
+
+	if !m.ForPNAndObjIDFinished() {
+		m.t.Fatal("Expected call to IndexBucketObjAccessorMock.ForPNAndObjID")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *IndexBucketObjAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *IndexBucketObjAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForPNAndObjIDFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForPNAndObjIDFinished() {
+				m.t.Error("Expected call to IndexBucketObjAccessorMock.ForPNAndObjID")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *IndexBucketObjAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForPNAndObjIDFinished() {
+		return false
+	}
+
+	return true
+}
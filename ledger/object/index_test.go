@@ -469,3 +469,46 @@ func TestDBIndex_SetBucket(t *testing.T) {
 		assert.Equal(t, idxBuf, resBuf)
 	})
 }
+
+func TestDBIndex_ForPNAndObjID(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	objID := gen.ID()
+	lflID := gen.ID()
+	buck := IndexBucket{
+		ObjID: objID,
+		Lifeline: Lifeline{
+			LatestState: &lflID,
+			JetID:       gen.JetID(),
+			Delegates:   []LifelineDelegate{},
+		},
+	}
+
+	t.Run("returns saved bucket", func(t *testing.T) {
+		pn := gen.PulseNumber()
+		index := NewIndexDB(store.NewMemoryMockDB())
+
+		err := index.SetBucket(ctx, pn, buck)
+		require.NoError(t, err)
+
+		res, err := index.ForPNAndObjID(ctx, pn, objID)
+		require.NoError(t, err)
+
+		buckBuf, _ := buck.Marshal()
+		resBuf, _ := res.Marshal()
+
+		assert.Equal(t, buckBuf, resBuf)
+	})
+
+	t.Run("returns error for another pulse", func(t *testing.T) {
+		pn := gen.PulseNumber()
+		index := NewIndexDB(store.NewMemoryMockDB())
+
+		err := index.SetBucket(ctx, pn, buck)
+		require.NoError(t, err)
+
+		_, err = index.ForPNAndObjID(ctx, pn+1, objID)
+		assert.Equal(t, ErrIndexBucketNotFound, err)
+	})
+}
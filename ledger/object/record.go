@@ -18,12 +18,15 @@ package object
 
 import (
 	"context"
+	"encoding/binary"
 	"sync"
 
+	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/internal/ledger/store"
 )
 
@@ -49,6 +52,35 @@ type RecordCollectionAccessor interface {
 	ForPulse(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) []record.Material
 }
 
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.RecordResultAccessor -o ./ -s _mock.go
+
+// RecordResultAccessor provides access to result records by requests.
+type RecordResultAccessor interface {
+	// ResultForRequest returns id of the result record, that closes provided request.
+	ResultForRequest(ctx context.Context, request insolar.ID) (insolar.ID, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/object.RecordEventAccessor -o ./ -s _mock.go
+
+// RecordEventAccessor provides access to contract events, that are saved with result records.
+type RecordEventAccessor interface {
+	// EventsForObject returns events with provided name, that were emitted by the object starting from provided pulse.
+	// Events of one pulse are never split, so more than limit events can be returned. Next is a pulse to continue
+	// from, it's zero when there are no more events.
+	EventsForObject(
+		ctx context.Context, obj insolar.ID, name string, from insolar.PulseNumber, limit int,
+	) (events []Event, next insolar.PulseNumber, err error)
+}
+
+// Event is a contract event, that is saved with a result record.
+type Event struct {
+	Request  insolar.Reference
+	Result   insolar.ID
+	Position uint32
+	Name     string
+	Data     []byte
+}
+
 //go:generate minimock -i github.com/insolar/insolar/ledger/object.RecordModifier -o ./ -s _mock.go
 
 // RecordModifier provides methods for setting record-values to storage.
@@ -72,6 +104,7 @@ type RecordMemory struct {
 
 	lock     sync.RWMutex
 	recsStor map[insolar.ID]record.Material
+	results  map[insolar.ID]insolar.ID
 }
 
 // NewRecordMemory creates a new instance of RecordMemory storage.
@@ -79,6 +112,7 @@ func NewRecordMemory() *RecordMemory {
 	ji := store.NewJetIndex()
 	return &RecordMemory{
 		recsStor:         map[insolar.ID]record.Material{},
+		results:          map[insolar.ID]insolar.ID{},
 		jetIndex:         ji,
 		jetIndexAccessor: ji,
 	}
@@ -96,6 +130,9 @@ func (m *RecordMemory) Set(ctx context.Context, id insolar.ID, rec record.Materi
 
 	m.recsStor[id] = rec
 	m.jetIndex.Add(id, rec.JetID)
+	if res := resultRecord(rec); res != nil {
+		m.results[*res.Request.Record()] = id
+	}
 
 	stats.Record(ctx,
 		statRecordInMemoryAddedCount.M(1),
@@ -118,6 +155,19 @@ func (m *RecordMemory) ForID(ctx context.Context, id insolar.ID) (rec record.Mat
 	return
 }
 
+// ResultForRequest returns id of the result record, that closes provided request.
+func (m *RecordMemory) ResultForRequest(ctx context.Context, request insolar.ID) (insolar.ID, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	id, ok := m.results[request]
+	if !ok {
+		return insolar.ID{}, ErrNotFound
+	}
+
+	return id, nil
+}
+
 // ForPulse returns []MaterialRecord for a provided jetID and a pulse number.
 func (m *RecordMemory) ForPulse(
 	ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber,
@@ -149,6 +199,9 @@ func (m *RecordMemory) DeleteForPN(ctx context.Context, pulse insolar.PulseNumbe
 
 		m.jetIndex.Delete(id, rec.JetID)
 		delete(m.recsStor, id)
+		if res := resultRecord(rec); res != nil {
+			delete(m.results, *res.Request.Record())
+		}
 
 		stats.Record(ctx,
 			statRecordInMemoryRemovedCount.M(1),
@@ -156,6 +209,17 @@ func (m *RecordMemory) DeleteForPN(ctx context.Context, pulse insolar.PulseNumbe
 	}
 }
 
+func resultRecord(rec record.Material) *record.Result {
+	if rec.Virtual == nil {
+		return nil
+	}
+	res, ok := record.Unwrap(rec.Virtual).(*record.Result)
+	if !ok {
+		return nil
+	}
+	return res
+}
+
 // RecordDB is a DB storage implementation. It saves records to disk and does not allow removal.
 type RecordDB struct {
 	lock sync.RWMutex
@@ -173,6 +237,50 @@ func (k recordKey) ID() []byte {
 	return (&res).Bytes()
 }
 
+type resultKey insolar.ID
+
+func (k resultKey) Scope() store.Scope {
+	return store.ScopeResult
+}
+
+func (k resultKey) ID() []byte {
+	res := insolar.ID(k)
+	return (&res).Bytes()
+}
+
+// eventKey orders events of an object by name and then by result record id, so events with the same name are stored
+// in order of pulses.
+type eventKey struct {
+	obj      insolar.ID
+	name     string
+	result   insolar.ID
+	position uint32
+}
+
+func (k eventKey) Scope() store.Scope {
+	return store.ScopeEvent
+}
+
+func (k eventKey) ID() []byte {
+	res := append(eventPrefix(k.obj, k.name), k.result.Bytes()...)
+	pos := make([]byte, 4)
+	binary.BigEndian.PutUint32(pos, k.position)
+	return append(res, pos...)
+}
+
+func eventPrefix(obj insolar.ID, name string) []byte {
+	res := append(obj.Bytes(), byte(len(name)))
+	return append(res, name...)
+}
+
+func newEventKey(obj insolar.ID, name string, raw []byte) eventKey {
+	k := eventKey{obj: obj, name: name}
+	raw = raw[len(eventPrefix(obj, name)):]
+	copy(k.result[:], raw[:insolar.RecordIDSize])
+	k.position = binary.BigEndian.Uint32(raw[insolar.RecordIDSize:])
+	return k
+}
+
 // NewRecordDB creates new DB storage instance.
 func NewRecordDB(db store.DB) *RecordDB {
 	return &RecordDB{db: db}
@@ -183,7 +291,64 @@ func (r *RecordDB) Set(ctx context.Context, id insolar.ID, rec record.Material)
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
-	return r.set(id, rec)
+	return r.set(ctx, id, rec)
+}
+
+// ResultForRequest returns id of the result record, that closes provided request.
+func (r *RecordDB) ResultForRequest(ctx context.Context, request insolar.ID) (insolar.ID, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	buff, err := r.db.Get(resultKey(request))
+	if err == store.ErrNotFound {
+		return insolar.ID{}, ErrNotFound
+	}
+	if err != nil {
+		return insolar.ID{}, err
+	}
+
+	var id insolar.ID
+	err = id.Unmarshal(buff)
+	return id, err
+}
+
+// EventsForObject returns events with provided name, that were emitted by the object starting from provided pulse.
+// Events of one pulse are never split, so more than limit events can be returned. Next is a pulse to continue from,
+// it's zero when there are no more events.
+func (r *RecordDB) EventsForObject(
+	ctx context.Context, obj insolar.ID, name string, from insolar.PulseNumber, limit int,
+) ([]Event, insolar.PulseNumber, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	prefix := eventPrefix(obj, name)
+	it := r.db.NewIterator(store.ScopeEvent, prefix)
+	defer it.Close()
+	it.Seek(append(prefix, from.Bytes()...))
+
+	var events []Event
+	for it.Next() {
+		key := newEventKey(obj, name, it.Key())
+		pn := key.result.Pulse()
+		if len(events) >= limit && pn != events[len(events)-1].Result.Pulse() {
+			return events, pn, nil
+		}
+
+		buf, err := it.Value()
+		if err != nil {
+			return nil, 0, err
+		}
+		event := Event{
+			Result:   key.result,
+			Position: key.position,
+			Name:     name,
+			Data:     buf[insolar.RecordRefSize:],
+		}
+		copy(event.Request[:], buf[:insolar.RecordRefSize])
+		events = append(events, event)
+	}
+
+	return events, 0, nil
 }
 
 // ForID returns record for provided id.
@@ -194,7 +359,7 @@ func (r *RecordDB) ForID(ctx context.Context, id insolar.ID) (record.Material, e
 	return r.get(id)
 }
 
-func (r *RecordDB) set(id insolar.ID, rec record.Material) error {
+func (r *RecordDB) set(ctx context.Context, id insolar.ID, rec record.Material) error {
 	key := recordKey(id)
 
 	_, err := r.db.Get(key)
@@ -207,7 +372,45 @@ func (r *RecordDB) set(id insolar.ID, rec record.Material) error {
 		return err
 	}
 
-	return r.db.Set(key, data)
+	res := resultRecord(rec)
+	if res == nil {
+		return r.db.Set(key, data)
+	}
+
+	batch := store.NewBatch()
+	batch.Set(key, data)
+	batch.Set(resultKey(*res.Request.Record()), id.Bytes())
+
+	// Result is saved even if its events are broken, because the request is closed by it anyway.
+	events, err := resultEvents(res)
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrapf(err, "failed to index events of result %s", id.DebugString()))
+	}
+	for i, e := range events {
+		batch.Set(
+			eventKey{obj: res.Object, name: e.Name, result: id, position: uint32(i)},
+			append(res.Request.Bytes(), e.Data...),
+		)
+	}
+	return r.db.Write(batch)
+}
+
+func resultEvents(res *record.Result) ([]insolar.ContractEvent, error) {
+	if len(res.Events) == 0 {
+		return nil, nil
+	}
+
+	var events []insolar.ContractEvent
+	err := insolar.Deserialize(res.Events, &events)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to deserialize events")
+	}
+	for _, e := range events {
+		if e.Name == "" || len(e.Name) > insolar.MaxContractEventNameLength {
+			return nil, errors.Errorf("invalid event name %q", e.Name)
+		}
+	}
+	return events, nil
 }
 
 func (r *RecordDB) get(id insolar.ID) (record.Material, error) {
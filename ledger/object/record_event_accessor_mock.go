@@ -0,0 +1,272 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "RecordEventAccessor" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//RecordEventAccessorMock implements github.com/insolar/insolar/ledger/object.RecordEventAccessor
+type RecordEventAccessorMock struct {
+	t minimock.Tester
+
+	EventsForObjectFunc       func(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 int) (r []Event, r1 insolar.PulseNumber, r2 error)
+	EventsForObjectCounter    uint64
+	EventsForObjectPreCounter uint64
+	EventsForObjectMock       mRecordEventAccessorMockEventsForObject
+}
+
+//NewRecordEventAccessorMock returns a mock for github.com/insolar/insolar/ledger/object.RecordEventAccessor
+func NewRecordEventAccessorMock(t minimock.Tester) *RecordEventAccessorMock {
+	m := &RecordEventAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.EventsForObjectMock = mRecordEventAccessorMockEventsForObject{mock: m}
+
+	return m
+}
+
+type mRecordEventAccessorMockEventsForObject struct {
+	mock              *RecordEventAccessorMock
+	mainExpectation   *RecordEventAccessorMockEventsForObjectExpectation
+	expectationSeries []*RecordEventAccessorMockEventsForObjectExpectation
+}
+
+type RecordEventAccessorMockEventsForObjectExpectation struct {
+	input  *RecordEventAccessorMockEventsForObjectInput
+	result *RecordEventAccessorMockEventsForObjectResult
+}
+
+type RecordEventAccessorMockEventsForObjectInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 string
+	p3 insolar.PulseNumber
+	p4 int
+}
+
+type RecordEventAccessorMockEventsForObjectResult struct {
+	r  []Event
+	r1 insolar.PulseNumber
+	r2 error
+}
+
+//Expect specifies that invocation of RecordEventAccessor.EventsForObject is expected from 1 to Infinity times
+func (m *mRecordEventAccessorMockEventsForObject) Expect(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 int) *mRecordEventAccessorMockEventsForObject {
+	m.mock.EventsForObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecordEventAccessorMockEventsForObjectExpectation{}
+	}
+	m.mainExpectation.input = &RecordEventAccessorMockEventsForObjectInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of RecordEventAccessor.EventsForObject
+func (m *mRecordEventAccessorMockEventsForObject) Return(r []Event, r1 insolar.PulseNumber, r2 error) *RecordEventAccessorMock {
+	m.mock.EventsForObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecordEventAccessorMockEventsForObjectExpectation{}
+	}
+	m.mainExpectation.result = &RecordEventAccessorMockEventsForObjectResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of RecordEventAccessor.EventsForObject is expected once
+func (m *mRecordEventAccessorMockEventsForObject) ExpectOnce(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 int) *RecordEventAccessorMockEventsForObjectExpectation {
+	m.mock.EventsForObjectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RecordEventAccessorMockEventsForObjectExpectation{}
+	expectation.input = &RecordEventAccessorMockEventsForObjectInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RecordEventAccessorMockEventsForObjectExpectation) Return(r []Event, r1 insolar.PulseNumber, r2 error) {
+	e.result = &RecordEventAccessorMockEventsForObjectResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of RecordEventAccessor.EventsForObject method
+func (m *mRecordEventAccessorMockEventsForObject) Set(f func(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 int) (r []Event, r1 insolar.PulseNumber, r2 error)) *RecordEventAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.EventsForObjectFunc = f
+	return m.mock
+}
+
+//EventsForObject implements github.com/insolar/insolar/ledger/object.RecordEventAccessor interface
+func (m *RecordEventAccessorMock) EventsForObject(p context.Context, p1 insolar.ID, p2 string, p3 insolar.PulseNumber, p4 int) (r []Event, r1 insolar.PulseNumber, r2 error) {
+	counter := atomic.AddUint64(&m.EventsForObjectPreCounter, 1)
+	defer atomic.AddUint64(&m.EventsForObjectCounter, 1)
+
+	if len(m.EventsForObjectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.EventsForObjectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RecordEventAccessorMock.EventsForObject. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.EventsForObjectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RecordEventAccessorMockEventsForObjectInput{p, p1, p2, p3, p4}, "RecordEventAccessor.EventsForObject got unexpected parameters")
+
+		result := m.EventsForObjectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecordEventAccessorMock.EventsForObject")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.EventsForObjectMock.mainExpectation != nil {
+
+		input := m.EventsForObjectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RecordEventAccessorMockEventsForObjectInput{p, p1, p2, p3, p4}, "RecordEventAccessor.EventsForObject got unexpected parameters")
+		}
+
+		result := m.EventsForObjectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecordEventAccessorMock.EventsForObject")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.EventsForObjectFunc == nil {
+		m.t.Fatalf("Unexpected call to RecordEventAccessorMock.EventsForObject. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.EventsForObjectFunc(p, p1, p2, p3, p4)
+}
+
+//EventsForObjectMinimockCounter returns a count of RecordEventAccessorMock.EventsForObjectFunc invocations
+func (m *RecordEventAccessorMock) EventsForObjectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.EventsForObjectCounter)
+}
+
+//EventsForObjectMinimockPreCounter returns the value of RecordEventAccessorMock.EventsForObject invocations
+func (m *RecordEventAccessorMock) EventsForObjectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.EventsForObjectPreCounter)
+}
+
+//EventsForObjectFinished returns true if mock invocations count is ok
+func (m *RecordEventAccessorMock) EventsForObjectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.EventsForObjectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.EventsForObjectCounter) == uint64(len(m.EventsForObjectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.EventsForObjectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.EventsForObjectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.EventsForObjectFunc != nil {
+		return atomic.LoadUint64(&m.EventsForObjectCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecordEventAccessorMock) ValidateCallCounters() {
+
+	if !m.EventsForObjectFinished() {
+		m.t.Fatal("Expected call to RecordEventAccessorMock.EventsForObject")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecordEventAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *RecordEventAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *RecordEventAccessorMock) MinimockFinish() {
+
+	if !m.EventsForObjectFinished() {
+		m.t.Fatal("Expected call to RecordEventAccessorMock.EventsForObject")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *RecordEventAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *RecordEventAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.EventsForObjectFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.EventsForObjectFinished() {
+				m.t.Error("Expected call to RecordEventAccessorMock.EventsForObject")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *RecordEventAccessorMock) AllMocksCalled() bool {
+
+	if !m.EventsForObjectFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,266 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "RecordResultAccessor" can be found in github.com/insolar/insolar/ledger/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//RecordResultAccessorMock implements github.com/insolar/insolar/ledger/object.RecordResultAccessor
+type RecordResultAccessorMock struct {
+	t minimock.Tester
+
+	ResultForRequestFunc       func(p context.Context, p1 insolar.ID) (r insolar.ID, r1 error)
+	ResultForRequestCounter    uint64
+	ResultForRequestPreCounter uint64
+	ResultForRequestMock       mRecordResultAccessorMockResultForRequest
+}
+
+//NewRecordResultAccessorMock returns a mock for github.com/insolar/insolar/ledger/object.RecordResultAccessor
+func NewRecordResultAccessorMock(t minimock.Tester) *RecordResultAccessorMock {
+	m := &RecordResultAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ResultForRequestMock = mRecordResultAccessorMockResultForRequest{mock: m}
+
+	return m
+}
+
+type mRecordResultAccessorMockResultForRequest struct {
+	mock              *RecordResultAccessorMock
+	mainExpectation   *RecordResultAccessorMockResultForRequestExpectation
+	expectationSeries []*RecordResultAccessorMockResultForRequestExpectation
+}
+
+type RecordResultAccessorMockResultForRequestExpectation struct {
+	input  *RecordResultAccessorMockResultForRequestInput
+	result *RecordResultAccessorMockResultForRequestResult
+}
+
+type RecordResultAccessorMockResultForRequestInput struct {
+	p  context.Context
+	p1 insolar.ID
+}
+
+type RecordResultAccessorMockResultForRequestResult struct {
+	r  insolar.ID
+	r1 error
+}
+
+//Expect specifies that invocation of RecordResultAccessor.ResultForRequest is expected from 1 to Infinity times
+func (m *mRecordResultAccessorMockResultForRequest) Expect(p context.Context, p1 insolar.ID) *mRecordResultAccessorMockResultForRequest {
+	m.mock.ResultForRequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecordResultAccessorMockResultForRequestExpectation{}
+	}
+	m.mainExpectation.input = &RecordResultAccessorMockResultForRequestInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of RecordResultAccessor.ResultForRequest
+func (m *mRecordResultAccessorMockResultForRequest) Return(r insolar.ID, r1 error) *RecordResultAccessorMock {
+	m.mock.ResultForRequestFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecordResultAccessorMockResultForRequestExpectation{}
+	}
+	m.mainExpectation.result = &RecordResultAccessorMockResultForRequestResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of RecordResultAccessor.ResultForRequest is expected once
+func (m *mRecordResultAccessorMockResultForRequest) ExpectOnce(p context.Context, p1 insolar.ID) *RecordResultAccessorMockResultForRequestExpectation {
+	m.mock.ResultForRequestFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RecordResultAccessorMockResultForRequestExpectation{}
+	expectation.input = &RecordResultAccessorMockResultForRequestInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RecordResultAccessorMockResultForRequestExpectation) Return(r insolar.ID, r1 error) {
+	e.result = &RecordResultAccessorMockResultForRequestResult{r, r1}
+}
+
+//Set uses given function f as a mock of RecordResultAccessor.ResultForRequest method
+func (m *mRecordResultAccessorMockResultForRequest) Set(f func(p context.Context, p1 insolar.ID) (r insolar.ID, r1 error)) *RecordResultAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ResultForRequestFunc = f
+	return m.mock
+}
+
+//ResultForRequest implements github.com/insolar/insolar/ledger/object.RecordResultAccessor interface
+func (m *RecordResultAccessorMock) ResultForRequest(p context.Context, p1 insolar.ID) (r insolar.ID, r1 error) {
+	counter := atomic.AddUint64(&m.ResultForRequestPreCounter, 1)
+	defer atomic.AddUint64(&m.ResultForRequestCounter, 1)
+
+	if len(m.ResultForRequestMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ResultForRequestMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RecordResultAccessorMock.ResultForRequest. %v %v", p, p1)
+			return
+		}
+
+		input := m.ResultForRequestMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RecordResultAccessorMockResultForRequestInput{p, p1}, "RecordResultAccessor.ResultForRequest got unexpected parameters")
+
+		result := m.ResultForRequestMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecordResultAccessorMock.ResultForRequest")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ResultForRequestMock.mainExpectation != nil {
+
+		input := m.ResultForRequestMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RecordResultAccessorMockResultForRequestInput{p, p1}, "RecordResultAccessor.ResultForRequest got unexpected parameters")
+		}
+
+		result := m.ResultForRequestMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecordResultAccessorMock.ResultForRequest")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ResultForRequestFunc == nil {
+		m.t.Fatalf("Unexpected call to RecordResultAccessorMock.ResultForRequest. %v %v", p, p1)
+		return
+	}
+
+	return m.ResultForRequestFunc(p, p1)
+}
+
+//ResultForRequestMinimockCounter returns a count of RecordResultAccessorMock.ResultForRequestFunc invocations
+func (m *RecordResultAccessorMock) ResultForRequestMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ResultForRequestCounter)
+}
+
+//ResultForRequestMinimockPreCounter returns the value of RecordResultAccessorMock.ResultForRequest invocations
+func (m *RecordResultAccessorMock) ResultForRequestMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ResultForRequestPreCounter)
+}
+
+//ResultForRequestFinished returns true if mock invocations count is ok
+func (m *RecordResultAccessorMock) ResultForRequestFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ResultForRequestMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ResultForRequestCounter) == uint64(len(m.ResultForRequestMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ResultForRequestMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ResultForRequestCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ResultForRequestFunc != nil {
+		return atomic.LoadUint64(&m.ResultForRequestCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecordResultAccessorMock) ValidateCallCounters() {
+
+	if !m.ResultForRequestFinished() {
+		m.t.Fatal("Expected call to RecordResultAccessorMock.ResultForRequest")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecordResultAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *RecordResultAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *RecordResultAccessorMock) MinimockFinish() {
+
+	if !m.ResultForRequestFinished() {
+		m.t.Fatal("Expected call to RecordResultAccessorMock.ResultForRequest")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *RecordResultAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *RecordResultAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ResultForRequestFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ResultForRequestFinished() {
+				m.t.Error("Expected call to RecordResultAccessorMock.ResultForRequest")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *RecordResultAccessorMock) AllMocksCalled() bool {
+
+	if !m.ResultForRequestFinished() {
+		return false
+	}
+
+	return true
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -182,6 +183,134 @@ func TestRecordStorage_ForPulse(t *testing.T) {
 	}
 }
 
+func TestRecordStorage_ResultForRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+
+	pn := gen.PulseNumber()
+	request := gen.Reference()
+	id := insolar.NewID(pn, gen.ID().Hash())
+	rec := record.Material{
+		Virtual: &record.Virtual{
+			Union: &record.Virtual_Result{
+				Result: &record.Result{Request: request},
+			},
+		},
+		JetID: gen.JetID(),
+	}
+
+	t.Run("memory storage indexes and cleans results", func(t *testing.T) {
+		t.Parallel()
+
+		recordStorage := NewRecordMemory()
+		_, err := recordStorage.ResultForRequest(ctx, *request.Record())
+		assert.Equal(t, ErrNotFound, err)
+
+		err = recordStorage.Set(ctx, *id, rec)
+		require.NoError(t, err)
+		err = recordStorage.Set(ctx, gen.ID(), getMaterialRecord())
+		require.NoError(t, err)
+
+		resultID, err := recordStorage.ResultForRequest(ctx, *request.Record())
+		require.NoError(t, err)
+		assert.Equal(t, *id, resultID)
+
+		recordStorage.DeleteForPN(ctx, pn)
+		_, err = recordStorage.ResultForRequest(ctx, *request.Record())
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("db storage indexes results", func(t *testing.T) {
+		t.Parallel()
+
+		recordStorage := NewRecordDB(store.NewMemoryMockDB())
+		_, err := recordStorage.ResultForRequest(ctx, *request.Record())
+		assert.Equal(t, ErrNotFound, err)
+
+		err = recordStorage.Set(ctx, *id, rec)
+		require.NoError(t, err)
+
+		resultID, err := recordStorage.ResultForRequest(ctx, *request.Record())
+		require.NoError(t, err)
+		assert.Equal(t, *id, resultID)
+	})
+}
+
+func TestRecordDB_EventsForObject(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	recordStorage := NewRecordDB(store.NewMemoryMockDB())
+
+	obj := gen.ID()
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+	setResult := func(pn insolar.PulseNumber, events ...insolar.ContractEvent) (insolar.ID, insolar.Reference) {
+		data, err := insolar.Serialize(events)
+		require.NoError(t, err)
+		request := gen.Reference()
+		id := insolar.NewID(pn, gen.ID().Hash())
+		rec := record.Material{
+			Virtual: &record.Virtual{
+				Union: &record.Virtual_Result{
+					Result: &record.Result{Object: obj, Request: request, Events: data},
+				},
+			},
+			JetID: gen.JetID(),
+		}
+		err = recordStorage.Set(ctx, *id, rec)
+		require.NoError(t, err)
+		return *id, request
+	}
+
+	first, request := setResult(pn,
+		insolar.ContractEvent{Name: "Transfer", Data: []byte{1}},
+		insolar.ContractEvent{Name: "Burn", Data: []byte{2}},
+		insolar.ContractEvent{Name: "Transfer", Data: []byte{3}},
+	)
+	setResult(pn+1, insolar.ContractEvent{Name: "Transfer", Data: []byte{4}})
+	setResult(pn+2, insolar.ContractEvent{Name: "Transfer", Data: []byte{5}})
+
+	t.Run("returns events of all pulses", func(t *testing.T) {
+		events, next, err := recordStorage.EventsForObject(ctx, obj, "Transfer", pn, 10)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(events))
+		assert.Equal(t, insolar.PulseNumber(0), next)
+		assert.Equal(t, Event{Request: request, Result: first, Position: 0, Name: "Transfer", Data: []byte{1}}, events[0])
+		assert.Equal(t, Event{Request: request, Result: first, Position: 2, Name: "Transfer", Data: []byte{3}}, events[1])
+		assert.Equal(t, []byte{4}, events[2].Data)
+		assert.Equal(t, []byte{5}, events[3].Data)
+	})
+
+	t.Run("does not split pulse", func(t *testing.T) {
+		events, next, err := recordStorage.EventsForObject(ctx, obj, "Transfer", pn, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(events))
+		assert.Equal(t, pn+1, next)
+
+		events, next, err = recordStorage.EventsForObject(ctx, obj, "Transfer", next, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, []byte{4}, events[0].Data)
+		assert.Equal(t, pn+2, next)
+	})
+
+	t.Run("filters by name and object", func(t *testing.T) {
+		events, _, err := recordStorage.EventsForObject(ctx, obj, "Burn", pn, 10)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, uint32(1), events[0].Position)
+
+		events, _, err = recordStorage.EventsForObject(ctx, obj, "Transfe", pn, 10)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+
+		events, _, err = recordStorage.EventsForObject(ctx, gen.ID(), "Transfer", pn, 10)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
+
 // getVirtualRecord generates random Virtual record
 func getVirtualRecord() record.Virtual {
 	var requestRecord record.Request
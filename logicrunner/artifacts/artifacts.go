@@ -35,8 +35,10 @@ type Client interface {
 	// When fetching object, validity can be specified.
 	RegisterValidation(ctx context.Context, object insolar.Reference, state insolar.ID, isValid bool, validationMessages []insolar.Message) error
 
-	// RegisterResult saves VM method call result.
-	RegisterResult(ctx context.Context, object, request insolar.Reference, payload []byte) (*insolar.ID, error)
+	// RegisterResult saves VM method call result and events, that were emitted by the call.
+	RegisterResult(
+		ctx context.Context, object, request insolar.Reference, payload []byte, events []insolar.ContractEvent,
+	) (*insolar.ID, error)
 
 	// GetCode returns code from code record by provided reference according to provided machine preference.
 	//
@@ -49,9 +51,38 @@ type Client interface {
 	// provide methods for fetching all related data.
 	GetObject(ctx context.Context, head insolar.Reference) (ObjectDescriptor, error)
 
+	// GetHistory returns object states from newest to oldest, starting from provided state.
+	//
+	// If provided state is nil, history starts from the latest state. At most limit states are returned. Returned
+	// next state should be passed to the next call, it's nil when the first state of the object is reached.
+	GetHistory(
+		ctx context.Context,
+		head insolar.Reference,
+		from *insolar.ID,
+		limit int,
+	) (states []ObjectState, next *insolar.ID, err error)
+
+	// GetEvents returns events with provided name, that were emitted by the object starting from provided pulse.
+	//
+	// Events are returned in order of pulses. Events of one pulse are never split, so more than limit events can be
+	// returned. Returned next pulse should be passed to the next call, it's zero when there are no more saved events.
+	// Events are indexed by heavy, so they become available after replication of the pulse.
+	GetEvents(
+		ctx context.Context,
+		object insolar.Reference,
+		name string,
+		from insolar.PulseNumber,
+		limit int,
+	) (events []Event, next insolar.PulseNumber, err error)
+
 	// GetPendingRequest returns a pending request for object.
 	GetPendingRequest(ctx context.Context, objectID insolar.ID) (insolar.Parcel, error)
 
+	// GetResult returns result record, that closes provided request.
+	//
+	// If the request is not closed yet, insolar.ErrNoResult will be returned.
+	GetResult(ctx context.Context, request insolar.Reference) (*record.Result, error)
+
 	// HasPendingRequests returns true if object has unclosed requests.
 	HasPendingRequests(ctx context.Context, object insolar.Reference) (bool, error)
 
@@ -120,6 +151,18 @@ type Client interface {
 		memory []byte,
 	) (ObjectDescriptor, error)
 
+	// MigrateObject creates amend object record in storage, that moves the object to provided prototype. Provided
+	// reference should be a reference to the head of the object. Provided memory well be the new object memory.
+	//
+	// Returned reference will be the latest object state (exact) reference.
+	MigrateObject(
+		ctx context.Context,
+		domain, request insolar.Reference,
+		obj ObjectDescriptor,
+		prototype insolar.Reference,
+		memory []byte,
+	) (ObjectDescriptor, error)
+
 	// DeactivateObject creates deactivate object record in storage. Provided reference should be a reference to the head
 	// of the object. If object is already deactivated, an error should be returned.
 	//
@@ -173,6 +216,32 @@ type ObjectDescriptor interface {
 	Parent() *insolar.Reference
 }
 
+// ObjectState is a state of an object in its history.
+type ObjectState struct {
+	// ID is a state record id. Pulse of the state is a pulse of the id.
+	ID insolar.ID
+	// Request is a reference to the request, that produced the state.
+	Request *insolar.Reference
+	// Prototype is a prototype of the object in the state.
+	Prototype *insolar.Reference
+	// Memory is a memory of the object in the state.
+	Memory []byte
+}
+
+// Event is a contract event, that was saved with a result of a request.
+type Event struct {
+	// Request is a reference to the request, that emitted the event.
+	Request insolar.Reference
+	// Result is an id of the result record, that holds the event. Pulse of the event is a pulse of the id.
+	Result insolar.ID
+	// Position is a position of the event among events of the result.
+	Position uint32
+	// Name is a name of the event.
+	Name string
+	// Data is a serialized payload of the event.
+	Data []byte
+}
+
 // RefIterator is used for iteration over affined children(parts) of container.
 type RefIterator interface {
 	Next() (*insolar.Reference, error)
@@ -206,6 +206,73 @@ func (m *client) GetObject(
 	}
 }
 
+// GetHistory returns object states from newest to oldest, starting from provided state.
+//
+// States are fetched one by one following previous state pointers, so each state is requested from the node, that
+// holds its pulse.
+func (m *client) GetHistory(
+	ctx context.Context,
+	head insolar.Reference,
+	from *insolar.ID,
+	limit int,
+) ([]ObjectState, *insolar.ID, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetHistory")
+	instrumenter := instrument(ctx, "GetHistory").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	if limit <= 0 {
+		err = errors.New("limit should be positive")
+		return nil, nil, err
+	}
+
+	sender := messagebus.BuildSender(
+		m.DefaultBus.Send,
+		messagebus.RetryIncorrectPulse(m.PulseAccessor),
+		messagebus.FollowRedirectSender(m.DefaultBus),
+		messagebus.RetryJetSender(m.JetStorage),
+	)
+
+	var states []ObjectState
+	next := from
+	for len(states) < limit {
+		var genericReply insolar.Reply
+		genericReply, err = sender(ctx, &message.GetObject{Head: head, State: next}, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		switch r := genericReply.(type) {
+		case *reply.Object:
+			states = append(states, ObjectState{
+				ID:        r.State,
+				Request:   r.Request,
+				Prototype: r.Prototype,
+				Memory:    r.Memory,
+			})
+			next = r.PrevState
+		case *reply.Error:
+			err = r.Error()
+			return nil, nil, err
+		default:
+			err = fmt.Errorf("GetHistory: unexpected reply: %#v", genericReply)
+			return nil, nil, err
+		}
+
+		if next == nil {
+			break
+		}
+	}
+
+	return states, next, nil
+}
+
 // GetPendingRequest returns an unclosed pending request
 // It takes an id from current LME
 // Then goes either to a light node or heavy node
@@ -291,6 +358,179 @@ func (m *client) GetPendingRequest(ctx context.Context, objectID insolar.ID) (in
 	}
 }
 
+// GetResult returns result record, that closes provided request.
+//
+// Result is saved by the node, that was executor for the request jet at the moment of registration, so executors
+// are asked pulse by pulse starting from the request pulse. Pulses beyond light chain limit are served by heavy.
+func (m *client) GetResult(
+	ctx context.Context,
+	request insolar.Reference,
+) (*record.Result, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetResult")
+	instrumenter := instrument(ctx, "GetResult").err(&err)
+	defer func() {
+		if err != nil && err != insolar.ErrNoResult {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	requestID := *request.Record()
+	askedHeavy := false
+	for pn := requestID.Pulse(); ; {
+		var toHeavy bool
+		toHeavy, err = m.JetCoordinator.IsBeyondLimit(ctx, currentPN, pn)
+		if err != nil {
+			return nil, err
+		}
+
+		// Heavy holds results of all pulses beyond limit, so it is asked only once.
+		if !toHeavy || !askedHeavy {
+			askedHeavy = askedHeavy || toHeavy
+
+			var res *record.Result
+			res, err = m.getResult(ctx, requestID, currentPN, pn)
+			if err == nil {
+				return res, nil
+			}
+			if err != insolar.ErrNoResult {
+				return nil, err
+			}
+		}
+
+		if pn >= currentPN {
+			break
+		}
+		var p insolar.Pulse
+		p, err = m.PulseAccessor.ForPulseNumber(ctx, pn)
+		if err != nil {
+			return nil, err
+		}
+		pn = p.NextPulseNumber
+	}
+
+	err = insolar.ErrNoResult
+	return nil, err
+}
+
+func (m *client) getResult(
+	ctx context.Context, requestID insolar.ID, currentPN, pn insolar.PulseNumber,
+) (*record.Result, error) {
+	node, err := m.JetCoordinator.NodeForObject(ctx, requestID, currentPN, pn)
+	if err != nil {
+		return nil, err
+	}
+
+	sender := messagebus.BuildSender(
+		m.DefaultBus.Send,
+		messagebus.RetryIncorrectPulse(m.PulseAccessor),
+	)
+	genericReply, err := sender(ctx, &message.GetResult{Request: requestID}, &insolar.MessageSendOptions{
+		Receiver: node,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := genericReply.(type) {
+	case *reply.Result:
+		rec := record.Virtual{}
+		err = rec.Unmarshal(r.Record)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetResult: can't deserialize record")
+		}
+		res, ok := record.Unwrap(&rec).(*record.Result)
+		if !ok {
+			return nil, fmt.Errorf("GetResult: unexpected record: %#v", rec)
+		}
+		return res, nil
+	case *reply.Error:
+		return nil, r.Error()
+	default:
+		return nil, fmt.Errorf("GetResult: unexpected reply: %#v", genericReply)
+	}
+}
+
+// GetEvents returns events with provided name, that were emitted by the object starting from provided pulse.
+//
+// Events are indexed by heavy, because results of the object requests are spread over all jets.
+func (m *client) GetEvents(
+	ctx context.Context,
+	object insolar.Reference,
+	name string,
+	from insolar.PulseNumber,
+	limit int,
+) ([]Event, insolar.PulseNumber, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetEvents")
+	instrumenter := instrument(ctx, "GetEvents").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	if limit <= 0 {
+		err = errors.New("limit should be positive")
+		return nil, 0, err
+	}
+
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	heavy, err := m.JetCoordinator.Heavy(ctx, currentPN)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sender := messagebus.BuildSender(
+		m.DefaultBus.Send,
+		messagebus.RetryIncorrectPulse(m.PulseAccessor),
+	)
+	genericReply, err := sender(ctx, &message.GetEvents{
+		Object: *object.Record(),
+		Name:   name,
+		From:   from,
+		Limit:  limit,
+	}, &insolar.MessageSendOptions{
+		Receiver: heavy,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch r := genericReply.(type) {
+	case *reply.Events:
+		events := make([]Event, 0, len(r.Events))
+		for _, e := range r.Events {
+			events = append(events, Event{
+				Request:  e.Request,
+				Result:   e.Result,
+				Position: e.Position,
+				Name:     name,
+				Data:     e.Data,
+			})
+		}
+		return events, r.Next, nil
+	case *reply.Error:
+		err = r.Error()
+		return nil, 0, err
+	default:
+		err = fmt.Errorf("GetEvents: unexpected reply: %#v", genericReply)
+		return nil, 0, err
+	}
+}
+
 // HasPendingRequests returns true if object has unclosed requests.
 func (m *client) HasPendingRequests(
 	ctx context.Context,
@@ -617,6 +857,36 @@ func (m *client) UpdateObject(
 	return desc, err
 }
 
+// MigrateObject creates amend object record in storage, that moves the object to provided prototype. Provided
+// reference should be a reference to the head of the object. Provided memory well be the new object memory.
+//
+// Returned reference will be the latest object state (exact) reference.
+func (m *client) MigrateObject(
+	ctx context.Context,
+	domain, request insolar.Reference,
+	object ObjectDescriptor,
+	prototype insolar.Reference,
+	memory []byte,
+) (ObjectDescriptor, error) {
+	var err error
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.MigrateObject")
+	instrumenter := instrument(ctx, "MigrateObject").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	if object.IsPrototype() {
+		err = errors.New("object is not an instance")
+		return nil, err
+	}
+	desc, err := m.updateObject(ctx, domain, request, object, &prototype, memory)
+	return desc, err
+}
+
 // RegisterValidation marks provided object state as approved or disapproved.
 //
 // When fetching object, validity can be specified.
@@ -656,7 +926,7 @@ func (m *client) RegisterValidation(
 
 // RegisterResult saves VM method call result.
 func (m *client) RegisterResult(
-	ctx context.Context, obj, request insolar.Reference, payload []byte,
+	ctx context.Context, obj, request insolar.Reference, payload []byte, events []insolar.ContractEvent,
 ) (*insolar.ID, error) {
 	var err error
 	ctx, span := instracer.StartSpan(ctx, "artifactmanager.RegisterResult")
@@ -674,6 +944,12 @@ func (m *client) RegisterResult(
 		Request: request,
 		Payload: payload,
 	}
+	if len(events) > 0 {
+		res.Events, err = insolar.Serialize(events)
+		if err != nil {
+			return nil, errors.Wrap(err, "RegisterResult: can't serialize events")
+		}
+	}
 	virtRec := record.Wrap(res)
 
 	recid, err := m.setRecord(
@@ -772,21 +1048,16 @@ func (m *client) updateObject(
 	ctx context.Context,
 	domain, request insolar.Reference,
 	obj ObjectDescriptor,
-	code *insolar.Reference,
+	image *insolar.Reference,
 	memory []byte,
 ) (ObjectDescriptor, error) {
-	var (
-		image *insolar.Reference
-		err   error
-	)
-	if obj.IsPrototype() {
-		if code != nil {
-			image = code
-		} else {
+	var err error
+	if image == nil {
+		if obj.IsPrototype() {
 			image, err = obj.Code()
+		} else {
+			image, err = obj.Prototype()
 		}
-	} else {
-		image, err = obj.Prototype()
 	}
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to update object")
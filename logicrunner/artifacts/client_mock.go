@@ -61,6 +61,16 @@ type ClientMock struct {
 	GetDelegatePreCounter uint64
 	GetDelegateMock       mClientMockGetDelegate
 
+	GetEventsFunc       func(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 int) (r []Event, r1 insolar.PulseNumber, r2 error)
+	GetEventsCounter    uint64
+	GetEventsPreCounter uint64
+	GetEventsMock       mClientMockGetEvents
+
+	GetHistoryFunc       func(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []ObjectState, r1 *insolar.ID, r2 error)
+	GetHistoryCounter    uint64
+	GetHistoryPreCounter uint64
+	GetHistoryMock       mClientMockGetHistory
+
 	GetObjectFunc       func(p context.Context, p1 insolar.Reference) (r ObjectDescriptor, r1 error)
 	GetObjectCounter    uint64
 	GetObjectPreCounter uint64
@@ -71,17 +81,27 @@ type ClientMock struct {
 	GetPendingRequestPreCounter uint64
 	GetPendingRequestMock       mClientMockGetPendingRequest
 
+	GetResultFunc       func(p context.Context, p1 insolar.Reference) (r *record.Result, r1 error)
+	GetResultCounter    uint64
+	GetResultPreCounter uint64
+	GetResultMock       mClientMockGetResult
+
 	HasPendingRequestsFunc       func(p context.Context, p1 insolar.Reference) (r bool, r1 error)
 	HasPendingRequestsCounter    uint64
 	HasPendingRequestsPreCounter uint64
 	HasPendingRequestsMock       mClientMockHasPendingRequests
 
+	MigrateObjectFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 ObjectDescriptor, p4 insolar.Reference, p5 []byte) (r ObjectDescriptor, r1 error)
+	MigrateObjectCounter    uint64
+	MigrateObjectPreCounter uint64
+	MigrateObjectMock       mClientMockMigrateObject
+
 	RegisterRequestFunc       func(p context.Context, p1 record.Request) (r *insolar.ID, r1 error)
 	RegisterRequestCounter    uint64
 	RegisterRequestPreCounter uint64
 	RegisterRequestMock       mClientMockRegisterRequest
 
-	RegisterResultFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte) (r *insolar.ID, r1 error)
+	RegisterResultFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) (r *insolar.ID, r1 error)
 	RegisterResultCounter    uint64
 	RegisterResultPreCounter uint64
 	RegisterResultMock       mClientMockRegisterResult
@@ -123,9 +143,13 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.GetChildrenMock = mClientMockGetChildren{mock: m}
 	m.GetCodeMock = mClientMockGetCode{mock: m}
 	m.GetDelegateMock = mClientMockGetDelegate{mock: m}
+	m.GetEventsMock = mClientMockGetEvents{mock: m}
+	m.GetHistoryMock = mClientMockGetHistory{mock: m}
 	m.GetObjectMock = mClientMockGetObject{mock: m}
 	m.GetPendingRequestMock = mClientMockGetPendingRequest{mock: m}
+	m.GetResultMock = mClientMockGetResult{mock: m}
 	m.HasPendingRequestsMock = mClientMockHasPendingRequests{mock: m}
+	m.MigrateObjectMock = mClientMockMigrateObject{mock: m}
 	m.RegisterRequestMock = mClientMockRegisterRequest{mock: m}
 	m.RegisterResultMock = mClientMockRegisterResult{mock: m}
 	m.RegisterValidationMock = mClientMockRegisterValidation{mock: m}
@@ -1362,6 +1386,319 @@ func (m *ClientMock) GetDelegateFinished() bool {
 	return true
 }
 
+type mClientMockGetEvents struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetEventsExpectation
+	expectationSeries []*ClientMockGetEventsExpectation
+}
+
+type ClientMockGetEventsExpectation struct {
+	input  *ClientMockGetEventsInput
+	result *ClientMockGetEventsResult
+}
+
+type ClientMockGetEventsInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 string
+	p3 insolar.PulseNumber
+	p4 int
+}
+
+type ClientMockGetEventsResult struct {
+	r  []Event
+	r1 insolar.PulseNumber
+	r2 error
+}
+
+//Expect specifies that invocation of Client.GetEvents is expected from 1 to Infinity times
+func (m *mClientMockGetEvents) Expect(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 int) *mClientMockGetEvents {
+	m.mock.GetEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetEventsExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetEventsInput{p, p1, p2, p3, p4}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetEvents
+func (m *mClientMockGetEvents) Return(r []Event, r1 insolar.PulseNumber, r2 error) *ClientMock {
+	m.mock.GetEventsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetEventsExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetEventsResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetEvents is expected once
+func (m *mClientMockGetEvents) ExpectOnce(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 int) *ClientMockGetEventsExpectation {
+	m.mock.GetEventsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetEventsExpectation{}
+	expectation.input = &ClientMockGetEventsInput{p, p1, p2, p3, p4}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetEventsExpectation) Return(r []Event, r1 insolar.PulseNumber, r2 error) {
+	e.result = &ClientMockGetEventsResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of Client.GetEvents method
+func (m *mClientMockGetEvents) Set(f func(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 int) (r []Event, r1 insolar.PulseNumber, r2 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetEventsFunc = f
+	return m.mock
+}
+
+//GetEvents implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetEvents(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.PulseNumber, p4 int) (r []Event, r1 insolar.PulseNumber, r2 error) {
+	counter := atomic.AddUint64(&m.GetEventsPreCounter, 1)
+	defer atomic.AddUint64(&m.GetEventsCounter, 1)
+
+	if len(m.GetEventsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetEventsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+			return
+		}
+
+		input := m.GetEventsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetEventsInput{p, p1, p2, p3, p4}, "Client.GetEvents got unexpected parameters")
+
+		result := m.GetEventsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetEvents")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetEventsMock.mainExpectation != nil {
+
+		input := m.GetEventsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetEventsInput{p, p1, p2, p3, p4}, "Client.GetEvents got unexpected parameters")
+		}
+
+		result := m.GetEventsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetEvents")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetEventsFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetEvents. %v %v %v %v %v", p, p1, p2, p3, p4)
+		return
+	}
+
+	return m.GetEventsFunc(p, p1, p2, p3, p4)
+}
+
+//GetEventsMinimockCounter returns a count of ClientMock.GetEventsFunc invocations
+func (m *ClientMock) GetEventsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetEventsCounter)
+}
+
+//GetEventsMinimockPreCounter returns the value of ClientMock.GetEvents invocations
+func (m *ClientMock) GetEventsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetEventsPreCounter)
+}
+
+//GetEventsFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetEventsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetEventsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetEventsCounter) == uint64(len(m.GetEventsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetEventsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetEventsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetEventsFunc != nil {
+		return atomic.LoadUint64(&m.GetEventsCounter) > 0
+	}
+
+	return true
+}
+
+type mClientMockGetHistory struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetHistoryExpectation
+	expectationSeries []*ClientMockGetHistoryExpectation
+}
+
+type ClientMockGetHistoryExpectation struct {
+	input  *ClientMockGetHistoryInput
+	result *ClientMockGetHistoryResult
+}
+
+type ClientMockGetHistoryInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 *insolar.ID
+	p3 int
+}
+
+type ClientMockGetHistoryResult struct {
+	r  []ObjectState
+	r1 *insolar.ID
+	r2 error
+}
+
+//Expect specifies that invocation of Client.GetHistory is expected from 1 to Infinity times
+func (m *mClientMockGetHistory) Expect(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) *mClientMockGetHistory {
+	m.mock.GetHistoryFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetHistoryExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetHistoryInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetHistory
+func (m *mClientMockGetHistory) Return(r []ObjectState, r1 *insolar.ID, r2 error) *ClientMock {
+	m.mock.GetHistoryFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetHistoryExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetHistoryResult{r, r1, r2}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetHistory is expected once
+func (m *mClientMockGetHistory) ExpectOnce(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) *ClientMockGetHistoryExpectation {
+	m.mock.GetHistoryFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetHistoryExpectation{}
+	expectation.input = &ClientMockGetHistoryInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetHistoryExpectation) Return(r []ObjectState, r1 *insolar.ID, r2 error) {
+	e.result = &ClientMockGetHistoryResult{r, r1, r2}
+}
+
+//Set uses given function f as a mock of Client.GetHistory method
+func (m *mClientMockGetHistory) Set(f func(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []ObjectState, r1 *insolar.ID, r2 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetHistoryFunc = f
+	return m.mock
+}
+
+//GetHistory implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetHistory(p context.Context, p1 insolar.Reference, p2 *insolar.ID, p3 int) (r []ObjectState, r1 *insolar.ID, r2 error) {
+	counter := atomic.AddUint64(&m.GetHistoryPreCounter, 1)
+	defer atomic.AddUint64(&m.GetHistoryCounter, 1)
+
+	if len(m.GetHistoryMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetHistoryMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetHistory. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.GetHistoryMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetHistoryInput{p, p1, p2, p3}, "Client.GetHistory got unexpected parameters")
+
+		result := m.GetHistoryMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetHistory")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetHistoryMock.mainExpectation != nil {
+
+		input := m.GetHistoryMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetHistoryInput{p, p1, p2, p3}, "Client.GetHistory got unexpected parameters")
+		}
+
+		result := m.GetHistoryMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetHistory")
+		}
+
+		r = result.r
+		r1 = result.r1
+		r2 = result.r2
+
+		return
+	}
+
+	if m.GetHistoryFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetHistory. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.GetHistoryFunc(p, p1, p2, p3)
+}
+
+//GetHistoryMinimockCounter returns a count of ClientMock.GetHistoryFunc invocations
+func (m *ClientMock) GetHistoryMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetHistoryCounter)
+}
+
+//GetHistoryMinimockPreCounter returns the value of ClientMock.GetHistory invocations
+func (m *ClientMock) GetHistoryMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetHistoryPreCounter)
+}
+
+//GetHistoryFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetHistoryFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetHistoryMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetHistoryCounter) == uint64(len(m.GetHistoryMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetHistoryMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetHistoryCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetHistoryFunc != nil {
+		return atomic.LoadUint64(&m.GetHistoryCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockGetObject struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockGetObjectExpectation
@@ -1664,6 +2001,157 @@ func (m *ClientMock) GetPendingRequestFinished() bool {
 	return true
 }
 
+type mClientMockGetResult struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetResultExpectation
+	expectationSeries []*ClientMockGetResultExpectation
+}
+
+type ClientMockGetResultExpectation struct {
+	input  *ClientMockGetResultInput
+	result *ClientMockGetResultResult
+}
+
+type ClientMockGetResultInput struct {
+	p  context.Context
+	p1 insolar.Reference
+}
+
+type ClientMockGetResultResult struct {
+	r  *record.Result
+	r1 error
+}
+
+//Expect specifies that invocation of Client.GetResult is expected from 1 to Infinity times
+func (m *mClientMockGetResult) Expect(p context.Context, p1 insolar.Reference) *mClientMockGetResult {
+	m.mock.GetResultFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetResultExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetResultInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetResult
+func (m *mClientMockGetResult) Return(r *record.Result, r1 error) *ClientMock {
+	m.mock.GetResultFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetResultExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetResultResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetResult is expected once
+func (m *mClientMockGetResult) ExpectOnce(p context.Context, p1 insolar.Reference) *ClientMockGetResultExpectation {
+	m.mock.GetResultFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetResultExpectation{}
+	expectation.input = &ClientMockGetResultInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetResultExpectation) Return(r *record.Result, r1 error) {
+	e.result = &ClientMockGetResultResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.GetResult method
+func (m *mClientMockGetResult) Set(f func(p context.Context, p1 insolar.Reference) (r *record.Result, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetResultFunc = f
+	return m.mock
+}
+
+//GetResult implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetResult(p context.Context, p1 insolar.Reference) (r *record.Result, r1 error) {
+	counter := atomic.AddUint64(&m.GetResultPreCounter, 1)
+	defer atomic.AddUint64(&m.GetResultCounter, 1)
+
+	if len(m.GetResultMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetResultMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetResult. %v %v", p, p1)
+			return
+		}
+
+		input := m.GetResultMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetResultInput{p, p1}, "Client.GetResult got unexpected parameters")
+
+		result := m.GetResultMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetResult")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetResultMock.mainExpectation != nil {
+
+		input := m.GetResultMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetResultInput{p, p1}, "Client.GetResult got unexpected parameters")
+		}
+
+		result := m.GetResultMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetResult")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetResultFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetResult. %v %v", p, p1)
+		return
+	}
+
+	return m.GetResultFunc(p, p1)
+}
+
+//GetResultMinimockCounter returns a count of ClientMock.GetResultFunc invocations
+func (m *ClientMock) GetResultMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetResultCounter)
+}
+
+//GetResultMinimockPreCounter returns the value of ClientMock.GetResult invocations
+func (m *ClientMock) GetResultMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetResultPreCounter)
+}
+
+//GetResultFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetResultFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetResultMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetResultCounter) == uint64(len(m.GetResultMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetResultMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetResultCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetResultFunc != nil {
+		return atomic.LoadUint64(&m.GetResultCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockHasPendingRequests struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockHasPendingRequestsExpectation
@@ -1815,6 +2303,161 @@ func (m *ClientMock) HasPendingRequestsFinished() bool {
 	return true
 }
 
+type mClientMockMigrateObject struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockMigrateObjectExpectation
+	expectationSeries []*ClientMockMigrateObjectExpectation
+}
+
+type ClientMockMigrateObjectExpectation struct {
+	input  *ClientMockMigrateObjectInput
+	result *ClientMockMigrateObjectResult
+}
+
+type ClientMockMigrateObjectInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.Reference
+	p3 ObjectDescriptor
+	p4 insolar.Reference
+	p5 []byte
+}
+
+type ClientMockMigrateObjectResult struct {
+	r  ObjectDescriptor
+	r1 error
+}
+
+//Expect specifies that invocation of Client.MigrateObject is expected from 1 to Infinity times
+func (m *mClientMockMigrateObject) Expect(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 ObjectDescriptor, p4 insolar.Reference, p5 []byte) *mClientMockMigrateObject {
+	m.mock.MigrateObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockMigrateObjectExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockMigrateObjectInput{p, p1, p2, p3, p4, p5}
+	return m
+}
+
+//Return specifies results of invocation of Client.MigrateObject
+func (m *mClientMockMigrateObject) Return(r ObjectDescriptor, r1 error) *ClientMock {
+	m.mock.MigrateObjectFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockMigrateObjectExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockMigrateObjectResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.MigrateObject is expected once
+func (m *mClientMockMigrateObject) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 ObjectDescriptor, p4 insolar.Reference, p5 []byte) *ClientMockMigrateObjectExpectation {
+	m.mock.MigrateObjectFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockMigrateObjectExpectation{}
+	expectation.input = &ClientMockMigrateObjectInput{p, p1, p2, p3, p4, p5}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockMigrateObjectExpectation) Return(r ObjectDescriptor, r1 error) {
+	e.result = &ClientMockMigrateObjectResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.MigrateObject method
+func (m *mClientMockMigrateObject) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 ObjectDescriptor, p4 insolar.Reference, p5 []byte) (r ObjectDescriptor, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.MigrateObjectFunc = f
+	return m.mock
+}
+
+//MigrateObject implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) MigrateObject(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 ObjectDescriptor, p4 insolar.Reference, p5 []byte) (r ObjectDescriptor, r1 error) {
+	counter := atomic.AddUint64(&m.MigrateObjectPreCounter, 1)
+	defer atomic.AddUint64(&m.MigrateObjectCounter, 1)
+
+	if len(m.MigrateObjectMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.MigrateObjectMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.MigrateObject. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
+			return
+		}
+
+		input := m.MigrateObjectMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockMigrateObjectInput{p, p1, p2, p3, p4, p5}, "Client.MigrateObject got unexpected parameters")
+
+		result := m.MigrateObjectMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.MigrateObject")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.MigrateObjectMock.mainExpectation != nil {
+
+		input := m.MigrateObjectMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockMigrateObjectInput{p, p1, p2, p3, p4, p5}, "Client.MigrateObject got unexpected parameters")
+		}
+
+		result := m.MigrateObjectMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.MigrateObject")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.MigrateObjectFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.MigrateObject. %v %v %v %v %v %v", p, p1, p2, p3, p4, p5)
+		return
+	}
+
+	return m.MigrateObjectFunc(p, p1, p2, p3, p4, p5)
+}
+
+//MigrateObjectMinimockCounter returns a count of ClientMock.MigrateObjectFunc invocations
+func (m *ClientMock) MigrateObjectMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.MigrateObjectCounter)
+}
+
+//MigrateObjectMinimockPreCounter returns the value of ClientMock.MigrateObject invocations
+func (m *ClientMock) MigrateObjectMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.MigrateObjectPreCounter)
+}
+
+//MigrateObjectFinished returns true if mock invocations count is ok
+func (m *ClientMock) MigrateObjectFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.MigrateObjectMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.MigrateObjectCounter) == uint64(len(m.MigrateObjectMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.MigrateObjectMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.MigrateObjectCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.MigrateObjectFunc != nil {
+		return atomic.LoadUint64(&m.MigrateObjectCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockRegisterRequest struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockRegisterRequestExpectation
@@ -1982,6 +2625,7 @@ type ClientMockRegisterResultInput struct {
 	p1 insolar.Reference
 	p2 insolar.Reference
 	p3 []byte
+	p4 []insolar.ContractEvent
 }
 
 type ClientMockRegisterResultResult struct {
@@ -1990,14 +2634,14 @@ type ClientMockRegisterResultResult struct {
 }
 
 //Expect specifies that invocation of Client.RegisterResult is expected from 1 to Infinity times
-func (m *mClientMockRegisterResult) Expect(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte) *mClientMockRegisterResult {
+func (m *mClientMockRegisterResult) Expect(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) *mClientMockRegisterResult {
 	m.mock.RegisterResultFunc = nil
 	m.expectationSeries = nil
 
 	if m.mainExpectation == nil {
 		m.mainExpectation = &ClientMockRegisterResultExpectation{}
 	}
-	m.mainExpectation.input = &ClientMockRegisterResultInput{p, p1, p2, p3}
+	m.mainExpectation.input = &ClientMockRegisterResultInput{p, p1, p2, p3, p4}
 	return m
 }
 
@@ -2014,12 +2658,12 @@ func (m *mClientMockRegisterResult) Return(r *insolar.ID, r1 error) *ClientMock
 }
 
 //ExpectOnce specifies that invocation of Client.RegisterResult is expected once
-func (m *mClientMockRegisterResult) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte) *ClientMockRegisterResultExpectation {
+func (m *mClientMockRegisterResult) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) *ClientMockRegisterResultExpectation {
 	m.mock.RegisterResultFunc = nil
 	m.mainExpectation = nil
 
 	expectation := &ClientMockRegisterResultExpectation{}
-	expectation.input = &ClientMockRegisterResultInput{p, p1, p2, p3}
+	expectation.input = &ClientMockRegisterResultInput{p, p1, p2, p3, p4}
 	m.expectationSeries = append(m.expectationSeries, expectation)
 	return expectation
 }
@@ -2029,7 +2673,7 @@ func (e *ClientMockRegisterResultExpectation) Return(r *insolar.ID, r1 error) {
 }
 
 //Set uses given function f as a mock of Client.RegisterResult method
-func (m *mClientMockRegisterResult) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte) (r *insolar.ID, r1 error)) *ClientMock {
+func (m *mClientMockRegisterResult) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) (r *insolar.ID, r1 error)) *ClientMock {
 	m.mainExpectation = nil
 	m.expectationSeries = nil
 
@@ -2038,18 +2682,18 @@ func (m *mClientMockRegisterResult) Set(f func(p context.Context, p1 insolar.Ref
 }
 
 //RegisterResult implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
-func (m *ClientMock) RegisterResult(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte) (r *insolar.ID, r1 error) {
+func (m *ClientMock) RegisterResult(p context.Context, p1 insolar.Reference, p2 insolar.Reference, p3 []byte, p4 []insolar.ContractEvent) (r *insolar.ID, r1 error) {
 	counter := atomic.AddUint64(&m.RegisterResultPreCounter, 1)
 	defer atomic.AddUint64(&m.RegisterResultCounter, 1)
 
 	if len(m.RegisterResultMock.expectationSeries) > 0 {
 		if counter > uint64(len(m.RegisterResultMock.expectationSeries)) {
-			m.t.Fatalf("Unexpected call to ClientMock.RegisterResult. %v %v %v %v", p, p1, p2, p3)
+			m.t.Fatalf("Unexpected call to ClientMock.RegisterResult. %v %v %v %v %v", p, p1, p2, p3, p4)
 			return
 		}
 
 		input := m.RegisterResultMock.expectationSeries[counter-1].input
-		testify_assert.Equal(m.t, *input, ClientMockRegisterResultInput{p, p1, p2, p3}, "Client.RegisterResult got unexpected parameters")
+		testify_assert.Equal(m.t, *input, ClientMockRegisterResultInput{p, p1, p2, p3, p4}, "Client.RegisterResult got unexpected parameters")
 
 		result := m.RegisterResultMock.expectationSeries[counter-1].result
 		if result == nil {
@@ -2067,7 +2711,7 @@ func (m *ClientMock) RegisterResult(p context.Context, p1 insolar.Reference, p2
 
 		input := m.RegisterResultMock.mainExpectation.input
 		if input != nil {
-			testify_assert.Equal(m.t, *input, ClientMockRegisterResultInput{p, p1, p2, p3}, "Client.RegisterResult got unexpected parameters")
+			testify_assert.Equal(m.t, *input, ClientMockRegisterResultInput{p, p1, p2, p3, p4}, "Client.RegisterResult got unexpected parameters")
 		}
 
 		result := m.RegisterResultMock.mainExpectation.result
@@ -2082,11 +2726,11 @@ func (m *ClientMock) RegisterResult(p context.Context, p1 insolar.Reference, p2
 	}
 
 	if m.RegisterResultFunc == nil {
-		m.t.Fatalf("Unexpected call to ClientMock.RegisterResult. %v %v %v %v", p, p1, p2, p3)
+		m.t.Fatalf("Unexpected call to ClientMock.RegisterResult. %v %v %v %v %v", p, p1, p2, p3, p4)
 		return
 	}
 
-	return m.RegisterResultFunc(p, p1, p2, p3)
+	return m.RegisterResultFunc(p, p1, p2, p3, p4)
 }
 
 //RegisterResultMinimockCounter returns a count of ClientMock.RegisterResultFunc invocations
@@ -2752,6 +3396,14 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.GetDelegate")
 	}
 
+	if !m.GetEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetEvents")
+	}
+
+	if !m.GetHistoryFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetHistory")
+	}
+
 	if !m.GetObjectFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
@@ -2760,10 +3412,18 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.GetPendingRequest")
 	}
 
+	if !m.GetResultFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetResult")
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		m.t.Fatal("Expected call to ClientMock.HasPendingRequests")
 	}
 
+	if !m.MigrateObjectFinished() {
+		m.t.Fatal("Expected call to ClientMock.MigrateObject")
+	}
+
 	if !m.RegisterRequestFinished() {
 		m.t.Fatal("Expected call to ClientMock.RegisterRequest")
 	}
@@ -2837,6 +3497,14 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.GetDelegate")
 	}
 
+	if !m.GetEventsFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetEvents")
+	}
+
+	if !m.GetHistoryFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetHistory")
+	}
+
 	if !m.GetObjectFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
@@ -2845,10 +3513,18 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.GetPendingRequest")
 	}
 
+	if !m.GetResultFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetResult")
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		m.t.Fatal("Expected call to ClientMock.HasPendingRequests")
 	}
 
+	if !m.MigrateObjectFinished() {
+		m.t.Fatal("Expected call to ClientMock.MigrateObject")
+	}
+
 	if !m.RegisterRequestFinished() {
 		m.t.Fatal("Expected call to ClientMock.RegisterRequest")
 	}
@@ -2895,9 +3571,13 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.GetChildrenFinished()
 		ok = ok && m.GetCodeFinished()
 		ok = ok && m.GetDelegateFinished()
+		ok = ok && m.GetEventsFinished()
+		ok = ok && m.GetHistoryFinished()
 		ok = ok && m.GetObjectFinished()
 		ok = ok && m.GetPendingRequestFinished()
+		ok = ok && m.GetResultFinished()
 		ok = ok && m.HasPendingRequestsFinished()
+		ok = ok && m.MigrateObjectFinished()
 		ok = ok && m.RegisterRequestFinished()
 		ok = ok && m.RegisterResultFinished()
 		ok = ok && m.RegisterValidationFinished()
@@ -2944,6 +3624,14 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.GetDelegate")
 			}
 
+			if !m.GetEventsFinished() {
+				m.t.Error("Expected call to ClientMock.GetEvents")
+			}
+
+			if !m.GetHistoryFinished() {
+				m.t.Error("Expected call to ClientMock.GetHistory")
+			}
+
 			if !m.GetObjectFinished() {
 				m.t.Error("Expected call to ClientMock.GetObject")
 			}
@@ -2952,10 +3640,18 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.GetPendingRequest")
 			}
 
+			if !m.GetResultFinished() {
+				m.t.Error("Expected call to ClientMock.GetResult")
+			}
+
 			if !m.HasPendingRequestsFinished() {
 				m.t.Error("Expected call to ClientMock.HasPendingRequests")
 			}
 
+			if !m.MigrateObjectFinished() {
+				m.t.Error("Expected call to ClientMock.MigrateObject")
+			}
+
 			if !m.RegisterRequestFinished() {
 				m.t.Error("Expected call to ClientMock.RegisterRequest")
 			}
@@ -3024,6 +3720,14 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetEventsFinished() {
+		return false
+	}
+
+	if !m.GetHistoryFinished() {
+		return false
+	}
+
 	if !m.GetObjectFinished() {
 		return false
 	}
@@ -3032,10 +3736,18 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetResultFinished() {
+		return false
+	}
+
 	if !m.HasPendingRequestsFinished() {
 		return false
 	}
 
+	if !m.MigrateObjectFinished() {
+		return false
+	}
+
 	if !m.RegisterRequestFinished() {
 		return false
 	}
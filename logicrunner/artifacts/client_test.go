@@ -206,6 +206,180 @@ func (s *amSuite) TestLedgerArtifactManager_GetChildren_FollowsRedirect() {
 	require.NoError(s.T(), err)
 }
 
+func (s *amSuite) TestLedgerArtifactManager_GetHistory() {
+	mc := minimock.NewController(s.T())
+	am := NewClient()
+	mb := testutils.NewMessageBusMock(mc)
+
+	objRef := genRandomRef(0)
+	first := genRandomID(1)
+	second := genRandomID(2)
+	third := genRandomID(3)
+	prev := map[insolar.ID]*insolar.ID{*third: second, *second: first, *first: nil}
+	mb.SendFunc = func(c context.Context, m insolar.Message, o *insolar.MessageSendOptions) (r insolar.Reply, r1 error) {
+		msg, ok := m.(*message.GetObject)
+		require.True(s.T(), ok)
+		state := third
+		if msg.State != nil {
+			state = msg.State
+		}
+		return &reply.Object{
+			Head:      *objRef,
+			State:     *state,
+			Memory:    state.Bytes(),
+			PrevState: prev[*state],
+		}, nil
+	}
+	am.DefaultBus = mb
+
+	pa := pulse.NewAccessorMock(s.T())
+	pa.LatestMock.Return(*insolar.GenesisPulse, nil)
+	am.PulseAccessor = pa
+
+	states, next, err := am.GetHistory(s.ctx, *objRef, nil, 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), states, 2)
+	assert.Equal(s.T(), *third, states[0].ID)
+	assert.Equal(s.T(), third.Bytes(), states[0].Memory)
+	assert.Equal(s.T(), *second, states[1].ID)
+	assert.Equal(s.T(), first, next)
+
+	states, next, err = am.GetHistory(s.ctx, *objRef, next, 2)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), states, 1)
+	assert.Equal(s.T(), *first, states[0].ID)
+	assert.Nil(s.T(), next)
+}
+
+func (s *amSuite) TestLedgerArtifactManager_GetResult() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	am := NewClient()
+	requestPN := insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+	currentPN := requestPN + 10
+	request := genRandomRef(requestPN)
+	resultID := genRandomID(currentPN)
+	firstNode := gen.Reference()
+	secondNode := gen.Reference()
+
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(insolar.Pulse{PulseNumber: currentPN}, nil)
+	pa.ForPulseNumberFunc = func(ctx context.Context, pn insolar.PulseNumber) (insolar.Pulse, error) {
+		require.Equal(s.T(), requestPN, pn)
+		return insolar.Pulse{PulseNumber: pn, NextPulseNumber: currentPN}, nil
+	}
+	am.PulseAccessor = pa
+
+	jc := jet.NewCoordinatorMock(mc)
+	jc.IsBeyondLimitMock.Return(false, nil)
+	jc.NodeForObjectFunc = func(ctx context.Context, objID insolar.ID, rootPN, targetPN insolar.PulseNumber) (*insolar.Reference, error) {
+		require.Equal(s.T(), *request.Record(), objID)
+		if targetPN == requestPN {
+			return &firstNode, nil
+		}
+		return &secondNode, nil
+	}
+	am.JetCoordinator = jc
+
+	virtRec := record.Wrap(record.Result{Request: *request, Payload: []byte{1, 2, 3}})
+	data, err := virtRec.Marshal()
+	require.NoError(s.T(), err)
+
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(c context.Context, m insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+		msg, ok := m.(*message.GetResult)
+		require.True(s.T(), ok)
+		require.Equal(s.T(), *request.Record(), msg.Request)
+		if *o.Receiver == firstNode {
+			return &reply.Error{ErrType: reply.ErrNoResult}, nil
+		}
+		return &reply.Result{ID: *resultID, Record: data}, nil
+	}
+	am.DefaultBus = mb
+
+	res, err := am.GetResult(s.ctx, *request)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []byte{1, 2, 3}, res.Payload)
+	assert.Equal(s.T(), uint64(2), mb.SendCounter)
+}
+
+func (s *amSuite) TestLedgerArtifactManager_GetResult_NoResult() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	am := NewClient()
+	currentPN := insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+	request := genRandomRef(currentPN)
+	node := testutils.RandomRef()
+
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(insolar.Pulse{PulseNumber: currentPN}, nil)
+	am.PulseAccessor = pa
+
+	jc := jet.NewCoordinatorMock(mc)
+	jc.IsBeyondLimitMock.Return(false, nil)
+	jc.NodeForObjectMock.Return(&node, nil)
+	am.JetCoordinator = jc
+
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendMock.Return(&reply.Error{ErrType: reply.ErrNoResult}, nil)
+	am.DefaultBus = mb
+
+	_, err := am.GetResult(s.ctx, *request)
+	assert.Equal(s.T(), insolar.ErrNoResult, err)
+}
+
+func (s *amSuite) TestLedgerArtifactManager_GetEvents() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	am := NewClient()
+	currentPN := insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+	object := genRandomRef(currentPN)
+	request := gen.Reference()
+	resultID := genRandomID(currentPN)
+	heavy := gen.Reference()
+
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(insolar.Pulse{PulseNumber: currentPN}, nil)
+	am.PulseAccessor = pa
+
+	jc := jet.NewCoordinatorMock(mc)
+	jc.HeavyMock.Return(&heavy, nil)
+	am.JetCoordinator = jc
+
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(c context.Context, m insolar.Message, o *insolar.MessageSendOptions) (insolar.Reply, error) {
+		msg, ok := m.(*message.GetEvents)
+		require.True(s.T(), ok)
+		require.Equal(s.T(), *object.Record(), msg.Object)
+		require.Equal(s.T(), "Transfer", msg.Name)
+		require.Equal(s.T(), insolar.PulseNumber(insolar.FirstPulseNumber), msg.From)
+		require.Equal(s.T(), 5, msg.Limit)
+		require.Equal(s.T(), heavy, *o.Receiver)
+		return &reply.Events{
+			Events: []reply.Event{{Request: request, Result: *resultID, Position: 1, Data: []byte{1, 2, 3}}},
+			Next:   currentPN,
+		}, nil
+	}
+	am.DefaultBus = mb
+
+	events, next, err := am.GetEvents(s.ctx, *object, "Transfer", insolar.FirstPulseNumber, 5)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), currentPN, next)
+	assert.Equal(s.T(), []Event{{
+		Request:  request,
+		Result:   *resultID,
+		Position: 1,
+		Name:     "Transfer",
+		Data:     []byte{1, 2, 3},
+	}}, events)
+
+	_, _, err = am.GetEvents(s.ctx, *object, "Transfer", insolar.FirstPulseNumber, 0)
+	assert.Error(s.T(), err)
+}
+
 func (s *amSuite) TestLedgerArtifactManager_RegisterRequest_JetMiss() {
 	mc := minimock.NewController(s.T())
 	defer mc.Finish()
@@ -313,3 +487,58 @@ func (s *amSuite) TestLedgerArtifactManager_GetRequest_Success() {
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), "test", res.Message().(*message.CallMethod).Method)
 }
+
+func TestSuccessionOf(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	predecessor := testutils.RandomRef()
+	memory, err := insolar.Serialize(Succession{Predecessors: []insolar.Reference{predecessor}, MigrationMethod: "Migrate"})
+	require.NoError(t, err)
+
+	prototype := NewObjectDescriptorMock(mc)
+	prototype.MemoryMock.Return(memory)
+	succession, err := SuccessionOf(prototype)
+	require.NoError(t, err)
+	require.Equal(t, "Migrate", succession.MigrationMethod)
+	require.True(t, succession.Succeeds(predecessor))
+	require.False(t, succession.Succeeds(testutils.RandomRef()))
+
+	prototype = NewObjectDescriptorMock(mc)
+	prototype.MemoryMock.Return(nil)
+	succession, err = SuccessionOf(prototype)
+	require.NoError(t, err)
+	require.Nil(t, succession)
+	require.False(t, succession.Succeeds(predecessor))
+}
+
+func TestAuthorizeSuccession(t *testing.T) {
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	ctx := inslogger.TestContext(t)
+	owner := testutils.RandomRef()
+	rootMember := testutils.RandomRef()
+	predecessor := NewObjectDescriptorMock(mc)
+	predecessor.ParentMock.Return(&owner)
+	am := NewClientMock(mc)
+	am.GetObjectMock.Return(predecessor, nil)
+
+	succession := Succession{Predecessors: []insolar.Reference{testutils.RandomRef()}, MigrationMethod: "Migrate"}
+	err := AuthorizeSuccession(ctx, am, succession, rootMember)
+	require.Error(t, err, "declarer is required")
+
+	succession.Declarer = owner
+	err = AuthorizeSuccession(ctx, am, succession, rootMember)
+	require.NoError(t, err)
+
+	// root member may replace any prototype
+	succession.Declarer = rootMember
+	err = AuthorizeSuccession(ctx, am, succession, rootMember)
+	require.NoError(t, err)
+
+	succession.Declarer = testutils.RandomRef()
+	err = AuthorizeSuccession(ctx, am, succession, rootMember)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "doesn't own predecessor")
+}
@@ -0,0 +1,135 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package artifacts
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Succession is a declaration of prototypes, that are replaced by a prototype. It's stored as a memory of the
+// prototype.
+//
+// Objects of predecessor prototypes are migrated to the successor on the first call made through a proxy of the
+// successor, calls made through proxies of predecessors are accepted by migrated objects. Succession is authorized
+// once, when it's declared (see DeclareSuccessor), so stored successions are trusted on migration.
+type Succession struct {
+	// Predecessors are prototypes, objects of which can be migrated to the successor.
+	Predecessors []insolar.Reference
+	// MigrationMethod is a method of the successor, that converts state of a predecessor object. It's called before
+	// the first call of a migrated object. Object state is migrated as is if it's empty.
+	MigrationMethod string
+	// Declarer is a member, that declared the succession.
+	Declarer insolar.Reference
+}
+
+// Succeeds returns true if provided prototype is a predecessor.
+func (s *Succession) Succeeds(prototype insolar.Reference) bool {
+	if s == nil {
+		return false
+	}
+	for _, p := range s.Predecessors {
+		if p.Equal(prototype) {
+			return true
+		}
+	}
+	return false
+}
+
+// SuccessionOf returns succession declared by provided prototype. Nil is returned if the prototype doesn't succeed
+// other prototypes.
+func SuccessionOf(prototype ObjectDescriptor) (*Succession, error) {
+	memory := prototype.Memory()
+	if len(memory) == 0 {
+		return nil, nil
+	}
+
+	succession := Succession{}
+	err := insolar.Deserialize(memory, &succession)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ SuccessionOf ] failed to deserialize succession")
+	}
+	return &succession, nil
+}
+
+// AuthorizeSuccession checks, that the declarer of succession may replace every predecessor: it's the owner (parent)
+// of the predecessor or the root member. Declarer must be verified by the caller (e.g. by signature of request).
+func AuthorizeSuccession(
+	ctx context.Context,
+	am Client,
+	succession Succession,
+	rootMember insolar.Reference,
+) error {
+	if succession.Declarer.IsEmpty() {
+		return errors.New("[ AuthorizeSuccession ] declarer of succession is not set")
+	}
+	if succession.Declarer.Equal(rootMember) {
+		return nil
+	}
+	for _, predecessor := range succession.Predecessors {
+		desc, err := am.GetObject(ctx, predecessor)
+		if err != nil {
+			return errors.Wrap(err, "[ AuthorizeSuccession ] failed to get predecessor")
+		}
+		owner := desc.Parent()
+		if owner == nil || !owner.Equal(succession.Declarer) {
+			return errors.Errorf(
+				"[ AuthorizeSuccession ] declarer %s doesn't own predecessor %s", succession.Declarer, predecessor,
+			)
+		}
+	}
+	return nil
+}
+
+// DeclareSuccessor authorizes provided succession and stores it as a memory of the prototype.
+func DeclareSuccessor(
+	ctx context.Context,
+	am Client,
+	domain, request, prototype insolar.Reference,
+	succession Succession,
+	rootMember insolar.Reference,
+) error {
+	if len(succession.Predecessors) == 0 {
+		return errors.New("[ DeclareSuccessor ] predecessors are not set")
+	}
+	err := AuthorizeSuccession(ctx, am, succession, rootMember)
+	if err != nil {
+		return errors.Wrap(err, "[ DeclareSuccessor ] succession is not authorized")
+	}
+
+	desc, err := am.GetObject(ctx, prototype)
+	if err != nil {
+		return errors.Wrap(err, "[ DeclareSuccessor ] failed to get prototype")
+	}
+	if !desc.IsPrototype() {
+		return errors.New("[ DeclareSuccessor ] object is not a prototype")
+	}
+
+	memory, err := insolar.Serialize(succession)
+	if err != nil {
+		return errors.Wrap(err, "[ DeclareSuccessor ] failed to serialize succession")
+	}
+
+	_, err = am.UpdatePrototype(ctx, domain, request, desc, memory, nil)
+	if err != nil {
+		return errors.Wrap(err, "[ DeclareSuccessor ] failed to update prototype")
+	}
+	return nil
+}
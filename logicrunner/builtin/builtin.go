@@ -77,6 +77,11 @@ func (bi *BuiltIn) CallMethod(ctx context.Context, callCtx *insolar.LogicCallCon
 	if !ok {
 		return nil, nil, errors.New("Wrong reference for builtin contract")
 	}
+	if callCtx.Mode == insolar.QueryMode {
+		if immutable, _ := c["INSATTR_"+method+"_Immutable"].(bool); !immutable {
+			return nil, nil, errors.Errorf("Querying non immutable method %s", method)
+		}
+	}
 
 	zv := reflect.New(reflect.TypeOf(c).Elem()).Interface()
 	ch := new(codec.CborHandle)
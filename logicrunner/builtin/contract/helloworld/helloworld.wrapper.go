@@ -28,6 +28,8 @@ func (e *ExtendableError) Error() string {
 	return e.S
 }
 
+var INSATTR_GetCode_Immutable = true
+
 func INSMETHOD_GetCode(object []byte, data []byte) ([]byte, []byte, error) {
 	ph := proxyctx.Current
 	self := new(HelloWorld)
@@ -54,6 +56,8 @@ func INSMETHOD_GetCode(object []byte, data []byte) ([]byte, []byte, error) {
 	return state, ret, err
 }
 
+var INSATTR_GetPrototype_Immutable = true
+
 func INSMETHOD_GetPrototype(object []byte, data []byte) ([]byte, []byte, error) {
 	ph := proxyctx.Current
 	self := new(HelloWorld)
@@ -152,9 +156,11 @@ func INSCONSTRUCTOR_New(data []byte) ([]byte, error) {
 
 func Initialize() map[string]interface{} {
 	return map[string]interface{}{
-		"INSMETHOD_GetCode":      INSMETHOD_GetCode,
-		"INSMETHOD_GetPrototype": INSMETHOD_GetPrototype,
-		"INSMETHOD_Greet":        INSMETHOD_Greet,
-		"INSCONSTRUCTOR_New":     INSCONSTRUCTOR_New,
+		"INSMETHOD_GetCode":              INSMETHOD_GetCode,
+		"INSMETHOD_GetPrototype":         INSMETHOD_GetPrototype,
+		"INSATTR_GetCode_Immutable":      INSATTR_GetCode_Immutable,
+		"INSATTR_GetPrototype_Immutable": INSATTR_GetPrototype_Immutable,
+		"INSMETHOD_Greet":                INSMETHOD_Greet,
+		"INSCONSTRUCTOR_New":             INSCONSTRUCTOR_New,
 	}
 }
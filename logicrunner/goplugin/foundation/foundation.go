@@ -99,6 +99,18 @@ func (bc *BaseContract) SelfDestruct() error {
 	return proxyctx.Current.DeactivateObject(bc.GetReference())
 }
 
+// Emit emits event with provided name and payload. Events are saved with the result of the current request and can be
+// queried by contract reference and event name afterwards. Events of a method, that returns an error, are discarded.
+// Immutable methods can't emit events, count and size of events are limited by execution limits.
+func (bc *BaseContract) Emit(name string, payload interface{}) error {
+	var data []byte
+	err := proxyctx.Current.Serialize(payload, &data)
+	if err != nil {
+		return err
+	}
+	return proxyctx.Current.EmitEvent(name, data)
+}
+
 // Error elementary string based error struct satisfying builtin error interface
 //    foundation.Error{"some err"}
 type Error struct {
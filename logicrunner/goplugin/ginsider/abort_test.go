@@ -0,0 +1,59 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package ginsider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tylerb/gls"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestRPC_Abort(t *testing.T) {
+	gi := NewGoInsider("", "", "")
+	r := &RPC{GI: gi}
+
+	callee := testutils.RandomRef()
+	prototype := testutils.RandomRef()
+	request := testutils.RandomRef()
+	callCtx := &insolar.LogicCallContext{
+		Mode:      "execution",
+		Callee:    &callee,
+		Prototype: &prototype,
+		Request:   &request,
+	}
+
+	gls.Set("callCtx", callCtx)
+	defer gls.Cleanup()
+
+	// requests, that are not executed, are not remembered
+	require.NoError(t, r.Abort(rpctypes.DownAbortReq{Request: request}, &rpctypes.DownAbortResp{}))
+	require.Empty(t, gi.calls)
+
+	gi.startCall(callCtx)
+	require.Equal(t, request, gi.MakeUpBaseReq().Request)
+
+	require.NoError(t, r.Abort(rpctypes.DownAbortReq{Request: request}, &rpctypes.DownAbortResp{}))
+	require.Panics(t, func() { gi.MakeUpBaseReq() })
+
+	gi.finishCall(callCtx)
+	require.Empty(t, gi.calls)
+}
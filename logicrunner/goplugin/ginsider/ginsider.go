@@ -58,6 +58,10 @@ type GoInsider struct {
 
 	plugins      map[insolar.Reference]*pluginRec
 	pluginsMutex sync.Mutex
+
+	// calls are requests executed by the runner, value is true if execution of the request is aborted
+	calls      map[insolar.Reference]bool
+	callsMutex sync.Mutex
 }
 
 // NewGoInsider creates a new GoInsider instance validating arguments
@@ -65,6 +69,7 @@ func NewGoInsider(path, network, address string) *GoInsider {
 	//TODO: check that path exist, it's a directory and writable
 	res := GoInsider{dir: path, upstreamProtocol: network, upstreamAddress: address}
 	res.plugins = make(map[insolar.Reference]*pluginRec)
+	res.calls = make(map[insolar.Reference]bool)
 	proxyctx.Current = &res
 	return &res
 }
@@ -99,12 +104,26 @@ func (t *RPC) CallMethod(args rpctypes.DownCallMethodReq, reply *rpctypes.DownCa
 	gls.Set("callCtx", args.Context)
 	defer gls.Cleanup()
 
+	t.GI.startCall(args.Context)
+	defer t.GI.finishCall(args.Context)
+
 	p, err := t.GI.Plugin(ctx, args.Code)
 	if err != nil {
 		return errors.Wrapf(err, "Couldn't get plugin by code reference %s", args.Code.String())
 	}
 
-	if args.Context.Caller.IsEmpty() {
+	if args.Context.Mode == insolar.QueryMode {
+		attr, err := p.Lookup("INSATTR_" + args.Method + "_Immutable")
+		if err != nil {
+			return errors.Wrapf(err, "Querying non immutable method %s (code ref: %s)", args.Method, args.Code.String())
+		}
+		immutable, ok := attr.(*bool)
+		if !ok || !*immutable {
+			return errors.Errorf("Querying non immutable method %s", args.Method)
+		}
+	}
+
+	if args.Context.Caller.IsEmpty() || args.Context.Caller.Equal(insolar.AnonymousCaller) {
 		attr, err := p.Lookup("INSATTR_" + args.Method + "_API")
 		if err != nil {
 			return errors.Wrapf(
@@ -158,6 +177,9 @@ func (t *RPC) CallConstructor(args rpctypes.DownCallConstructorReq, reply *rpcty
 	gls.Set("callCtx", args.Context)
 	defer gls.Cleanup()
 
+	t.GI.startCall(args.Context)
+	defer t.GI.finishCall(args.Context)
+
 	p, err := t.GI.Plugin(ctx, args.Code)
 	if err != nil {
 		return err
@@ -183,6 +205,45 @@ func (t *RPC) CallConstructor(args rpctypes.DownCallConstructorReq, reply *rpcty
 	return nil
 }
 
+// Abort is an RPC that aborts execution of the request, that exceeded time limit. Code of the contract can't be
+// interrupted, so the execution is stopped on its next upcall.
+func (t *RPC) Abort(args rpctypes.DownAbortReq, reply *rpctypes.DownAbortResp) error {
+	t.GI.callsMutex.Lock()
+	defer t.GI.callsMutex.Unlock()
+
+	if _, ok := t.GI.calls[args.Request]; ok {
+		t.GI.calls[args.Request] = true
+	}
+	return nil
+}
+
+// startCall registers request executed by the runner
+func (gi *GoInsider) startCall(callCtx *insolar.LogicCallContext) {
+	if callCtx == nil || callCtx.Request == nil {
+		return
+	}
+	gi.callsMutex.Lock()
+	defer gi.callsMutex.Unlock()
+	gi.calls[*callCtx.Request] = false
+}
+
+// finishCall forgets request, that is not executed by the runner anymore
+func (gi *GoInsider) finishCall(callCtx *insolar.LogicCallContext) {
+	if callCtx == nil || callCtx.Request == nil {
+		return
+	}
+	gi.callsMutex.Lock()
+	defer gi.callsMutex.Unlock()
+	delete(gi.calls, *callCtx.Request)
+}
+
+// isAborted returns true if execution of the request is aborted
+func (gi *GoInsider) isAborted(request insolar.Reference) bool {
+	gi.callsMutex.Lock()
+	defer gi.callsMutex.Unlock()
+	return gi.calls[request]
+}
+
 // Upstream returns RPC client connected to upstream server (goplugin)
 func (gi *GoInsider) Upstream() (*rpc.Client, error) {
 	gi.upstreamMutex.Lock()
@@ -220,7 +281,7 @@ func (gi *GoInsider) ObtainCode(ctx context.Context, ref insolar.Reference) (str
 
 	inslogger.FromContext(ctx).Debugf("obtaining code %q", ref)
 	req := rpctypes.UpGetCodeReq{
-		UpBaseReq: MakeUpBaseReq(),
+		UpBaseReq: gi.MakeUpBaseReq(),
 		Code:      ref,
 		MType:     insolar.MachineTypeGoPlugin,
 	}
@@ -282,12 +343,16 @@ func (gi *GoInsider) getPluginRec(ref insolar.Reference) *pluginRec {
 	return res
 }
 
-// MakeUpBaseReq makes base of request from current CallContext
-func MakeUpBaseReq() rpctypes.UpBaseReq {
+// MakeUpBaseReq makes base of request from current CallContext. It panics if execution of the request is aborted,
+// so the contract is stopped and the panic is returned as error of the call.
+func (gi *GoInsider) MakeUpBaseReq() rpctypes.UpBaseReq {
 	callCtx, ok := gls.Get("callCtx").(*insolar.LogicCallContext)
 	if !ok {
 		panic("Wrong or unexistent call context, you probably started a goroutine")
 	}
+	if callCtx.Request != nil && gi.isAborted(*callCtx.Request) {
+		panic("execution of request is aborted: " + callCtx.Request.String())
+	}
 
 	return rpctypes.UpBaseReq{
 		Mode:            callCtx.Mode,
@@ -304,7 +369,7 @@ func (gi *GoInsider) RouteCall(ref insolar.Reference, wait bool, immutable bool,
 		return nil, err
 	}
 	req := rpctypes.UpRouteReq{
-		UpBaseReq: MakeUpBaseReq(),
+		UpBaseReq: gi.MakeUpBaseReq(),
 		Wait:      wait,
 		Immutable: immutable,
 		Object:    ref,
@@ -334,7 +399,7 @@ func (gi *GoInsider) SaveAsChild(parentRef, classRef insolar.Reference, construc
 	}
 
 	req := rpctypes.UpSaveAsChildReq{
-		UpBaseReq:       MakeUpBaseReq(),
+		UpBaseReq:       gi.MakeUpBaseReq(),
 		Parent:          parentRef,
 		Prototype:       classRef,
 		ConstructorName: constructorName,
@@ -365,7 +430,7 @@ func (gi *GoInsider) GetObjChildrenIterator(obj insolar.Reference, prototype ins
 
 	res := rpctypes.UpGetObjChildrenIteratorResp{}
 	req := rpctypes.UpGetObjChildrenIteratorReq{
-		UpBaseReq: MakeUpBaseReq(),
+		UpBaseReq: gi.MakeUpBaseReq(),
 
 		IteratorID: iteratorID,
 		Object:     obj,
@@ -397,7 +462,7 @@ func (gi *GoInsider) SaveAsDelegate(intoRef, classRef insolar.Reference, constru
 	}
 
 	req := rpctypes.UpSaveAsDelegateReq{
-		UpBaseReq:       MakeUpBaseReq(),
+		UpBaseReq:       gi.MakeUpBaseReq(),
 		Into:            intoRef,
 		Prototype:       classRef,
 		ConstructorName: constructorName,
@@ -425,7 +490,7 @@ func (gi *GoInsider) GetDelegate(object, ofType insolar.Reference) (insolar.Refe
 	}
 
 	req := rpctypes.UpGetDelegateReq{
-		UpBaseReq: MakeUpBaseReq(),
+		UpBaseReq: gi.MakeUpBaseReq(),
 		Object:    object,
 		OfType:    ofType,
 	}
@@ -451,7 +516,7 @@ func (gi *GoInsider) DeactivateObject(object insolar.Reference) error {
 	}
 
 	req := rpctypes.UpDeactivateObjectReq{
-		UpBaseReq: MakeUpBaseReq(),
+		UpBaseReq: gi.MakeUpBaseReq(),
 	}
 
 	res := rpctypes.UpDeactivateObjectResp{}
@@ -467,6 +532,32 @@ func (gi *GoInsider) DeactivateObject(object insolar.Reference) error {
 	return nil
 }
 
+// EmitEvent sends event of the current execution to insolard
+func (gi *GoInsider) EmitEvent(name string, data []byte) error {
+	client, err := gi.Upstream()
+	if err != nil {
+		return err
+	}
+
+	req := rpctypes.UpEmitEventReq{
+		UpBaseReq: gi.MakeUpBaseReq(),
+		Name:      name,
+		Data:      data,
+	}
+
+	res := rpctypes.UpEmitEventResp{}
+	err = client.Call("RPC.EmitEvent", req, &res)
+	if err != nil {
+		if err == rpc.ErrShutdown {
+			log.Error("Insgorund can't connect to Insolard")
+			os.Exit(0)
+		}
+		return errors.Wrap(err, "[ EmitEvent ] on calling main API")
+	}
+
+	return nil
+}
+
 // Serialize - CBOR serializer wrapper: `what` -> `to`
 func (gi *GoInsider) Serialize(what interface{}, to *[]byte) error {
 	ch := new(codec.CborHandle)
@@ -35,6 +35,9 @@ import (
 	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
 )
 
+// reconnectDelay is a pause before the next try to connect to runners, when a runner can't be dialed
+const reconnectDelay = 100 * time.Millisecond
+
 // Options of the GoPlugin
 type Options struct {
 	// Listen  is address `GoPlugin` listens on and provides RPC interface for runner(s)
@@ -55,8 +58,9 @@ type GoPlugin struct {
 	MessageBus      insolar.MessageBus
 	ArtifactManager artifacts.Client
 
-	clientMutex sync.Mutex
-	client      *rpc.Client
+	runners  *runnerPool
+	stopOnce sync.Once
+	done     chan struct{}
 }
 
 // NewGoPlugin returns a new started GoPlugin
@@ -65,6 +69,18 @@ func NewGoPlugin(conf *configuration.LogicRunner, eb insolar.MessageBus, am arti
 		Cfg:             conf,
 		MessageBus:      eb,
 		ArtifactManager: am,
+
+		runners: newRunnerPool(conf.GoPlugin),
+		done:    make(chan struct{}),
+	}
+
+	err := gp.runners.start(context.Background())
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't start runners")
+	}
+
+	if conf.GoPlugin.HealthCheckPeriod > 0 {
+		go gp.runners.runHealthChecks(context.Background(), conf.GoPlugin, gp.maxCallTimeout(), gp.done)
 	}
 
 	return &gp, nil
@@ -72,69 +88,120 @@ func NewGoPlugin(conf *configuration.LogicRunner, eb insolar.MessageBus, am arti
 
 // Stop stops runner(s) and RPC service
 func (gp *GoPlugin) Stop() error {
+	gp.stopOnce.Do(func() {
+		close(gp.done)
+		gp.runners.close()
+	})
 	return nil
 }
 
-const timeout = time.Minute * 10
+// timeoutFor returns channel, that fires when time limit of the call is exceeded. Channel never fires if time is
+// not limited.
+func (gp *GoPlugin) timeoutFor(callContext *insolar.LogicCallContext) <-chan time.Time {
+	prototype := ""
+	if callContext != nil && callContext.Prototype != nil {
+		prototype = callContext.Prototype.String()
+	}
 
-// Downstream returns a connection to `ginsider`
-func (gp *GoPlugin) Downstream(ctx context.Context) (*rpc.Client, error) {
-	gp.clientMutex.Lock()
-	defer gp.clientMutex.Unlock()
+	timeout := gp.Cfg.LimitsFor(prototype).Timeout
+	if timeout <= 0 {
+		return nil
+	}
+	return time.After(timeout)
+}
 
-	if gp.client != nil {
-		return gp.client, nil
+// maxCallTimeout returns the longest time limit of calls, zero means that some calls are not limited
+func (gp *GoPlugin) maxCallTimeout() time.Duration {
+	max := gp.Cfg.Limits.Timeout
+	for prototype := range gp.Cfg.PrototypeLimits {
+		timeout := gp.Cfg.LimitsFor(prototype).Timeout
+		if timeout <= 0 {
+			return 0
+		}
+		if timeout > max {
+			max = timeout
+		}
 	}
+	return max
+}
 
-	client, err := rpc.Dial(gp.Cfg.GoPlugin.RunnerProtocol, gp.Cfg.GoPlugin.RunnerListen)
+// abort asks the runner of the call, that exceeded time limit, to stop it. Runner can't interrupt code of a
+// contract, so the call is stopped on its next upcall. Calls, that never make upcalls, keep the runner busy until
+// it fails health checks and is restarted.
+func (gp *GoPlugin) abort(ctx context.Context, callContext *insolar.LogicCallContext) {
+	if callContext == nil || callContext.Request == nil {
+		return
+	}
+
+	r := gp.runners.pick(routingKey(callContext))
+	client, err := r.downstream()
 	if err != nil {
-		return nil, errors.Wrapf(
-			err, "couldn't dial '%s' over %s",
-			gp.Cfg.GoPlugin.RunnerListen, gp.Cfg.GoPlugin.RunnerProtocol,
-		)
+		inslogger.FromContext(ctx).Warn("couldn't abort call, runner is unavailable: ", err)
+		return
 	}
 
-	gp.client = client
-	return gp.client, nil
+	req := rpctypes.DownAbortReq{Request: *callContext.Request}
+	go func() {
+		err := client.Call("RPC.Abort", req, &rpctypes.DownAbortResp{})
+		if err != nil {
+			inslogger.FromContext(ctx).Warn("couldn't abort call: ", err)
+		}
+	}()
 }
 
-func (gp *GoPlugin) CloseDownstream() {
-	gp.clientMutex.Lock()
-	defer gp.clientMutex.Unlock()
-
-	// this method can be called multiple times from callClientWithReconnect
-	if gp.client != nil {
-		gp.client.Close()
-		gp.client = nil
-	}
+// Downstream returns a connection to the first `ginsider` of the pool
+func (gp *GoPlugin) Downstream(ctx context.Context) (*rpc.Client, error) {
+	return gp.runners.runners[0].downstream()
 }
 
-func (gp *GoPlugin) callClientWithReconnect(ctx context.Context, method string, req interface{}, res interface{}) error {
+// callClientWithReconnect makes the call on the runner of the object. Runner is evicted from the pool if the
+// connection to it is lost. The call is repeated on the next runner only if it wasn't sent, calls that could be
+// executed already fail, so side effects of a call are never duplicated.
+func (gp *GoPlugin) callClientWithReconnect(
+	ctx context.Context, callContext *insolar.LogicCallContext, method string, req interface{}, res interface{},
+) error {
 	inslogger.FromContext(ctx).Debug("GoPlugin.callClientWithReconnect starts")
-	var err error
-	var client *rpc.Client
 
+	key := routingKey(callContext)
 	for {
-		inslogger.FromContext(ctx).Info("Connect to insgorund")
-		client, err = gp.Downstream(ctx)
-		if err == nil {
-			call := <-client.Go(method, req, res, nil).Done
-			err = call.Error
-
-			if err != rpc.ErrShutdown {
-				break
-			} else {
-				inslogger.FromContext(ctx).Debug("Connection to insgorund is closed, need to reconnect")
-				gp.CloseDownstream()
-				inslogger.FromContext(ctx).Debugf("Reconnecting...")
-			}
-		} else {
+		select {
+		case <-gp.done:
+			return errors.New("goplugin is stopped")
+		default:
+		}
+
+		r := gp.runners.pick(key)
+		inslogger.FromContext(ctx).Info("Connect to insgorund on ", r.listen)
+		client, err := r.downstream()
+		if err != nil {
 			inslogger.FromContext(ctx).Debugf("Can't connect to to insgorund, err: %s", err.Error())
+			r.evict(ctx)
+			time.Sleep(reconnectDelay)
 			inslogger.FromContext(ctx).Debugf("Reconnecting...")
+			continue
 		}
-	}
 
-	return err
+		r.acquire()
+		call := <-client.Go(method, req, res, nil).Done
+		r.release()
+
+		switch call.Error.(type) {
+		case nil, rpc.ServerError:
+			// runner is alive, so it's restored if it was evicted when all runners were evicted
+			r.restore(ctx)
+			return call.Error
+		}
+
+		r.drop(client)
+		r.evict(ctx)
+		if call.Error == rpc.ErrShutdown {
+			// Connections of live runners are closed on stop only, so the connection was already broken and the
+			// call wasn't sent.
+			inslogger.FromContext(ctx).Debug("Connection to insgorund is closed, reconnecting...")
+			continue
+		}
+		return errors.Wrap(call.Error, "connection to insgorund is lost during the call")
+	}
 }
 
 type CallMethodResult struct {
@@ -145,7 +212,7 @@ type CallMethodResult struct {
 func (gp *GoPlugin) CallMethodRPC(ctx context.Context, req rpctypes.DownCallMethodReq, res rpctypes.DownCallMethodResp, resultChan chan CallMethodResult) {
 	inslogger.FromContext(ctx).Debug("GoPlugin.CallMethodRPC starts ...")
 	method := "RPC.CallMethod"
-	callClientError := gp.callClientWithReconnect(ctx, method, req, &res)
+	callClientError := gp.callClientWithReconnect(ctx, req.Context, method, req, &res)
 	resultChan <- CallMethodResult{Response: res, Error: callClientError}
 }
 
@@ -176,7 +243,7 @@ func (gp *GoPlugin) CallMethod(
 		Arguments: args,
 	}
 
-	resultChan := make(chan CallMethodResult)
+	resultChan := make(chan CallMethodResult, 1)
 	go gp.CallMethodRPC(ctx, req, res, resultChan)
 
 	select {
@@ -185,8 +252,9 @@ func (gp *GoPlugin) CallMethod(
 			return nil, nil, errors.Wrap(callResult.Error, "problem with API call")
 		}
 		return callResult.Response.Data, callResult.Response.Ret, nil
-	case <-time.After(timeout):
-		return nil, nil, errors.New("logicrunner execution timeout")
+	case <-gp.timeoutFor(callContext):
+		gp.abort(ctx, callContext)
+		return nil, nil, &insolar.ExecutionLimitError{Limit: insolar.LimitTime}
 	}
 }
 
@@ -197,7 +265,7 @@ type CallConstructorResult struct {
 
 func (gp *GoPlugin) CallConstructorRPC(ctx context.Context, req rpctypes.DownCallConstructorReq, res rpctypes.DownCallConstructorResp, resultChan chan CallConstructorResult) {
 	method := "RPC.CallConstructor"
-	callClientError := gp.callClientWithReconnect(ctx, method, req, &res)
+	callClientError := gp.callClientWithReconnect(ctx, req.Context, method, req, &res)
 	resultChan <- CallConstructorResult{Response: res, Error: callClientError}
 }
 
@@ -217,7 +285,7 @@ func (gp *GoPlugin) CallConstructor(
 		Arguments: args,
 	}
 
-	resultChan := make(chan CallConstructorResult)
+	resultChan := make(chan CallConstructorResult, 1)
 	go gp.CallConstructorRPC(ctx, req, res, resultChan)
 
 	select {
@@ -226,7 +294,8 @@ func (gp *GoPlugin) CallConstructor(
 			return nil, errors.Wrap(callResult.Error, "problem with API call")
 		}
 		return callResult.Response.Ret, nil
-	case <-time.After(timeout):
-		return nil, errors.New("logicrunner execution timeout")
+	case <-gp.timeoutFor(callContext):
+		gp.abort(ctx, callContext)
+		return nil, &insolar.ExecutionLimitError{Limit: insolar.LimitTime}
 	}
 }
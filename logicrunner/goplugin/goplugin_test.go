@@ -17,11 +17,170 @@
 package goplugin
 
 import (
+	"net"
+	"net/rpc"
+	"sync/atomic"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+	"github.com/insolar/insolar/testutils"
 )
 
 func TestTypeCompatibility(t *testing.T) {
 	var _ insolar.MachineLogicExecutor = (*GoPlugin)(nil)
 }
+
+func TestRunnerPool_Pick(t *testing.T) {
+	pool := newRunnerPool(&configuration.GoPlugin{
+		Runners: []configuration.GoPluginRunner{
+			{Listen: "127.0.0.1:1", Protocol: "tcp"},
+			{Listen: "127.0.0.1:2", Protocol: "tcp"},
+			{Listen: "127.0.0.1:3", Protocol: "tcp"},
+		},
+	})
+	ctx := inslogger.TestContext(t)
+	object := testutils.RandomRef()
+
+	r := pool.pick(object.Bytes())
+	require.Equal(t, r, pool.pick(object.Bytes()), "calls of an object are routed to the same runner")
+
+	r.evict(ctx)
+	next := pool.pick(object.Bytes())
+	require.NotEqual(t, r, next, "evicted runner is skipped")
+
+	for _, runner := range pool.runners {
+		runner.evict(ctx)
+	}
+	require.Equal(t, r, pool.pick(object.Bytes()), "runner of the object is used if all runners are evicted")
+
+	r.restore(ctx)
+	require.False(t, r.isEvicted())
+	require.Equal(t, r, pool.pick(object.Bytes()))
+}
+
+func TestRunnerPool_SingleRunner(t *testing.T) {
+	pool := newRunnerPool(&configuration.GoPlugin{RunnerListen: "127.0.0.1:7777", RunnerProtocol: "tcp"})
+	require.Len(t, pool.runners, 1)
+	require.Equal(t, "127.0.0.1:7777", pool.runners[0].listen)
+	require.Equal(t, pool.runners[0], pool.pick(nil))
+}
+
+func TestRunnerPool_HealthCheck(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go rpc.NewServer().Accept(listener)
+
+	cfg := &configuration.GoPlugin{
+		Runners: []configuration.GoPluginRunner{
+			{Listen: listener.Addr().String(), Protocol: "tcp"},
+		},
+	}
+	pool := newRunnerPool(cfg)
+	r := pool.runners[0]
+
+	r.evict(ctx)
+	pool.healthCheck(ctx, cfg, 0)
+	require.False(t, r.isEvicted(), "alive runner is restored")
+
+	require.NoError(t, listener.Close())
+	pool.close()
+	pool.healthCheck(ctx, cfg, 0)
+	require.True(t, r.isEvicted(), "dead runner is evicted")
+}
+
+func TestRunnerPool_HealthCheck_KeepsCallsInFlight(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go rpc.NewServer().Accept(listener)
+
+	cfg := &configuration.GoPlugin{
+		Runners: []configuration.GoPluginRunner{
+			{Listen: listener.Addr().String(), Protocol: "tcp"},
+		},
+	}
+	pool := newRunnerPool(cfg)
+	defer pool.close()
+	r := pool.runners[0]
+
+	client, err := r.downstream()
+	require.NoError(t, err)
+	r.evict(ctx)
+	same, err := r.downstream()
+	require.NoError(t, err)
+	require.Equal(t, client, same, "eviction doesn't close connection of calls in flight")
+}
+
+func TestRunnerPool_HealthCheck_Restart(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	cfg := &configuration.GoPlugin{
+		Runners: []configuration.GoPluginRunner{
+			// nothing listens the address, so every check fails
+			{Listen: "127.0.0.1:1", Protocol: "tcp", Command: []string{"sleep", "60"}},
+		},
+		RestartAfter: 2,
+	}
+	pool := newRunnerPool(cfg)
+	require.NoError(t, pool.start(ctx))
+	defer pool.close()
+	r := pool.runners[0]
+	first := r.process
+
+	pool.healthCheck(ctx, cfg, 0)
+	require.True(t, r.isEvicted())
+	require.Equal(t, first, r.process, "runner is not restarted after the first failure")
+
+	r.acquire()
+	pool.healthCheck(ctx, cfg, 0)
+	require.Equal(t, first, r.process, "runner with calls in flight is not restarted")
+
+	r.release()
+	pool.healthCheck(ctx, cfg, 0)
+	require.NotEqual(t, first, r.process, "runner is restarted")
+}
+
+func TestGoPlugin_callClientWithReconnect_NoRetryInFlight(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	// runner reads the call and drops the connection without answer
+	var accepted int32
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			atomic.AddInt32(&accepted, 1)
+			buf := make([]byte, 1)
+			_, _ = conn.Read(buf)
+			conn.Close()
+		}
+	}()
+
+	cfg := configuration.NewLogicRunner()
+	cfg.GoPlugin.Runners = []configuration.GoPluginRunner{
+		{Listen: listener.Addr().String(), Protocol: "tcp"},
+	}
+	cfg.GoPlugin.HealthCheckPeriod = 0
+	gp, err := NewGoPlugin(&cfg, nil, nil)
+	require.NoError(t, err)
+	defer gp.Stop()
+
+	res := rpctypes.DownCallMethodResp{}
+	err = gp.callClientWithReconnect(ctx, nil, "RPC.CallMethod", rpctypes.DownCallMethodReq{Method: "Call"}, &res)
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&accepted), "call that could be executed is not repeated")
+}
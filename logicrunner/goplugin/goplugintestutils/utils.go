@@ -153,6 +153,22 @@ func (t *TestArtifactManager) GetPendingRequest(ctx context.Context, objectID in
 	panic("implement me")
 }
 
+func (t *TestArtifactManager) GetHistory(
+	ctx context.Context, head insolar.Reference, from *insolar.ID, limit int,
+) ([]artifacts.ObjectState, *insolar.ID, error) {
+	panic("implement me")
+}
+
+func (t *TestArtifactManager) GetEvents(
+	ctx context.Context, object insolar.Reference, name string, from insolar.PulseNumber, limit int,
+) ([]artifacts.Event, insolar.PulseNumber, error) {
+	panic("implement me")
+}
+
+func (t *TestArtifactManager) GetResult(ctx context.Context, request insolar.Reference) (*record.Result, error) {
+	panic("implement me")
+}
+
 func (t *TestArtifactManager) HasPendingRequests(ctx context.Context, object insolar.Reference) (bool, error) {
 	panic("implement me")
 }
@@ -184,7 +200,7 @@ func (t *TestArtifactManager) RegisterRequest(ctx context.Context, req record.Re
 
 // RegisterResult saves VM method call result.
 func (t *TestArtifactManager) RegisterResult(
-	ctx context.Context, object, request insolar.Reference, payload []byte,
+	ctx context.Context, object, request insolar.Reference, payload []byte, events []insolar.ContractEvent,
 ) (*insolar.ID, error) {
 	panic("implement me")
 }
@@ -336,6 +352,26 @@ func (t *TestArtifactManager) UpdateObject(
 	return objDesc, nil
 }
 
+// MigrateObject implementation for tests
+func (t *TestArtifactManager) MigrateObject(
+	ctx context.Context,
+	domain insolar.Reference,
+	request insolar.Reference,
+	object artifacts.ObjectDescriptor,
+	prototype insolar.Reference,
+	memory []byte,
+) (artifacts.ObjectDescriptor, error) {
+	objDesc, ok := t.Objects[*object.HeadRef()]
+	if !ok {
+		return nil, errors.New("No object to migrate")
+	}
+
+	objDesc.Data = memory
+	objDesc.PrototypeRef = &prototype
+
+	return objDesc, nil
+}
+
 // RegisterValidation implementation for tests
 func (t *TestArtifactManager) RegisterValidation(
 	ctx context.Context,
@@ -536,6 +572,42 @@ func (cb *ContractsBuilder) Build(contracts map[string]string) error {
 	return nil
 }
 
+// DeclareSuccessor declares prototype of the contract a successor of provided prototypes, objects of predecessors
+// are converted by provided migration method on migration. Succession is declared by the root member, so it may
+// replace any prototype.
+func (cb *ContractsBuilder) DeclareSuccessor(
+	name string, migrationMethod string, rootMember insolar.Reference, predecessors ...insolar.Reference,
+) error {
+	prototype, ok := cb.Prototypes[name]
+	if !ok {
+		return errors.Errorf("[ DeclareSuccessor ] contract %q is not built", name)
+	}
+
+	ctx := context.TODO()
+	nonce := testutils.RandomRef()
+	request, err := cb.ArtifactManager.RegisterRequest(
+		ctx,
+		record.Request{
+			CallType:  record.CTSaveAsChild,
+			Prototype: &nonce,
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "[ DeclareSuccessor ] Can't RegisterRequest")
+	}
+
+	err = artifacts.DeclareSuccessor(
+		ctx, cb.ArtifactManager,
+		insolar.Reference{}, *insolar.NewReference(insolar.ID{}, *request), *prototype,
+		artifacts.Succession{Predecessors: predecessors, MigrationMethod: migrationMethod, Declarer: rootMember},
+		rootMember,
+	)
+	if err != nil {
+		return errors.Wrap(err, "[ DeclareSuccessor ] Can't declare successor")
+	}
+	return nil
+}
+
 func (cb *ContractsBuilder) proxy(name string) error {
 	dstDir := filepath.Join(cb.root, "src/github.com/insolar/insolar/application/proxy", name)
 
@@ -26,6 +26,7 @@ import (
 
 var (
 	tagMethodName = insmetrics.MustTagKey("methodName")
+	tagRunner     = insmetrics.MustTagKey("runner")
 )
 
 var (
@@ -34,6 +35,11 @@ var (
 		"time spent on execution contract, measured in goplugin",
 		stats.UnitMilliseconds,
 	)
+	statGopluginRunnerEvicted = stats.Int64(
+		"goplugin/runner/evicted",
+		"count of insgorund evictions from the pool",
+		stats.UnitDimensionless,
+	)
 )
 
 func init() {
@@ -43,6 +49,11 @@ func init() {
 			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
 			TagKeys:     []tag.Key{tagMethodName},
 		},
+		&view.View{
+			Measure:     statGopluginRunnerEvicted,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{tagRunner},
+		},
 	)
 	if err != nil {
 		panic(err)
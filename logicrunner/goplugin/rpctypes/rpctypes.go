@@ -54,6 +54,14 @@ type DownCallConstructorResp struct {
 	Ret insolar.Arguments
 }
 
+// DownAbortReq is a set of arguments for Abort RPC in the runner
+type DownAbortReq struct {
+	Request insolar.Reference
+}
+
+// DownAbortResp is response from Abort RPC in the runner
+type DownAbortResp struct{}
+
 // UpBaseReq  is a base type for all insgorund -> logicrunner requests
 type UpBaseReq struct {
 	Mode            string
@@ -161,3 +169,14 @@ type UpDeactivateObjectReq struct {
 // UpDeactivateObjectResp is response from DeactivateObject RPC in goplugin
 type UpDeactivateObjectResp struct {
 }
+
+// UpEmitEventReq is a set of arguments for EmitEvent RPC in goplugin
+type UpEmitEventReq struct {
+	UpBaseReq
+	Name string
+	Data []byte
+}
+
+// UpEmitEventResp is response from EmitEvent RPC in goplugin
+type UpEmitEventResp struct {
+}
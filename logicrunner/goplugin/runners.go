@@ -0,0 +1,383 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package goplugin
+
+import (
+	"context"
+	"hash/fnv"
+	"net"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/insmetrics"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+// runner is a connection to a single `insgorund` process of the pool
+type runner struct {
+	protocol string
+	listen   string
+	command  []string
+
+	mu        sync.Mutex
+	client    *rpc.Client
+	evicted   bool
+	evictedAt time.Time
+	// failures - count of failed health checks in a row
+	failures int
+	// active - count of calls in flight
+	active  int
+	process *exec.Cmd
+	exited  chan struct{}
+}
+
+func newRunner(cfg configuration.GoPluginRunner) *runner {
+	return &runner{protocol: cfg.Protocol, listen: cfg.Listen, command: cfg.Command}
+}
+
+// downstream returns a connection to the runner, dials it if there is no connection
+func (r *runner) downstream() (*rpc.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.client != nil {
+		return r.client, nil
+	}
+
+	client, err := rpc.Dial(r.protocol, r.listen)
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't dial '%s' over %s", r.listen, r.protocol)
+	}
+
+	r.client = client
+	return r.client, nil
+}
+
+// drop forgets broken connection, so the next call dials the runner again
+func (r *runner) drop(client *rpc.Client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.client == client {
+		r.client = nil
+	}
+	// connection is already broken, so there are no calls in flight on it
+	_ = client.Close()
+}
+
+// evict excludes the runner from calls routing until it passes a health check, calls in flight are not interrupted
+func (r *runner) evict(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// this method can be called multiple times by concurrent calls of the same runner
+	if !r.evicted {
+		r.evicted = true
+		r.evictedAt = time.Now()
+		inslogger.FromContext(ctx).Warnf("insgorund on '%s' is evicted", r.listen)
+		stats.Record(insmetrics.InsertTag(ctx, tagRunner, r.listen), statGopluginRunnerEvicted.M(1))
+	}
+}
+
+// fail evicts the runner that failed a health check
+func (r *runner) fail(ctx context.Context) {
+	r.evict(ctx)
+
+	r.mu.Lock()
+	r.failures++
+	r.mu.Unlock()
+}
+
+// restore includes the runner back to calls routing
+func (r *runner) restore(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.failures = 0
+	if r.evicted {
+		r.evicted = false
+		inslogger.FromContext(ctx).Infof("insgorund on '%s' is restored", r.listen)
+	}
+}
+
+func (r *runner) isEvicted() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.evicted
+}
+
+// acquire counts a call in flight, release should be called when the call is finished
+func (r *runner) acquire() {
+	r.mu.Lock()
+	r.active++
+	r.mu.Unlock()
+}
+
+func (r *runner) release() {
+	r.mu.Lock()
+	r.active--
+	r.mu.Unlock()
+}
+
+// shouldRestart returns true if the runner is started by the pool and failed restartAfter health checks in a row.
+// Calls in flight are waited for callTimeout since eviction, they have got time limit errors already by then.
+func (r *runner) shouldRestart(restartAfter int, callTimeout time.Duration) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.command) == 0 || restartAfter <= 0 || r.failures < restartAfter {
+		return false
+	}
+	if r.active == 0 {
+		return true
+	}
+	return callTimeout > 0 && time.Since(r.evictedAt) > callTimeout
+}
+
+// start starts `insgorund` process of the runner if the runner has a command
+func (r *runner) start(ctx context.Context) error {
+	if len(r.command) == 0 {
+		return nil
+	}
+
+	cmd := exec.Command(r.command[0], r.command[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err := cmd.Start()
+	if err != nil {
+		return errors.Wrapf(err, "couldn't start insgorund on '%s'", r.listen)
+	}
+
+	exited := make(chan struct{})
+	go func() {
+		err := cmd.Wait()
+		inslogger.FromContext(ctx).Infof("insgorund on '%s' exited: %v", r.listen, err)
+		close(exited)
+	}()
+
+	r.mu.Lock()
+	r.process = cmd
+	r.exited = exited
+	r.mu.Unlock()
+	return nil
+}
+
+// stop kills `insgorund` process of the runner and waits for its exit
+func (r *runner) stop() {
+	r.mu.Lock()
+	process, exited := r.process, r.exited
+	r.process, r.exited = nil, nil
+	r.mu.Unlock()
+
+	if process == nil {
+		return
+	}
+	_ = process.Process.Kill()
+	<-exited
+}
+
+// restart kills `insgorund` process of the runner and starts it again, the runner is routed to after it passes
+// a health check
+func (r *runner) restart(ctx context.Context) error {
+	inslogger.FromContext(ctx).Warnf("restarting insgorund on '%s'", r.listen)
+	r.stop()
+
+	r.mu.Lock()
+	client := r.client
+	r.client = nil
+	r.failures = 0
+	r.mu.Unlock()
+	if client != nil {
+		// calls in flight on the killed process are failed by now
+		_ = client.Close()
+	}
+
+	return r.start(ctx)
+}
+
+// runnerPool spreads calls between runners by object reference, so calls of an object are executed by the same
+// runner while it's healthy
+type runnerPool struct {
+	runners []*runner
+}
+
+func newRunnerPool(cfg *configuration.GoPlugin) *runnerPool {
+	pool := &runnerPool{}
+	for _, runnerCfg := range cfg.RunnerList() {
+		pool.runners = append(pool.runners, newRunner(runnerCfg))
+	}
+	return pool
+}
+
+// pick returns the runner for the object, the next runner of the pool is taken if the runner of the object is
+// evicted. The runner of the object is returned if all runners are evicted.
+func (p *runnerPool) pick(object []byte) *runner {
+	h := fnv.New32a()
+	_, _ = h.Write(object)
+	first := int(h.Sum32() % uint32(len(p.runners)))
+
+	for i := 0; i < len(p.runners); i++ {
+		r := p.runners[(first+i)%len(p.runners)]
+		if !r.isEvicted() {
+			return r
+		}
+	}
+	return p.runners[first]
+}
+
+// routingKey returns key calls are spread by: reference of the object, or prototype for calls without an object
+func routingKey(callContext *insolar.LogicCallContext) []byte {
+	if callContext == nil {
+		return nil
+	}
+	if callContext.Callee != nil {
+		return callContext.Callee.Bytes()
+	}
+	if callContext.Prototype != nil {
+		return callContext.Prototype.Bytes()
+	}
+	return nil
+}
+
+// start starts processes of runners, that have commands
+func (p *runnerPool) start(ctx context.Context) error {
+	for _, r := range p.runners {
+		if err := r.start(ctx); err != nil {
+			p.close()
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *runnerPool) close() {
+	for _, r := range p.runners {
+		r.mu.Lock()
+		if r.client != nil {
+			r.client.Close()
+			r.client = nil
+		}
+		r.mu.Unlock()
+		r.stop()
+	}
+}
+
+// healthCheck checks every runner of the pool, runners that failed the check are evicted and evicted runners that
+// passed it are restored. Runners, that failed too many checks in a row, are restarted if the pool started them.
+func (p *runnerPool) healthCheck(ctx context.Context, cfg *configuration.GoPlugin, callTimeout time.Duration) {
+	var wg sync.WaitGroup
+	for _, r := range p.runners {
+		wg.Add(1)
+		go func(r *runner) {
+			defer wg.Done()
+
+			err := checkRunner(r, cfg)
+			if err == nil {
+				r.restore(ctx)
+				return
+			}
+
+			inslogger.FromContext(ctx).Warnf("insgorund on '%s' failed health check: %s", r.listen, err)
+			r.fail(ctx)
+			if r.shouldRestart(cfg.RestartAfter, callTimeout) {
+				if err := r.restart(ctx); err != nil {
+					inslogger.FromContext(ctx).Error(err)
+				}
+			}
+		}(r)
+	}
+	wg.Wait()
+}
+
+// checkRunner calls the healthcheck contract on the runner, only connection is checked if the contract isn't
+// configured. Check uses its own connection, so a failed check doesn't affect calls in flight.
+func checkRunner(r *runner, cfg *configuration.GoPlugin) error {
+	var conn net.Conn
+	var err error
+	if cfg.HealthCheckTimeout > 0 {
+		conn, err = net.DialTimeout(r.protocol, r.listen, cfg.HealthCheckTimeout)
+	} else {
+		conn, err = net.Dial(r.protocol, r.listen)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "couldn't dial '%s' over %s", r.listen, r.protocol)
+	}
+	client := rpc.NewClient(conn)
+	defer client.Close()
+
+	if cfg.HealthCheckCode == "" {
+		return nil
+	}
+
+	code, err := insolar.NewReferenceFromBase58(cfg.HealthCheckCode)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse healthcheck contract ref")
+	}
+
+	empty, err := insolar.Serialize([]interface{}{})
+	if err != nil {
+		return err
+	}
+
+	res := rpctypes.DownCallMethodResp{}
+	req := rpctypes.DownCallMethodReq{
+		Context:   &insolar.LogicCallContext{Caller: code},
+		Code:      *code,
+		Data:      empty,
+		Method:    "Check",
+		Arguments: empty,
+	}
+
+	var timeout <-chan time.Time
+	if cfg.HealthCheckTimeout > 0 {
+		timeout = time.After(cfg.HealthCheckTimeout)
+	}
+
+	select {
+	case call := <-client.Go("RPC.CallMethod", req, &res, make(chan *rpc.Call, 1)).Done:
+		return call.Error
+	case <-timeout:
+		return errors.New("health check timeout")
+	}
+}
+
+// runHealthChecks checks runners of the pool periodically until done is closed
+func (p *runnerPool) runHealthChecks(
+	ctx context.Context, cfg *configuration.GoPlugin, callTimeout time.Duration, done <-chan struct{},
+) {
+	ticker := time.NewTicker(cfg.HealthCheckPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			p.healthCheck(ctx, cfg, callTimeout)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+)
+
+// limits returns resource limits of current execution.
+func (lr *LogicRunner) limits(current *CurrentExecution) configuration.ExecutionLimits {
+	prototype := ""
+	if current.LogicContext != nil && current.LogicContext.Prototype != nil {
+		prototype = current.LogicContext.Prototype.String()
+	}
+	return lr.Cfg.LimitsFor(prototype)
+}
+
+// countOutgoingCall registers nested call of current execution. Error is returned and remembered for the execution
+// if the call exceeds the limit, so the contract can't hide it.
+func (lr *LogicRunner) countOutgoingCall(es *ExecutionState) error {
+	current := es.Current
+	current.OutgoingCalls++
+
+	limit := lr.limits(current).MaxOutgoingCalls
+	if limit > 0 && current.OutgoingCalls > limit {
+		current.LimitError = &insolar.ExecutionLimitError{Limit: insolar.LimitOutgoingCalls}
+		return current.LimitError
+	}
+	return nil
+}
+
+// countEvent registers event emitted by current execution. Like exceeded outgoing calls, exceeded event limits are
+// remembered for the execution.
+func (lr *LogicRunner) countEvent(es *ExecutionState, event insolar.ContractEvent) error {
+	current := es.Current
+	limits := lr.limits(current)
+
+	if limits.MaxEventSize > 0 && len(event.Name)+len(event.Data) > limits.MaxEventSize {
+		current.LimitError = &insolar.ExecutionLimitError{Limit: insolar.LimitEventSize}
+		return current.LimitError
+	}
+	if limits.MaxEvents > 0 && len(current.Events) >= limits.MaxEvents {
+		current.LimitError = &insolar.ExecutionLimitError{Limit: insolar.LimitEvents}
+		return current.LimitError
+	}
+
+	current.Events = append(current.Events, event)
+	return nil
+}
+
+// checkLimits returns an error if execution exceeded its limits.
+func (lr *LogicRunner) checkLimits(current *CurrentExecution, state []byte) error {
+	if current.LimitError != nil {
+		return current.LimitError
+	}
+
+	limit := lr.limits(current).MaxStateSize
+	if limit > 0 && len(state) > limit {
+		return &insolar.ExecutionLimitError{Limit: insolar.LimitStateSize}
+	}
+	return nil
+}
+
+// registerLimitResult registers error of exceeded limits as a result of request. Object state is left unchanged and
+// events of the execution are discarded. Note that the time limit error depends on the wall time of the executor, so
+// unlike other limit errors it's not a deterministic result of the request.
+func (lr *LogicRunner) registerLimitResult(
+	ctx context.Context, es *ExecutionState, object Ref, limitErr *insolar.ExecutionLimitError,
+) error {
+	es.deactivate = false
+
+	result, err := insolar.MarshalArgs(nil, &foundation.Error{S: limitErr.Error()})
+	if err != nil {
+		return es.WrapError(err, "couldn't marshal limit error")
+	}
+	_, err = lr.ArtifactManager.RegisterResult(ctx, object, *es.Current.Request, result, nil)
+	if err != nil {
+		return es.WrapError(err, "couldn't save results")
+	}
+	return es.WrapError(limitErr, "execution limit")
+}
+
+func limitError(err error) (*insolar.ExecutionLimitError, bool) {
+	limitErr, ok := errors.Cause(err).(*insolar.ExecutionLimitError)
+	return limitErr, ok
+}
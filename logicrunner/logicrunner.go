@@ -78,6 +78,12 @@ type CurrentExecution struct {
 	RequesterNode *Ref
 	ReturnMode    record.Request_RM
 	SentResult    bool
+	// OutgoingCalls is a count of nested calls made by the execution
+	OutgoingCalls int
+	// LimitError is set when the execution exceeded its limits
+	LimitError error
+	// Events are emitted by the execution, they are registered with the result
+	Events []insolar.ContractEvent
 }
 
 type ExecutionQueueElement struct {
@@ -163,6 +169,9 @@ type LogicRunner struct {
 	state      map[Ref]*ObjectState // if object exists, we are validating or executing it right now
 	stateMutex sync.RWMutex
 
+	queries      map[Ref]*queryState // read-only queries, that are executed right now
+	queriesMutex sync.Mutex
+
 	sock net.Listener
 
 	stopLock   sync.Mutex
@@ -183,8 +192,9 @@ func NewLogicRunner(cfg *configuration.LogicRunner) (*LogicRunner, error) {
 		return nil, errors.New("LogicRunner have nil configuration")
 	}
 	res := LogicRunner{
-		Cfg:   cfg,
-		state: make(map[Ref]*ObjectState),
+		Cfg:     cfg,
+		state:   make(map[Ref]*ObjectState),
+		queries: make(map[Ref]*queryState),
 	}
 
 	err := initHandlers(&res)
@@ -585,6 +595,7 @@ type ObjectBody struct {
 	CodeMachineType insolar.MachineType
 	CodeRef         *Ref
 	Parent          *Ref
+	Succession      *artifacts.Succession
 }
 
 func init() {
@@ -598,6 +609,10 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 		if err != nil {
 			return nil, errors.Wrap(err, "couldn't get descriptors by object reference")
 		}
+		succession, err := artifacts.SuccessionOf(protoDesc)
+		if err != nil {
+			return nil, errors.Wrap(err, "couldn't get succession of prototype")
+		}
 
 		es.objectbody = &ObjectBody{
 			objDescriptor:   objDesc,
@@ -606,18 +621,22 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 			CodeMachineType: codeDesc.MachineType(),
 			CodeRef:         codeDesc.Ref(),
 			Parent:          objDesc.Parent(),
+			Succession:      succession,
 		}
 		inslogger.FromContext(ctx).Info("LogicRunner.executeMethodCall starts")
 	}
 
+	// it's needed to assure that we call method on ref, that has same prototype as proxy, that we import in contract code
+	if m.Prototype != nil && !m.Prototype.Equal(*es.objectbody.Prototype) {
+		if err := lr.checkSuccession(ctx, es, m); err != nil {
+			return nil, err
+		}
+	}
+
 	current := *es.Current
 	current.LogicContext.Prototype = es.objectbody.Prototype
 	current.LogicContext.Code = es.objectbody.CodeRef
 	current.LogicContext.Parent = es.objectbody.Parent
-	// it's needed to assure that we call method on ref, that has same prototype as proxy, that we import in contract code
-	if m.Prototype != nil && !m.Prototype.Equal(*es.objectbody.Prototype) {
-		return nil, errors.New("proxy call error: try to call method of prototype as method of another prototype")
-	}
 
 	executor, err := lr.GetExecutor(es.objectbody.CodeMachineType)
 	if err != nil {
@@ -627,6 +646,12 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 	newData, result, err := executor.CallMethod(
 		ctx, current.LogicContext, *es.objectbody.CodeRef, es.objectbody.Object, m.Method, m.Arguments,
 	)
+	if err == nil {
+		err = lr.checkLimits(es.Current, newData)
+	}
+	if limitErr, ok := limitError(err); ok {
+		return nil, lr.registerLimitResult(ctx, es, *m.Object, limitErr)
+	}
 	if err != nil {
 		return nil, es.WrapError(err, "executor error")
 	}
@@ -649,7 +674,7 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 		}
 		es.objectbody.objDescriptor = od
 	}
-	_, err = am.RegisterResult(ctx, *m.Object, *current.Request, result)
+	_, err = am.RegisterResult(ctx, *m.Object, *current.Request, result, succeededEvents(result, es.Current.Events))
 	if err != nil {
 		return nil, es.WrapError(err, "couldn't save results")
 	}
@@ -659,6 +684,20 @@ func (lr *LogicRunner) executeMethodCall(ctx context.Context, es *ExecutionState
 	return &reply.CallMethod{Result: result}, nil
 }
 
+// succeededEvents returns events, that are registered with the result of a method. Events of a method, that returned
+// an error (the last result of contract methods), are discarded.
+func succeededEvents(result []byte, events []insolar.ContractEvent) []insolar.ContractEvent {
+	if len(events) == 0 {
+		return nil
+	}
+	var results []interface{}
+	err := insolar.Deserialize(result, &results)
+	if err != nil || len(results) == 0 || results[len(results)-1] != nil {
+		return nil
+	}
+	return events
+}
+
 func (lr *LogicRunner) getDescriptorsByPrototypeRef(
 	ctx context.Context, protoRef Ref,
 ) (
@@ -734,6 +773,12 @@ func (lr *LogicRunner) executeConstructorCall(
 	}
 
 	newData, err := executor.CallConstructor(ctx, current.LogicContext, *codeDesc.Ref(), m.Method, m.Arguments)
+	if err == nil {
+		err = lr.checkLimits(es.Current, newData)
+	}
+	if limitErr, ok := limitError(err); ok {
+		return nil, lr.registerLimitResult(ctx, es, *current.Request, limitErr)
+	}
 	if err != nil {
 		return nil, es.WrapError(err, "executer error")
 	}
@@ -747,7 +792,7 @@ func (lr *LogicRunner) executeConstructorCall(
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't activate object")
 		}
-		_, err = lr.ArtifactManager.RegisterResult(ctx, *current.Request, *current.Request, nil)
+		_, err = lr.ArtifactManager.RegisterResult(ctx, *current.Request, *current.Request, nil, es.Current.Events)
 		if err != nil {
 			return nil, es.WrapError(err, "couldn't save results")
 		}
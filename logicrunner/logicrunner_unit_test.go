@@ -42,6 +42,8 @@ import (
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/logicrunner/goplugin/foundation"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
 	"github.com/insolar/insolar/pulsar"
 	"github.com/insolar/insolar/pulsar/entropygenerator"
 
@@ -647,6 +649,441 @@ func (suite *LogicRunnerTestSuite) TestNoExcessiveAmends() {
 	suite.Require().Equal(uint64(1), suite.am.UpdateObjectCounter)
 }
 
+func (suite *LogicRunnerTestSuite) TestExecuteMethodCallLimits() {
+	objRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+
+	newExecutionState := func() *ExecutionState {
+		requestRef := testutils.RandomRef()
+		objDesc := artifacts.NewObjectDescriptorMock(suite.T())
+		objDesc.HeadRefMock.Return(&objRef)
+		es := &ExecutionState{}
+		es.objectbody = &ObjectBody{
+			objDescriptor:   objDesc,
+			Object:          []byte{1},
+			Prototype:       &protoRef,
+			CodeRef:         &protoRef,
+			CodeMachineType: insolar.MachineTypeBuiltin,
+		}
+		es.Current = &CurrentExecution{
+			LogicContext: &insolar.LogicCallContext{},
+			Request:      &requestRef,
+		}
+		return es
+	}
+	msg := &message.CallMethod{
+		Request: record.Request{
+			Object: &objRef,
+			Method: "some",
+		},
+	}
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodMock.Return([]byte{1, 2, 3, 4, 5}, []byte{}, nil)
+
+	var registered []byte
+	suite.am.RegisterResultFunc = func(
+		_ context.Context, obj insolar.Reference, _ insolar.Reference, payload []byte, _ []insolar.ContractEvent,
+	) (*insolar.ID, error) {
+		suite.Equal(objRef, obj)
+		registered = payload
+		return nil, nil
+	}
+	suite.am.UpdateObjectMock.Return(nil, nil)
+
+	suite.T().Run("state size", func(t *testing.T) {
+		suite.lr.Cfg.Limits = configuration.ExecutionLimits{MaxStateSize: 3}
+		defer func() { suite.lr.Cfg.Limits = configuration.ExecutionLimits{} }()
+
+		es := newExecutionState()
+		_, err := suite.lr.executeMethodCall(suite.ctx, es, msg)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), insolar.LimitStateSize)
+		require.Contains(t, string(registered), insolar.LimitStateSize)
+		require.Equal(t, []byte{1}, es.objectbody.Object)
+		require.Equal(t, uint64(0), suite.am.UpdateObjectCounter)
+	})
+
+	suite.T().Run("prototype override", func(t *testing.T) {
+		suite.lr.Cfg.Limits = configuration.ExecutionLimits{MaxStateSize: 3}
+		suite.lr.Cfg.PrototypeLimits = map[string]configuration.ExecutionLimits{
+			protoRef.String(): {MaxStateSize: 10},
+		}
+		defer func() {
+			suite.lr.Cfg.Limits = configuration.ExecutionLimits{}
+			suite.lr.Cfg.PrototypeLimits = nil
+		}()
+
+		es := newExecutionState()
+		_, err := suite.lr.executeMethodCall(suite.ctx, es, msg)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 2, 3, 4, 5}, es.objectbody.Object)
+	})
+
+	suite.T().Run("outgoing calls", func(t *testing.T) {
+		suite.lr.Cfg.Limits = configuration.ExecutionLimits{MaxOutgoingCalls: 1}
+		defer func() { suite.lr.Cfg.Limits = configuration.ExecutionLimits{} }()
+
+		es := newExecutionState()
+		require.NoError(t, suite.lr.countOutgoingCall(es))
+		require.Error(t, suite.lr.countOutgoingCall(es))
+
+		_, err := suite.lr.executeMethodCall(suite.ctx, es, msg)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), insolar.LimitOutgoingCalls)
+		require.Contains(t, string(registered), insolar.LimitOutgoingCalls)
+	})
+}
+
+func (suite *LogicRunnerTestSuite) TestExecuteMethodCallEvents() {
+	objRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	requestRef := testutils.RandomRef()
+
+	objDesc := artifacts.NewObjectDescriptorMock(suite.T())
+	objDesc.HeadRefMock.Return(&objRef)
+	es := &ExecutionState{}
+	es.objectbody = &ObjectBody{
+		objDescriptor:   objDesc,
+		Object:          []byte{1},
+		Prototype:       &protoRef,
+		CodeRef:         &protoRef,
+		CodeMachineType: insolar.MachineTypeBuiltin,
+	}
+	es.Current = &CurrentExecution{
+		LogicContext: &insolar.LogicCallContext{},
+		Request:      &requestRef,
+	}
+	suite.lr.UpsertObjectState(objRef).ExecutionState = es
+
+	rpc := &RPC{lr: suite.lr}
+	emit := func(name string) error {
+		req := rpctypes.UpEmitEventReq{
+			UpBaseReq: rpctypes.UpBaseReq{Mode: "execution", Callee: objRef, Request: requestRef},
+			Name:      name,
+			Data:      []byte(name),
+		}
+		return rpc.EmitEvent(req, &rpctypes.UpEmitEventResp{})
+	}
+
+	noError, err := insolar.Serialize([]interface{}{nil})
+	suite.Require().NoError(err)
+	contractError, err := insolar.MarshalArgs(nil, &foundation.Error{S: "no money"})
+	suite.Require().NoError(err)
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(
+		ctx context.Context, callContext *insolar.LogicCallContext, code insolar.Reference, data []byte,
+		method string, args insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		suite.Require().Error(emit(""))
+		if method == "big" {
+			_ = emit(string(make([]byte, 100)))
+			return data, noError, nil
+		}
+		suite.Require().NoError(emit("Transfer"))
+		if method == "many" {
+			_ = emit("Burn")
+			_ = emit("Burn")
+			return data, noError, nil
+		}
+		suite.Require().NoError(emit("Burn"))
+		if method == "fail" {
+			return data, contractError, nil
+		}
+		return data, noError, nil
+	}
+
+	var registered []insolar.ContractEvent
+	suite.am.RegisterResultFunc = func(
+		_ context.Context, _ insolar.Reference, _ insolar.Reference, _ []byte, events []insolar.ContractEvent,
+	) (*insolar.ID, error) {
+		registered = events
+		return nil, nil
+	}
+
+	newMessage := func(method string) *message.CallMethod {
+		return &message.CallMethod{
+			Request: record.Request{
+				Object: &objRef,
+				Method: method,
+			},
+		}
+	}
+	_, err = suite.lr.executeMethodCall(suite.ctx, es, newMessage("some"))
+	suite.Require().NoError(err)
+	suite.Equal([]insolar.ContractEvent{
+		{Name: "Transfer", Data: []byte("Transfer")},
+		{Name: "Burn", Data: []byte("Burn")},
+	}, registered)
+
+	suite.T().Run("failed call", func(t *testing.T) {
+		es.Current.Events = nil
+		_, err := suite.lr.executeMethodCall(suite.ctx, es, newMessage("fail"))
+		require.NoError(t, err)
+		require.Empty(t, registered)
+	})
+
+	suite.T().Run("limits", func(t *testing.T) {
+		suite.lr.Cfg.Limits = configuration.ExecutionLimits{MaxEvents: 2, MaxEventSize: 50}
+		defer func() { suite.lr.Cfg.Limits = configuration.ExecutionLimits{} }()
+
+		es.Current.Events = nil
+		_, err := suite.lr.executeMethodCall(suite.ctx, es, newMessage("many"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), insolar.LimitEvents)
+		require.Empty(t, registered)
+
+		es.Current.Events = nil
+		es.Current.LimitError = nil
+		_, err = suite.lr.executeMethodCall(suite.ctx, es, newMessage("big"))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), insolar.LimitEventSize)
+		require.Empty(t, registered)
+	})
+
+	es.Current.LogicContext.Immutable = true
+	suite.Error(emit("Transfer"))
+
+	suite.T().Run("upcall of finished request", func(t *testing.T) {
+		es.Current.LogicContext.Immutable = false
+		es.Current.Events = nil
+		require.NoError(t, emit("Transfer"))
+
+		// upcalls of a request, that exceeded time limit, come when the next request is executed
+		nextRef := testutils.RandomRef()
+		es.Current.Request = &nextRef
+		require.Error(t, emit("Transfer"))
+
+		es.Current = nil
+		require.Error(t, emit("Transfer"))
+	})
+}
+
+func (suite *LogicRunnerTestSuite) TestExecuteMethodCallMigration() {
+	objRef := testutils.RandomRef()
+	oldProtoRef := testutils.RandomRef()
+	newProtoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+
+	succession, err := insolar.Serialize(artifacts.Succession{
+		Predecessors:    []insolar.Reference{oldProtoRef},
+		MigrationMethod: "Migrate",
+	})
+	suite.Require().NoError(err)
+
+	protoDesc := artifacts.NewObjectDescriptorMock(suite.T())
+	protoDesc.HeadRefMock.Return(&newProtoRef)
+	protoDesc.CodeMock.Return(&codeRef, nil)
+	protoDesc.MemoryMock.Return(succession)
+
+	codeDesc := artifacts.NewCodeDescriptorMock(suite.T())
+	codeDesc.RefMock.Return(&codeRef)
+	codeDesc.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+
+	otherProtoDesc := artifacts.NewObjectDescriptorMock(suite.T())
+	otherProtoDesc.CodeMock.Return(&codeRef, nil)
+	otherProtoDesc.MemoryMock.Return(nil)
+
+	suite.am.GetObjectFunc = func(_ context.Context, head insolar.Reference) (artifacts.ObjectDescriptor, error) {
+		if head.Equal(newProtoRef) {
+			return protoDesc, nil
+		}
+		return otherProtoDesc, nil
+	}
+	suite.am.GetCodeMock.Return(codeDesc, nil)
+
+	noError, err := insolar.Serialize([]interface{}{nil})
+	suite.Require().NoError(err)
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(
+		_ context.Context, callContext *insolar.LogicCallContext, code insolar.Reference, data []byte, method string, _ insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		suite.Equal(codeRef, code)
+		suite.Equal(newProtoRef, *callContext.Prototype)
+		if method == "Migrate" {
+			return append(data, 2), noError, nil
+		}
+		return append(data, 3), noError, nil
+	}
+
+	var migrated []byte
+	migratedDesc := artifacts.NewObjectDescriptorMock(suite.T())
+	suite.am.MigrateObjectFunc = func(
+		_ context.Context, _, _ insolar.Reference, _ artifacts.ObjectDescriptor, prototype insolar.Reference, memory []byte,
+	) (artifacts.ObjectDescriptor, error) {
+		suite.Equal(newProtoRef, prototype)
+		migrated = memory
+		return migratedDesc, nil
+	}
+	suite.am.UpdateObjectMock.Return(migratedDesc, nil)
+	suite.am.RegisterResultMock.Return(nil, nil)
+
+	newExecutionState := func() *ExecutionState {
+		requestRef := testutils.RandomRef()
+		objDesc := artifacts.NewObjectDescriptorMock(suite.T())
+		es := &ExecutionState{}
+		es.objectbody = &ObjectBody{
+			objDescriptor:   objDesc,
+			Object:          []byte{1},
+			Prototype:       &oldProtoRef,
+			CodeRef:         &oldProtoRef,
+			CodeMachineType: insolar.MachineTypeBuiltin,
+		}
+		es.Current = &CurrentExecution{
+			LogicContext: &insolar.LogicCallContext{},
+			Request:      &requestRef,
+		}
+		return es
+	}
+	newMessage := func(method string, prototype insolar.Reference) *message.CallMethod {
+		return &message.CallMethod{
+			Request: record.Request{
+				Object:    &objRef,
+				Method:    method,
+				Prototype: &prototype,
+			},
+		}
+	}
+
+	suite.T().Run("call through proxy of successor", func(t *testing.T) {
+		es := newExecutionState()
+		_, err := suite.lr.executeMethodCall(suite.ctx, es, newMessage("some", newProtoRef))
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 2}, migrated)
+		require.Equal(t, []byte{1, 2, 3}, es.objectbody.Object)
+		require.Equal(t, newProtoRef, *es.objectbody.Prototype)
+		require.Equal(t, codeRef, *es.objectbody.CodeRef)
+
+		// calls through proxies of predecessors are accepted by migrated object
+		_, err = suite.lr.executeMethodCall(suite.ctx, es, newMessage("some", oldProtoRef))
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 2, 3, 3}, es.objectbody.Object)
+	})
+
+	suite.T().Run("explicit migration", func(t *testing.T) {
+		es := newExecutionState()
+		_, err := suite.lr.executeMethodCall(suite.ctx, es, newMessage("Migrate", newProtoRef))
+		require.NoError(t, err)
+		require.Equal(t, []byte{1}, migrated)
+		require.Equal(t, []byte{1, 2}, es.objectbody.Object)
+	})
+
+	suite.T().Run("not a successor", func(t *testing.T) {
+		es := newExecutionState()
+		_, err := suite.lr.executeMethodCall(suite.ctx, es, newMessage("some", testutils.RandomRef()))
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "proxy call error")
+		require.Equal(t, oldProtoRef, *es.objectbody.Prototype)
+	})
+}
+
+func (suite *LogicRunnerTestSuite) TestQuery() {
+	objRef := testutils.RandomRef()
+	otherRef := testutils.RandomRef()
+	protoRef := testutils.RandomRef()
+	codeRef := testutils.RandomRef()
+	stateID := testutils.RandomID()
+	pulseNumber := insolar.PulseNumber(insolar.FirstPulseNumber + 10)
+
+	newObjDesc := func(head insolar.Reference) *artifacts.ObjectDescriptorMock {
+		desc := artifacts.NewObjectDescriptorMock(suite.T())
+		desc.HeadRefMock.Return(&head)
+		desc.PrototypeMock.Return(&protoRef, nil)
+		desc.ParentMock.Return(&insolar.Reference{})
+		desc.MemoryMock.Return([]byte{1})
+		desc.StateIDMock.Return(&stateID)
+		return desc
+	}
+	objDescs := map[insolar.Reference]artifacts.ObjectDescriptor{
+		objRef:   newObjDesc(objRef),
+		otherRef: newObjDesc(otherRef),
+	}
+	protoDesc := artifacts.NewObjectDescriptorMock(suite.T())
+	protoDesc.HeadRefMock.Return(&protoRef)
+	protoDesc.CodeMock.Return(&codeRef, nil)
+	codeDesc := artifacts.NewCodeDescriptorMock(suite.T())
+	codeDesc.RefMock.Return(&codeRef)
+	codeDesc.MachineTypeMock.Return(insolar.MachineTypeBuiltin)
+
+	suite.am.GetObjectFunc = func(_ context.Context, head insolar.Reference) (artifacts.ObjectDescriptor, error) {
+		if head.Equal(protoRef) {
+			return protoDesc, nil
+		}
+		return objDescs[head], nil
+	}
+	suite.am.GetCodeMock.Return(codeDesc, nil)
+	suite.ps.LatestFunc = func(context.Context) (insolar.Pulse, error) {
+		return insolar.Pulse{PulseNumber: pulseNumber}, nil
+	}
+
+	rpc := &RPC{lr: suite.lr}
+	route := func(callCtx *insolar.LogicCallContext, object insolar.Reference, wait bool) ([]byte, error) {
+		req := rpctypes.UpRouteReq{
+			UpBaseReq: rpctypes.UpBaseReq{Mode: callCtx.Mode, Callee: *callCtx.Callee, Request: *callCtx.Request},
+			Wait:      wait,
+			Object:    object,
+			Prototype: protoRef,
+			Method:    "nested",
+		}
+		rep := &rpctypes.UpRouteResp{}
+		err := rpc.RouteCall(req, rep)
+		return rep.Result, err
+	}
+
+	mle := testutils.NewMachineLogicExecutorMock(suite.mc)
+	suite.lr.Executors[insolar.MachineTypeBuiltin] = mle
+	mle.CallMethodFunc = func(
+		_ context.Context, callCtx *insolar.LogicCallContext, _ insolar.Reference, data []byte,
+		method string, _ insolar.Arguments,
+	) ([]byte, insolar.Arguments, error) {
+		suite.Equal(insolar.QueryMode, callCtx.Mode)
+		suite.True(callCtx.Immutable)
+
+		switch method {
+		case "nested":
+			suite.Equal(objRef, *callCtx.Caller)
+			return data, []byte("nested"), nil
+		case "write":
+			return append(data, 2), nil, nil
+		}
+
+		// top level query is anonymous, even objects without parent don't take it for a call of the parent
+		suite.Equal(insolar.AnonymousCaller, *callCtx.Caller)
+		suite.NotEqual(*callCtx.Parent, *callCtx.Caller)
+
+		base := rpctypes.UpBaseReq{Mode: callCtx.Mode, Callee: *callCtx.Callee, Request: *callCtx.Request}
+		suite.Error(rpc.EmitEvent(rpctypes.UpEmitEventReq{UpBaseReq: base, Name: "Transfer"}, &rpctypes.UpEmitEventResp{}))
+		suite.Error(rpc.SaveAsChild(rpctypes.UpSaveAsChildReq{UpBaseReq: base}, &rpctypes.UpSaveAsChildResp{}))
+
+		_, err := route(callCtx, otherRef, false)
+		suite.Error(err)
+		_, err = route(callCtx, objRef, true)
+		suite.Error(err)
+		res, err := route(callCtx, otherRef, true)
+		suite.NoError(err)
+		return data, res, nil
+	}
+
+	res, err := suite.lr.Query(suite.ctx, objRef, "read", nil)
+	suite.Require().NoError(err)
+	suite.Equal(&insolar.QueryResult{
+		Result: []byte("nested"),
+		State:  stateID,
+		Pulse:  pulseNumber,
+	}, res)
+
+	_, err = suite.lr.Query(suite.ctx, objRef, "write", nil)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), errQueryWrite.Error())
+
+	suite.Empty(suite.lr.queries)
+}
+
 func (suite *LogicRunnerTestSuite) TestHandleAbandonedRequestsNotificationMessage() {
 	objectId := testutils.RandomID()
 	msg := &message.AbandonedRequestsNotification{Object: objectId}
@@ -1075,6 +1512,7 @@ func (suite *LogicRunnerTestSuite) TestCallMethodWithOnPulse() {
 
 				suite.am.RegisterResultFunc = func(
 					ctx context.Context, r1 insolar.Reference, r2 insolar.Reference, mem []byte,
+					events []insolar.ContractEvent,
 				) (*insolar.ID, error) {
 					resId := testutils.RandomID()
 					return &resId, nil
@@ -0,0 +1,125 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/logicrunner/artifacts"
+)
+
+// checkSuccession allows calls made through a proxy of another prototype if one of the prototypes succeeds the other.
+// Calls through proxies of predecessors are accepted as is, the object is migrated to the prototype of the proxy if
+// it's a successor. Successions are authorized when they are declared, so they are not checked here.
+func (lr *LogicRunner) checkSuccession(ctx context.Context, es *ExecutionState, m *message.CallMethod) error {
+	if es.objectbody.Succession.Succeeds(*m.Prototype) {
+		return nil
+	}
+
+	protoDesc, codeDesc, err := lr.getDescriptorsByPrototypeRef(ctx, *m.Prototype)
+	if err != nil {
+		return es.WrapError(err, "couldn't get descriptors of prototype")
+	}
+	succession, err := artifacts.SuccessionOf(protoDesc)
+	if err != nil {
+		return es.WrapError(err, "couldn't get succession of prototype")
+	}
+	if !succession.Succeeds(*es.objectbody.Prototype) {
+		return errors.New("proxy call error: try to call method of prototype as method of another prototype")
+	}
+
+	return lr.migrateObject(ctx, es, m, protoDesc, codeDesc, succession)
+}
+
+// migrateObject moves the object to the successor prototype. Object state is converted by the migration method of
+// the successor, unless the migration method is called explicitly by the request.
+func (lr *LogicRunner) migrateObject(
+	ctx context.Context,
+	es *ExecutionState,
+	m *message.CallMethod,
+	protoDesc artifacts.ObjectDescriptor,
+	codeDesc artifacts.CodeDescriptor,
+	succession *artifacts.Succession,
+) error {
+	inslogger.FromContext(ctx).Infof(
+		"migrating object %s from prototype %s to %s",
+		m.Object, es.objectbody.Prototype, protoDesc.HeadRef(),
+	)
+
+	memory := es.objectbody.Object
+	if succession.MigrationMethod != "" && succession.MigrationMethod != m.Method {
+		executor, err := lr.GetExecutor(codeDesc.MachineType())
+		if err != nil {
+			return es.WrapError(err, "no executor registered")
+		}
+
+		logicContext := *es.Current.LogicContext
+		logicContext.Prototype = protoDesc.HeadRef()
+		logicContext.Code = codeDesc.Ref()
+		logicContext.Parent = es.objectbody.Parent
+
+		args, err := insolar.Serialize([]interface{}{})
+		if err != nil {
+			return es.WrapError(err, "couldn't serialize migration arguments")
+		}
+
+		var result []byte
+		memory, result, err = executor.CallMethod(
+			ctx, &logicContext, *codeDesc.Ref(), memory, succession.MigrationMethod, args,
+		)
+		if err != nil {
+			return es.WrapError(err, "migration error")
+		}
+		if err := migrationError(result); err != nil {
+			return es.WrapError(err, "migration error")
+		}
+	}
+
+	od, err := lr.ArtifactManager.MigrateObject(
+		ctx, Ref{}, *es.Current.Request, es.objectbody.objDescriptor, *protoDesc.HeadRef(), memory,
+	)
+	if err != nil {
+		return es.WrapError(err, "couldn't migrate object")
+	}
+
+	es.objectbody.objDescriptor = od
+	es.objectbody.Object = memory
+	es.objectbody.Prototype = protoDesc.HeadRef()
+	es.objectbody.CodeMachineType = codeDesc.MachineType()
+	es.objectbody.CodeRef = codeDesc.Ref()
+	es.objectbody.Succession = succession
+
+	return nil
+}
+
+// migrationError returns error returned by the migration method, it's the only result of the method.
+func migrationError(result []byte) error {
+	var ret []interface{}
+	err := insolar.Deserialize(result, &ret)
+	if err != nil {
+		return errors.Wrap(err, "couldn't deserialize result of migration method")
+	}
+	if len(ret) > 0 && ret[len(ret)-1] != nil {
+		return errors.Errorf("migration method returned error: %v", ret[len(ret)-1])
+	}
+	return nil
+}
@@ -48,6 +48,7 @@ var proxyctxPath = "github.com/insolar/insolar/logicrunner/goplugin/proxyctx"
 var corePath = "github.com/insolar/insolar/insolar"
 
 var immutableFlag = "//ins:immutable"
+var predecessorFlag = "//ins:predecessor"
 
 const (
 	TemplateDirectory = "templates"
@@ -65,6 +66,7 @@ type ParsedFile struct {
 	methods      map[string][]*ast.FuncDecl
 	constructors map[string][]*ast.FuncDecl
 	contract     string
+	predecessors []string
 }
 
 // ParseFile parses a file as Go source code of a smart contract
@@ -118,6 +120,12 @@ func (pf *ParsedFile) parseTypes() error {
 			if err != nil {
 				return err
 			}
+			if pf.contract == typeNode.Name.Name {
+				pf.predecessors, err = parsePredecessors(tDecl.Doc, typeNode.Doc)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -342,6 +350,7 @@ func (pf *ParsedFile) WriteProxy(classReference string, out io.Writer) error {
 		"MethodsProxies":      methodsProxies,
 		"ConstructorsProxies": constructorProxies,
 		"ClassReference":      classReference,
+		"Predecessors":        pf.predecessors,
 		"Imports":             pf.generateImports(false),
 	}
 
@@ -655,6 +664,28 @@ func isImmutable(decl *ast.FuncDecl) bool {
 	return isImmutable
 }
 
+// parsePredecessors returns references of prototypes succeeded by the contract, they are declared in the doc
+// comment of the contract type as "//ins:predecessor <reference>"
+func parsePredecessors(docs ...*ast.CommentGroup) ([]string, error) {
+	var predecessors []string
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		for _, comment := range doc.List {
+			if !strings.HasPrefix(comment.Text, predecessorFlag+" ") {
+				continue
+			}
+			ref := strings.TrimSpace(strings.TrimPrefix(comment.Text, predecessorFlag))
+			if _, err := insolar.NewReferenceFromBase58(ref); err != nil {
+				return nil, errors.Wrapf(err, "bad predecessor reference %q", ref)
+			}
+			predecessors = append(predecessors, ref)
+		}
+	}
+	return predecessors, nil
+}
+
 type ContractListEntry struct {
 	Name       string
 	Path       string
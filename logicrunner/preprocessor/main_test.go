@@ -217,6 +217,51 @@ func New() *One {
 	s.Error(err)
 }
 
+func (s *PreprocessorSuite) TestPredecessorsParsing() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) // nolint: errcheck
+
+	predecessor := testutils.RandomRef().String()
+	code := `
+package main
+
+// One is a successor
+//ins:predecessor ` + predecessor + `
+type One struct {
+	foundation.BaseContract
+}
+`
+
+	err = goplugintestutils.WriteFile(tmpDir, "one.go", code)
+	s.NoError(err)
+
+	parsed, err := ParseFile(filepath.Join(tmpDir, "one.go"), insolar.MachineTypeGoPlugin)
+	s.Require().NoError(err)
+	s.Equal([]string{predecessor}, parsed.predecessors)
+
+	var bufProxy bytes.Buffer
+	err = parsed.WriteProxy(testutils.RandomRef().String(), &bufProxy)
+	s.Require().NoError(err)
+	s.Contains(bufProxy.String(), "var PredecessorReferences")
+	s.Contains(bufProxy.String(), predecessor)
+
+	code = `
+package main
+
+//ins:predecessor bad
+type One struct {
+	foundation.BaseContract
+}
+`
+
+	err = goplugintestutils.WriteFile(tmpDir, "one.go", code)
+	s.NoError(err)
+
+	_, err = ParseFile(filepath.Join(tmpDir, "one.go"), insolar.MachineTypeGoPlugin)
+	s.Error(err)
+}
+
 func (s *PreprocessorSuite) TestCompileContractProxy() {
 
 	tmpDir, err := ioutil.TempDir("", "test-")
@@ -374,6 +419,41 @@ func (a *A) Get(
 	s.Contains(bufWrapper.String(), "args[3] = &args3")
 }
 
+func (s *PreprocessorSuite) TestImmutableAttributeWrapper() {
+	tmpDir, err := ioutil.TempDir("", "test-")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir) //nolint: errcheck
+
+	testContract := "/test.go"
+
+	err = goplugintestutils.WriteFile(tmpDir, testContract, `
+package main
+
+type A struct{
+	foundation.BaseContract
+}
+
+//ins:immutable
+func (a *A) Get() (int, error) {
+	return 0, nil
+}
+
+func (a *A) Set(i int) error {
+	return nil
+}
+`)
+	s.NoError(err)
+
+	parsed, err := ParseFile(tmpDir+testContract, insolar.MachineTypeGoPlugin)
+	s.NoError(err)
+
+	var bufWrapper bytes.Buffer
+	err = parsed.WriteWrapper(&bufWrapper, parsed.ContractName())
+	s.NoError(err)
+	s.Contains(bufWrapper.String(), "var INSATTR_Get_Immutable = true")
+	s.NotContains(bufWrapper.String(), "INSATTR_Set_Immutable")
+}
+
 func (s *PreprocessorSuite) TestContractOnlyIfEmbedBaseContract() {
 	tmpDir, err := ioutil.TempDir("", "test-")
 	s.NoError(err)
@@ -29,6 +29,22 @@ import (
 // PrototypeReference to prototype of this contract
 // error checking hides in generator
 var PrototypeReference, _ = insolar.NewReferenceFromBase58("{{ .ClassReference }}")
+{{ if .Predecessors }}
+// PredecessorReferences to prototypes succeeded by this contract
+// error checking hides in generator
+var PredecessorReferences = func() []*insolar.Reference {
+	var refs []*insolar.Reference
+	for _, s := range []string{
+{{- range $ref := .Predecessors }}
+		"{{ $ref }}",
+{{- end }}
+	} {
+		ref, _ := insolar.NewReferenceFromBase58(s)
+		refs = append(refs, ref)
+	}
+	return refs
+}()
+{{ end }}
 
 
 // {{ .ContractType }} holds proxy type
@@ -75,6 +91,12 @@ func GetPrototype() insolar.Reference {
 // GetImplementationFrom returns proxy to delegate of given type
 func GetImplementationFrom(object insolar.Reference) (*{{ .ContractType }}, error) {
 	ref, err := proxyctx.Current.GetDelegate(object, *PrototypeReference)
+{{- if .Predecessors }}
+	// delegates created before migration are registered with prototypes of predecessors
+	for i := 0; err != nil && i < len(PredecessorReferences); i++ {
+		ref, err = proxyctx.Current.GetDelegate(object, *PredecessorReferences[i])
+	}
+{{- end }}
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +30,8 @@ func ( e *ExtendableError ) Error() string{
 	return e.S
 }
 
+var INSATTR_GetCode_Immutable = true
+
 func INSMETHOD_GetCode(object []byte, data []byte) ([]byte, []byte, error) {
 	ph := proxyctx.Current
 	self := new({{ $.ContractType }})
@@ -56,6 +58,8 @@ func INSMETHOD_GetCode(object []byte, data []byte) ([]byte, []byte, error) {
 	return state, ret, err
 }
 
+var INSATTR_GetPrototype_Immutable = true
+
 func INSMETHOD_GetPrototype(object []byte, data []byte) ([]byte, []byte, error) {
 	ph := proxyctx.Current
 	self := new({{ $.ContractType }})
@@ -83,6 +87,10 @@ func INSMETHOD_GetPrototype(object []byte, data []byte) ([]byte, []byte, error)
 }
 
 {{ range $method := .Methods }}
+{{ if $method.Immutable -}}
+var INSATTR_{{ $method.Name }}_Immutable = true
+{{- end }}
+
 func INSMETHOD_{{ $method.Name }}(object []byte, data []byte) ([]byte, []byte, error) {
 	ph := proxyctx.Current
 
@@ -164,8 +172,13 @@ func Initialize() map[string]interface{} {
     return map[string]interface{}{
         "INSMETHOD_GetCode": INSMETHOD_GetCode,
         "INSMETHOD_GetPrototype": INSMETHOD_GetPrototype,
+        "INSATTR_GetCode_Immutable": INSATTR_GetCode_Immutable,
+        "INSATTR_GetPrototype_Immutable": INSATTR_GetPrototype_Immutable,
 {{ range $method := .Methods -}}
         "INSMETHOD_{{ $method.Name }}": INSMETHOD_{{ $method.Name }},
+{{- if $method.Immutable }}
+        "INSATTR_{{ $method.Name }}_Immutable": INSATTR_{{ $method.Name }}_Immutable,
+{{- end }}
 {{- end }}
 {{ range $f := .Functions -}}
         "INSCONSTRUCTOR_{{ $f.Name }}": INSCONSTRUCTOR_{{ $f.Name }},
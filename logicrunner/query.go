@@ -0,0 +1,220 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package logicrunner
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/instrumentation/instracer"
+	"github.com/insolar/insolar/logicrunner/goplugin/rpctypes"
+)
+
+var errQueryWrite = errors.New("query can't write to ledger")
+
+// queryState is a state of read-only query. Queries don't pass through queue of the object, so they don't use
+// ObjectState and are found by reference of the query on upcalls.
+type queryState struct {
+	*ExecutionState
+	parent *queryState
+}
+
+// Query executes method of the object against its latest state. Query doesn't register request, so it can be
+// executed on any virtual node and leaves no records on ledger. Executors run only methods marked immutable in the
+// prototype, query fails if the method still changes state of the object or makes calls, that should be registered.
+// Caller of the query is insolar.AnonymousCaller. Nested calls are executed locally as queries too.
+func (lr *LogicRunner) Query(
+	ctx context.Context, object Ref, method string, args insolar.Arguments,
+) (*insolar.QueryResult, error) {
+	return lr.query(ctx, nil, object, nil, method, args)
+}
+
+func (lr *LogicRunner) query(
+	ctx context.Context, parent *queryState, object Ref, prototype *Ref, method string, args insolar.Arguments,
+) (*insolar.QueryResult, error) {
+	ctx, span := instracer.StartSpan(ctx, "LogicRunner.query")
+	defer span.End()
+
+	for p := parent; p != nil; p = p.parent {
+		if p.Ref.Equal(object) {
+			return nil, errors.New("query loop detected")
+		}
+	}
+
+	pulse, err := lr.PulseAccessor.Latest(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get current pulse")
+	}
+
+	objDesc, protoDesc, codeDesc, err := lr.getDescriptorsByObjectRef(ctx, object)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't get descriptors by object reference")
+	}
+	// objects can't be migrated by queries, so proxy should match prototype of the object
+	if prototype != nil && !prototype.Equal(*protoDesc.HeadRef()) {
+		return nil, errors.New("object has other prototype, it should be migrated by request first")
+	}
+
+	request, err := newQueryRef(pulse.PulseNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	qs := &queryState{
+		ExecutionState: &ExecutionState{
+			Ref: object,
+			objectbody: &ObjectBody{
+				objDescriptor:   objDesc,
+				Object:          objDesc.Memory(),
+				Prototype:       protoDesc.HeadRef(),
+				CodeMachineType: codeDesc.MachineType(),
+				CodeRef:         codeDesc.Ref(),
+				Parent:          objDesc.Parent(),
+			},
+			Current: &CurrentExecution{
+				Context: ctx,
+				Request: request,
+			},
+		},
+		parent: parent,
+	}
+
+	anonymous := insolar.AnonymousCaller
+	caller, callerPrototype := &anonymous, &Ref{}
+	if parent != nil {
+		caller, callerPrototype = &parent.Ref, parent.objectbody.Prototype
+	}
+	qs.Current.LogicContext = &insolar.LogicCallContext{
+		Mode:            insolar.QueryMode,
+		Caller:          caller,
+		Callee:          &object,
+		Request:         request,
+		Prototype:       qs.objectbody.Prototype,
+		Code:            qs.objectbody.CodeRef,
+		CallerPrototype: callerPrototype,
+		Parent:          qs.objectbody.Parent,
+		Time:            time.Now(),
+		Pulse:           pulse,
+		Immutable:       true,
+		TraceID:         inslogger.TraceID(ctx),
+	}
+
+	executor, err := lr.GetExecutor(qs.objectbody.CodeMachineType)
+	if err != nil {
+		return nil, qs.WrapError(err, "no executor registered")
+	}
+
+	lr.addQuery(*request, qs)
+	defer lr.removeQuery(*request)
+
+	newData, result, err := executor.CallMethod(
+		ctx, qs.Current.LogicContext, *qs.objectbody.CodeRef, qs.objectbody.Object, method, args,
+	)
+	if err == nil {
+		err = lr.checkLimits(qs.Current, newData)
+	}
+	if err != nil {
+		return nil, qs.WrapError(err, "executor error")
+	}
+	if qs.deactivate || !bytes.Equal(qs.objectbody.Object, newData) {
+		return nil, qs.WrapError(errQueryWrite, "query changed state of the object")
+	}
+
+	return &insolar.QueryResult{
+		Result: result,
+		State:  *objDesc.StateID(),
+		Pulse:  pulse.PulseNumber,
+	}, nil
+}
+
+// routeQueryCall executes nested call of query as query. Calls without waiting for result can't be made, because
+// they should be registered.
+func (gpr *RPC) routeQueryCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) error {
+	if !req.Wait {
+		return errors.Wrap(errQueryWrite, "couldn't make call without waiting for result")
+	}
+
+	qs := gpr.lr.mustQueryState(req.Request)
+	if err := gpr.lr.countOutgoingCall(qs.ExecutionState); err != nil {
+		return err
+	}
+
+	res, err := gpr.lr.query(qs.Current.Context, qs, req.Object, &req.Prototype, req.Method, req.Arguments)
+	if err != nil {
+		return err
+	}
+	rep.Result = res.Result
+	return nil
+}
+
+// newQueryRef returns random reference, that identifies query on upcalls instead of request.
+func newQueryRef(pn insolar.PulseNumber) (*Ref, error) {
+	hash := make([]byte, insolar.RecordHashSize)
+	_, err := rand.Read(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "couldn't generate query reference")
+	}
+	id := insolar.NewID(pn, hash)
+	return insolar.NewReference(*id, *id), nil
+}
+
+func (lr *LogicRunner) addQuery(ref Ref, qs *queryState) {
+	lr.queriesMutex.Lock()
+	defer lr.queriesMutex.Unlock()
+	lr.queries[ref] = qs
+}
+
+func (lr *LogicRunner) removeQuery(ref Ref) {
+	lr.queriesMutex.Lock()
+	defer lr.queriesMutex.Unlock()
+	delete(lr.queries, ref)
+}
+
+func (lr *LogicRunner) mustQueryState(ref Ref) *queryState {
+	lr.queriesMutex.Lock()
+	defer lr.queriesMutex.Unlock()
+	qs, ok := lr.queries[ref]
+	if !ok {
+		panic("No requested query state. ref: " + ref.String())
+	}
+	return qs
+}
+
+// mustModeState returns state of the execution, that made upcall. Upcalls of requests, that are not executed
+// anymore (e.g. exceeded time limit), are rejected, so they are never counted against the next request.
+func (lr *LogicRunner) mustModeState(req rpctypes.UpBaseReq) *ExecutionState {
+	if req.Mode == insolar.QueryMode {
+		return lr.mustQueryState(req.Request).ExecutionState
+	}
+
+	es := lr.MustObjectState(req.Callee).MustModeState(req.Mode)
+
+	es.Lock()
+	current := es.Current
+	es.Unlock()
+
+	if current == nil || current.Request == nil || !current.Request.Equal(req.Request) {
+		panic("upcall of request, that is not executed: " + req.Request.String())
+	}
+	return es
+}
@@ -86,8 +86,7 @@ func recoverRPC(err *error) {
 // GetCode is an RPC retrieving a code by its reference
 func (gpr *RPC) GetCode(req rpctypes.UpGetCodeReq, reply *rpctypes.UpGetCodeResp) (err error) {
 	defer recoverRPC(&err)
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := gpr.lr.mustModeState(req.UpBaseReq)
 	ctx := es.Current.Context
 	inslogger.FromContext(ctx).Debug("In RPC.GetCode ....")
 
@@ -111,15 +110,22 @@ func (gpr *RPC) GetCode(req rpctypes.UpGetCodeReq, reply *rpctypes.UpGetCodeResp
 func (gpr *RPC) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
+	if req.Mode == insolar.QueryMode {
+		return gpr.routeQueryCall(req, rep)
+	}
+
+	es := gpr.lr.mustModeState(req.UpBaseReq)
 
-	if os.ExecutionState.Current.LogicContext.Immutable {
+	if es.Current.LogicContext.Immutable {
 		return errors.New("Try to call route from immutable method")
 	}
 
-	es := os.MustModeState(req.Mode)
 	ctx := es.Current.Context
 
+	if err := gpr.lr.countOutgoingCall(es); err != nil {
+		return err
+	}
+
 	// TODO: delegation token
 
 	es.nonce++
@@ -159,10 +165,17 @@ func (gpr *RPC) RouteCall(req rpctypes.UpRouteReq, rep *rpctypes.UpRouteResp) (e
 func (gpr *RPC) SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveAsChildResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	if req.Mode == insolar.QueryMode {
+		return errors.Wrap(errQueryWrite, "couldn't save object")
+	}
+
+	es := gpr.lr.mustModeState(req.UpBaseReq)
 	ctx := es.Current.Context
 
+	if err := gpr.lr.countOutgoingCall(es); err != nil {
+		return err
+	}
+
 	es.nonce++
 
 	msg := &message.CallMethod{
@@ -190,10 +203,17 @@ func (gpr *RPC) SaveAsChild(req rpctypes.UpSaveAsChildReq, rep *rpctypes.UpSaveA
 func (gpr *RPC) SaveAsDelegate(req rpctypes.UpSaveAsDelegateReq, rep *rpctypes.UpSaveAsDelegateResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	if req.Mode == insolar.QueryMode {
+		return errors.Wrap(errQueryWrite, "couldn't save object")
+	}
+
+	es := gpr.lr.mustModeState(req.UpBaseReq)
 	ctx := es.Current.Context
 
+	if err := gpr.lr.countOutgoingCall(es); err != nil {
+		return err
+	}
+
 	es.nonce++
 
 	msg := &message.CallMethod{
@@ -229,8 +249,7 @@ func (gpr *RPC) GetObjChildrenIterator(
 ) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := gpr.lr.mustModeState(req.UpBaseReq)
 	ctx := es.Current.Context
 
 	am := gpr.lr.ArtifactManager
@@ -304,8 +323,7 @@ func (gpr *RPC) GetObjChildrenIterator(
 func (gpr *RPC) GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDelegateResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := gpr.lr.mustModeState(req.UpBaseReq)
 	ctx := es.Current.Context
 
 	am := gpr.lr.ArtifactManager
@@ -321,8 +339,23 @@ func (gpr *RPC) GetDelegate(req rpctypes.UpGetDelegateReq, rep *rpctypes.UpGetDe
 func (gpr *RPC) DeactivateObject(req rpctypes.UpDeactivateObjectReq, rep *rpctypes.UpDeactivateObjectResp) (err error) {
 	defer recoverRPC(&err)
 
-	os := gpr.lr.MustObjectState(req.Callee)
-	es := os.MustModeState(req.Mode)
+	es := gpr.lr.mustModeState(req.UpBaseReq)
 	es.deactivate = true
 	return nil
 }
+
+// EmitEvent is an RPC saving event of the current execution, events are registered with the result of the request
+func (gpr *RPC) EmitEvent(req rpctypes.UpEmitEventReq, rep *rpctypes.UpEmitEventResp) (err error) {
+	defer recoverRPC(&err)
+
+	if req.Name == "" || len(req.Name) > insolar.MaxContractEventNameLength {
+		return errors.Errorf("event name should be from 1 to %d bytes long", insolar.MaxContractEventNameLength)
+	}
+
+	es := gpr.lr.mustModeState(req.UpBaseReq)
+	if es.Current.LogicContext.Immutable {
+		return errors.New("immutable method can't emit events")
+	}
+
+	return gpr.lr.countEvent(es, insolar.ContractEvent{Name: req.Name, Data: req.Data})
+}
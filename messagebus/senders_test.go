@@ -0,0 +1,60 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+)
+
+func TestRetryJetSender_MergedJet(t *testing.T) {
+	ctx := context.Background()
+	pn := gen.PulseNumber()
+	jets := jet.NewStore()
+
+	// Tree of the sender still has branches of the jet, that was merged by light.
+	root := insolar.ZeroJetID
+	left, right, err := jets.Split(ctx, pn, root)
+	require.NoError(t, err)
+	jets.Update(ctx, pn, false, left, right)
+
+	target := gen.ID()
+	var routed []insolar.JetID
+	sender := func(ctx context.Context, msg insolar.Message, options *insolar.MessageSendOptions) (insolar.Reply, error) {
+		jetID, _ := jets.ForID(ctx, pn, target)
+		routed = append(routed, jetID)
+		if jetID != root {
+			return &reply.JetMiss{JetID: insolar.ID(root), Pulse: pn}, nil
+		}
+		return &reply.OK{}, nil
+	}
+
+	rep, err := RetryJetSender(jets)(sender)(ctx, &message.GetObject{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, &reply.OK{}, rep)
+	require.Len(t, routed, 2)
+	require.Equal(t, root, routed[1], "message is routed to merged jet")
+	require.Equal(t, []insolar.JetID{root}, jets.All(ctx, pn))
+}
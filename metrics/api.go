@@ -25,3 +25,11 @@ var APIContractExecutionTime = prometheus.NewSummaryVec(prometheus.SummaryOpts{
 	Subsystem:  "API",
 	Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.95: 0.005, 0.99: 0.001},
 }, []string{"method", "success"})
+
+// APIRateLimitedTotal is total number of API requests rejected by rate limits
+var APIRateLimitedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name:      "rate_limited_total",
+	Help:      "Total number of API requests rejected by rate limits",
+	Namespace: insolarNamespace,
+	Subsystem: "API",
+}, []string{"endpoint", "limit"})
@@ -42,6 +42,7 @@ func GetInsolarRegistry(nodeRole string) *prometheus.Registry {
 	registerer.MustRegister(NetworkRecvSize)
 
 	registerer.MustRegister(APIContractExecutionTime)
+	registerer.MustRegister(APIRateLimitedTotal)
 
 	return registry
 }
@@ -46,8 +46,10 @@ import (
 	"github.com/insolar/insolar/keystore"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/drop"
+	"github.com/insolar/insolar/ledger/heavy/exporter"
 	"github.com/insolar/insolar/ledger/heavy/handler"
 	"github.com/insolar/insolar/ledger/heavy/pulsemanager"
+	"github.com/insolar/insolar/ledger/heavy/snapshot"
 	"github.com/insolar/insolar/ledger/object"
 	"github.com/insolar/insolar/logicrunner/artifacts"
 	"github.com/insolar/insolar/messagebus"
@@ -62,6 +64,8 @@ import (
 type components struct {
 	cmp               component.Manager
 	NodeRef, NodeRole string
+
+	journal *exporter.JournalDB
 }
 
 func newComponents(ctx context.Context, cfg configuration.Configuration) (*components, error) {
@@ -137,7 +141,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 	var (
 		Requester insolar.ContractRequester
 		Genesis   insolar.GenesisDataProvider
-		API       insolar.APIRunner
+		API       *api.Runner
 	)
 	{
 		var err error
@@ -195,7 +199,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 	{
 		conf := cfg.Ledger
 
-		db, err := store.NewBadgerDB(conf.Storage.DataDirectory)
+		db, err := store.NewDB(conf.Storage.Backend, conf.Storage.DataDirectory)
 		if err != nil {
 			panic(errors.Wrap(err, "failed to initialize DB"))
 		}
@@ -207,6 +211,8 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		indexes := object.NewIndexDB(db)
 		blobs := blob.NewDB(db)
 		drops := drop.NewDB(db)
+		journal := exporter.NewJournalDB(db)
+		c.journal = journal
 
 		cord := jetcoordinator.NewJetCoordinator(conf.LightChainLimit)
 		cord.PulseCalculator = pulses
@@ -222,10 +228,15 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		pm.NodeSetter = nodes
 		pm.Nodes = nodes
 		pm.PulseAppender = pulses
+		pm.PulseAccessor = pulses
+		pm.DB = db
 
 		h := handler.New()
+		pm.PayloadLock = h.PayloadLock
 		h.RecordAccessor = records
 		h.RecordModifier = records
+		h.RecordResultAccessor = records
+		h.RecordEventAccessor = records
 		h.JetCoordinator = Coordinator
 		h.IndexLifelineAccessor = indexes
 		h.IndexBucketModifier = indexes
@@ -233,8 +244,21 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		h.BlobAccessor = blobs
 		h.BlobModifier = blobs
 		h.DropModifier = drops
+		h.JournalModifier = journal
 		h.PCS = CryptoScheme
 
+		API.Exporter = exporter.NewExporter(
+			conf.Exporter.ExportLag,
+			pulses,
+			pulses,
+			journal,
+			records,
+			blobs,
+			indexes,
+			drops,
+		)
+		API.Snapshots = snapshot.NewFileSaver(conf.Snapshot.Directory, pm)
+
 		Coordinator = cord
 		Pulses = pulses
 		Jets = jets
@@ -277,6 +301,11 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 }
 
 func (c *components) Start(ctx context.Context) error {
+	// Genesis and data of previous versions are stored bypassing the export journal.
+	err := c.journal.Backfill(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to backfill export journal")
+	}
 	return c.cmp.Start(ctx)
 }
 
@@ -39,6 +39,7 @@ import (
 	"github.com/insolar/insolar/insolar/node"
 	"github.com/insolar/insolar/insolar/pulse"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/keystore"
 	"github.com/insolar/insolar/ledger/blob"
 	"github.com/insolar/insolar/ledger/drop"
@@ -137,7 +138,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 	var (
 		Requester insolar.ContractRequester
 		Genesis   insolar.GenesisDataProvider
-		API       insolar.APIRunner
+		API       *api.Runner
 	)
 	{
 		var err error
@@ -204,6 +205,12 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		jets := jet.NewStore()
 		nodes := node.NewStorage()
 
+		db, err := store.NewDB(conf.Storage.Backend, conf.Storage.DataDirectory)
+		if err != nil {
+			panic(errors.Wrap(err, "failed to initialize DB"))
+		}
+		journal := replication.NewJournalDB(db)
+
 		c := component.Manager{}
 		c.Inject(CryptoScheme)
 
@@ -233,6 +240,7 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 		handler.IDLocker = idLocker
 		handler.RecordModifier = records
 		handler.RecordAccessor = records
+		handler.RecordResultAccessor = records
 		handler.Nodes = nodes
 		handler.HotDataWaiter = waiter
 		handler.JetReleaser = waiter
@@ -245,18 +253,23 @@ func newComponents(ctx context.Context, cfg configuration.Configuration) (*compo
 			blobs,
 			records,
 			indexes,
+			journal,
 			pulses,
 			pulses,
 			conf.LightChainLimit,
+			conf.LightStorageBudget,
 		)
+		handler.Retention = lightCleaner
 		dataGatherer := replication.NewDataGatherer(drops, blobs, records, indexes)
 		lthSyncer := replication.NewReplicatorDefault(
 			jetCalculator,
 			dataGatherer,
 			lightCleaner,
+			journal,
 			Bus,
 			pulses,
 		)
+		API.Replication = journal
 
 		pm := pulsemanager.NewPulseManager(
 			conf,
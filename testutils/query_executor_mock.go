@@ -0,0 +1,268 @@
+package testutils
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "QueryExecutor" can be found in github.com/insolar/insolar/insolar
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//QueryExecutorMock implements github.com/insolar/insolar/insolar.QueryExecutor
+type QueryExecutorMock struct {
+	t minimock.Tester
+
+	QueryFunc       func(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.Arguments) (r *insolar.QueryResult, r1 error)
+	QueryCounter    uint64
+	QueryPreCounter uint64
+	QueryMock       mQueryExecutorMockQuery
+}
+
+//NewQueryExecutorMock returns a mock for github.com/insolar/insolar/insolar.QueryExecutor
+func NewQueryExecutorMock(t minimock.Tester) *QueryExecutorMock {
+	m := &QueryExecutorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.QueryMock = mQueryExecutorMockQuery{mock: m}
+
+	return m
+}
+
+type mQueryExecutorMockQuery struct {
+	mock              *QueryExecutorMock
+	mainExpectation   *QueryExecutorMockQueryExpectation
+	expectationSeries []*QueryExecutorMockQueryExpectation
+}
+
+type QueryExecutorMockQueryExpectation struct {
+	input  *QueryExecutorMockQueryInput
+	result *QueryExecutorMockQueryResult
+}
+
+type QueryExecutorMockQueryInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 string
+	p3 insolar.Arguments
+}
+
+type QueryExecutorMockQueryResult struct {
+	r  *insolar.QueryResult
+	r1 error
+}
+
+//Expect specifies that invocation of QueryExecutor.Query is expected from 1 to Infinity times
+func (m *mQueryExecutorMockQuery) Expect(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.Arguments) *mQueryExecutorMockQuery {
+	m.mock.QueryFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &QueryExecutorMockQueryExpectation{}
+	}
+	m.mainExpectation.input = &QueryExecutorMockQueryInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of QueryExecutor.Query
+func (m *mQueryExecutorMockQuery) Return(r *insolar.QueryResult, r1 error) *QueryExecutorMock {
+	m.mock.QueryFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &QueryExecutorMockQueryExpectation{}
+	}
+	m.mainExpectation.result = &QueryExecutorMockQueryResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of QueryExecutor.Query is expected once
+func (m *mQueryExecutorMockQuery) ExpectOnce(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.Arguments) *QueryExecutorMockQueryExpectation {
+	m.mock.QueryFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &QueryExecutorMockQueryExpectation{}
+	expectation.input = &QueryExecutorMockQueryInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *QueryExecutorMockQueryExpectation) Return(r *insolar.QueryResult, r1 error) {
+	e.result = &QueryExecutorMockQueryResult{r, r1}
+}
+
+//Set uses given function f as a mock of QueryExecutor.Query method
+func (m *mQueryExecutorMockQuery) Set(f func(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.Arguments) (r *insolar.QueryResult, r1 error)) *QueryExecutorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.QueryFunc = f
+	return m.mock
+}
+
+//Query implements github.com/insolar/insolar/insolar.QueryExecutor interface
+func (m *QueryExecutorMock) Query(p context.Context, p1 insolar.Reference, p2 string, p3 insolar.Arguments) (r *insolar.QueryResult, r1 error) {
+	counter := atomic.AddUint64(&m.QueryPreCounter, 1)
+	defer atomic.AddUint64(&m.QueryCounter, 1)
+
+	if len(m.QueryMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.QueryMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to QueryExecutorMock.Query. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.QueryMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, QueryExecutorMockQueryInput{p, p1, p2, p3}, "QueryExecutor.Query got unexpected parameters")
+
+		result := m.QueryMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the QueryExecutorMock.Query")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.QueryMock.mainExpectation != nil {
+
+		input := m.QueryMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, QueryExecutorMockQueryInput{p, p1, p2, p3}, "QueryExecutor.Query got unexpected parameters")
+		}
+
+		result := m.QueryMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the QueryExecutorMock.Query")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.QueryFunc == nil {
+		m.t.Fatalf("Unexpected call to QueryExecutorMock.Query. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.QueryFunc(p, p1, p2, p3)
+}
+
+//QueryMinimockCounter returns a count of QueryExecutorMock.QueryFunc invocations
+func (m *QueryExecutorMock) QueryMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.QueryCounter)
+}
+
+//QueryMinimockPreCounter returns the value of QueryExecutorMock.Query invocations
+func (m *QueryExecutorMock) QueryMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.QueryPreCounter)
+}
+
+//QueryFinished returns true if mock invocations count is ok
+func (m *QueryExecutorMock) QueryFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.QueryMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.QueryCounter) == uint64(len(m.QueryMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.QueryMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.QueryCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.QueryFunc != nil {
+		return atomic.LoadUint64(&m.QueryCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *QueryExecutorMock) ValidateCallCounters() {
+
+	if !m.QueryFinished() {
+		m.t.Fatal("Expected call to QueryExecutorMock.Query")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *QueryExecutorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *QueryExecutorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *QueryExecutorMock) MinimockFinish() {
+
+	if !m.QueryFinished() {
+		m.t.Fatal("Expected call to QueryExecutorMock.Query")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *QueryExecutorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *QueryExecutorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.QueryFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.QueryFinished() {
+				m.t.Error("Expected call to QueryExecutorMock.Query")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *QueryExecutorMock) AllMocksCalled() bool {
+
+	if !m.QueryFinished() {
+		return false
+	}
+
+	return true
+}